@@ -2,18 +2,34 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
 
+	"github.com/dgraph-io/dgo/v240"
+	"github.com/dgraph-io/dgo/v240/protos/api"
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
 	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
 
 	server "github.com/yourorg/truthweave/internal/api/http"
 	"github.com/yourorg/truthweave/internal/api/http/middleware"
+	domaincommunity "github.com/yourorg/truthweave/internal/domain/community"
+	"github.com/yourorg/truthweave/internal/infrastructure/dgraph"
 	"github.com/yourorg/truthweave/internal/infrastructure/gemini"
 	"github.com/yourorg/truthweave/internal/infrastructure/postgres"
 	"github.com/yourorg/truthweave/internal/infrastructure/temporal"
+	"github.com/yourorg/truthweave/internal/usecase/analytics"
 	"github.com/yourorg/truthweave/internal/usecase/article"
+	"github.com/yourorg/truthweave/internal/usecase/chat"
+	"github.com/yourorg/truthweave/internal/usecase/community"
+	"github.com/yourorg/truthweave/internal/usecase/dispute"
+	"github.com/yourorg/truthweave/internal/usecase/entity"
+	"github.com/yourorg/truthweave/internal/usecase/graph"
+	"github.com/yourorg/truthweave/internal/usecase/perspective"
+	"github.com/yourorg/truthweave/internal/usecase/review"
+	"github.com/yourorg/truthweave/internal/usecase/search"
 	"github.com/yourorg/truthweave/pkg/config"
 	"github.com/yourorg/truthweave/pkg/logger"
 )
@@ -54,6 +70,25 @@ func main() {
 	// Creăm "Bibliotecarii" care se ocupă de date.
 	newsRepository := postgres.NewPostgresNewsArticleRepository(db)
 	adRepository := postgres.NewPostgresAdvertisementRepository(db)
+	entityRegistry := postgres.NewPostgresEntityRegistryRepository(db)
+	knowledgeBase := postgres.NewPostgresKnowledgeBaseRepository(db)
+	analyticsRollups := postgres.NewPostgresAnalyticsRollupRepository(db)
+	trendSignals := postgres.NewPostgresTrendSignalRepository(db)
+	articleSearch := postgres.NewPostgresArticleSearchRepository(db)
+	chatSessions := postgres.NewPostgresChatSessionRepository(db)
+	editorialReviews := postgres.NewPostgresEditorialReviewRepository(db)
+	readerDisputes := postgres.NewPostgresReaderDisputeRepository(db)
+	communityRatings := postgres.NewPostgresCommunityRatingRepository(db)
+	storyPerspectives := postgres.NewPostgresStoryPerspectiveRepository(db)
+
+	// [RO] 3b. Conectare la Dgraph (Graful de Cunoștințe)
+	dconn, err := grpc.Dial(cfg.DgraphHost, grpc.WithInsecure())
+	if err != nil {
+		appLogger.Error("Eroare Critică: Conexiunea la Dgraph a eșuat", "error", err)
+		return
+	}
+	defer dconn.Close()
+	graphRepository := dgraph.NewDgraphKnowledgeGraphRepository(dgo.NewDgraphClient(api.NewDgraphClient(dconn)))
 
 	// [RO] 4. Conectare la Temporal (Orchestratorul de Procese)
 	tClient, err := client.Dial(client.Options{
@@ -81,11 +116,44 @@ func main() {
 		temporalOrchestrator,
 		aiClient,
 	)
+	entityService := entity.NewEntityResolutionService(entityRegistry, graphRepository, knowledgeBase, aiClient)
+	entityProfileService := entity.NewEntityProfileService(entityRegistry, graphRepository, knowledgeBase)
+	graphExportService := graph.NewGraphExportService(graphRepository)
+	timeSeriesService := analytics.NewAnalyticsTimeSeriesService(analyticsRollups, temporalOrchestrator)
+	trendService := analytics.NewTrendSignalService(trendSignals, temporalOrchestrator)
+	searchService := search.NewHybridSearchService(articleSearch, aiClient)
+	chatService := chat.NewChatSessionService(chatSessions, newsService, aiClient, aiClient)
+	reviewService := review.NewEditorialReviewService(editorialReviews, temporalOrchestrator)
+	disputeService := dispute.NewReaderDisputeService(readerDisputes, graphRepository, temporalOrchestrator, cfg.DisputeReanalysisThreshold)
+	raterTokenSecret := cfg.RaterTokenSecret
+	if raterTokenSecret == "" {
+		// [RO] Fără secret configurat, jetoanele evaluatorilor nu supraviețuiesc repornirii
+		appLogger.Warn("RATER_TOKEN_SECRET lipsește: folosim un secret efemer pentru identitățile evaluatorilor")
+		ephemeral := make([]byte, 32)
+		if _, err := rand.Read(ephemeral); err != nil {
+			appLogger.Error("Eroare Critică: Nu am putut genera secretul evaluatorilor", "error", err)
+			return
+		}
+		raterTokenSecret = hex.EncodeToString(ephemeral)
+	}
+	communityService := community.NewCommunityRatingService(communityRatings, domaincommunity.NewRaterCredentials(raterTokenSecret), temporalOrchestrator)
+	perspectiveService := perspective.NewStoryPerspectiveService(storyPerspectives, temporalOrchestrator)
 
 	// [RO] 7. Configurare Controller HTTP (API)
 	// Pregătim "Recepția" care va răspunde la cererile mobile.
 	httpHandler := server.NewNewsArticleRequestHandlers(newsService)
 	adminHandler := server.NewAdvertisementAdministrationHandlers(adRepository)
+	graphAdminHandler := server.NewGraphAdministrationHandlers(newsService)
+	entityAdminHandler := server.NewEntityAdministrationHandlers(entityService)
+	entityHandler := server.NewEntityRequestHandlers(entityProfileService)
+	graphExportHandler := server.NewGraphExportHandlers(graphExportService)
+	analyticsHandler := server.NewAnalyticsRequestHandlers(timeSeriesService, trendService)
+	searchHandler := server.NewSearchRequestHandlers(searchService)
+	chatHandler := server.NewChatSessionRequestHandlers(chatService)
+	reviewAdminHandler := server.NewEditorialReviewAdministrationHandlers(reviewService)
+	disputeHandler := server.NewReaderDisputeRequestHandlers(disputeService)
+	communityHandler := server.NewCommunityRatingRequestHandlers(communityService)
+	perspectiveHandler := server.NewStoryPerspectiveRequestHandlers(perspectiveService)
 
 	// [RO] 8. Start Server (Cu Middleware Logger)
 	r := gin.New()
@@ -93,7 +161,21 @@ func main() {
 	r.Use(middleware.StructuredLogger(appLogger))
 
 	httpHandler.RegisterAPIEndpoints(r)
+	entityHandler.RegisterAPIEndpoints(r)
+	graphExportHandler.RegisterAPIEndpoints(r)
+	analyticsHandler.RegisterAPIEndpoints(r)
+	searchHandler.RegisterAPIEndpoints(r)
+	chatHandler.RegisterAPIEndpoints(r)
+	disputeHandler.RegisterAPIEndpoints(r)
+	communityHandler.RegisterAPIEndpoints(r)
+	perspectiveHandler.RegisterAPIEndpoints(r)
 	adminHandler.RegisterAdminEndpoints(r)
+	graphAdminHandler.RegisterAdminEndpoints(r)
+	entityAdminHandler.RegisterAdminEndpoints(r)
+	analyticsHandler.RegisterAdminEndpoints(r)
+	reviewAdminHandler.RegisterAdminEndpoints(r)
+	communityHandler.RegisterAdminEndpoints(r)
+	perspectiveHandler.RegisterAdminEndpoints(r)
 
 	appLogger.Info("🚀 Aplicația TruthWeave a pornit cu succes!", "port", cfg.ServerPort)
 	if err := r.Run(":" + cfg.ServerPort); err != nil {
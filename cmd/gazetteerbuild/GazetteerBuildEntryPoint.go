@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/yourorg/truthweave/internal/domain/geo"
+	"github.com/yourorg/truthweave/internal/infrastructure/gazetteer"
+)
+
+// [RO] Punct de Intrare Generare Gazetteer (Natural Earth)
+//
+// Transformă granițele Natural Earth (GeoJSON, descărcate manual) în fișierul gazetteer
+// folosit de worker prin GAZETTEER_PATH. Rulare:
+//
+//	go run ./cmd/gazetteerbuild -countries ne_10m_admin_0_countries.geojson \
+//	    -regions ne_10m_admin_1_states_provinces.geojson -out /data/gazetteer.json
+//
+// Fără -out, fișierul este scris la ieșirea standard.
+func main() {
+	countriesPath := flag.String("countries", "", "GeoJSON admin-0 (țări), obligatoriu")
+	regionsPath := flag.String("regions", "", "GeoJSON admin-1 (regiuni), opțional")
+	outputPath := flag.String("out", "", "Fișierul rezultat (implicit: stdout)")
+	tolerance := flag.Float64("tolerance", 0.05, "Toleranța de simplificare a contururilor, în grade")
+	flag.Parse()
+
+	if *countriesPath == "" {
+		log.Fatal("Eroare Gazetteer: Parametrul -countries este obligatoriu.")
+	}
+	converter := gazetteer.NaturalEarthConverter{SimplifyTolerance: *tolerance}
+
+	// [RO] 1. Țări
+	places := convertFile(*countriesPath, converter.ConvertCountries)
+	log.Printf("🌍 %d țări", len(places))
+
+	// [RO] 2. Regiuni
+	if *regionsPath != "" {
+		regions := convertFile(*regionsPath, converter.ConvertRegions)
+		log.Printf("🗺️  %d regiuni", len(regions))
+		places = append(places, regions...)
+	}
+
+	// [RO] 3. Scriere
+	output := os.Stdout
+	if *outputPath != "" {
+		file, err := os.Create(*outputPath)
+		if err != nil {
+			log.Fatalf("Eroare Gazetteer: %v", err)
+		}
+		defer file.Close()
+		output = file
+	}
+	if err := gazetteer.WriteGazetteer(output, "Natural Earth", places); err != nil {
+		log.Fatalf("Eroare Gazetteer: Scrierea a eșuat: %v", err)
+	}
+}
+
+func convertFile(path string, convert func(reader io.Reader) ([]geo.Place, error)) []geo.Place {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("Eroare Gazetteer: Nu am putut deschide %s: %v", path, err)
+	}
+	defer file.Close()
+
+	places, err := convert(file)
+	if err != nil {
+		log.Fatalf("Eroare Gazetteer: %v", err)
+	}
+	return places
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"io"
+	"log"
+	"strings"
+
+	_ "github.com/lib/pq"
+
+	domainentity "github.com/yourorg/truthweave/internal/domain/entity"
+	"github.com/yourorg/truthweave/internal/infrastructure/postgres"
+	"github.com/yourorg/truthweave/internal/infrastructure/wikidata"
+	"github.com/yourorg/truthweave/pkg/config"
+)
+
+// [RO] Punct de Intrare Import Bază de Cunoștințe (Wikidata offline)
+//
+// Încarcă un dump Wikidata JSON filtrat (persoane, organizații, locuri) dintr-un fișier local,
+// fără niciun apel de rețea. Rulare:
+//
+//	go run ./cmd/kbimport -dump /data/wikidata-filtered.json.gz -lang en,ro
+//
+// Importul este idempotent: rularea din nou actualizează intrările existente.
+func main() {
+	dumpPath := flag.String("dump", "", "Calea către dump-ul Wikidata (.json, .json.gz sau .json.bz2)")
+	languages := flag.String("lang", "en,ro", "Limbile preferate pentru etichete, în ordine")
+	batchSize := flag.Int("batch", 500, "Numărul de intrări per tranzacție")
+	flag.Parse()
+
+	if *dumpPath == "" {
+		log.Fatal("Eroare Import: Parametrul -dump este obligatoriu.")
+	}
+
+	// [RO] 1. Configurare
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Eroare Import: Nu am putut încărca configurările: %v", err)
+	}
+
+	db, err := sql.Open("postgres", cfg.DBURL)
+	if err != nil {
+		log.Fatalf("Eroare Postgres: %v", err)
+	}
+	defer db.Close()
+	knowledgeBase := postgres.NewPostgresKnowledgeBaseRepository(db)
+
+	// [RO] 2. Citire Dump
+	dump, err := wikidata.OpenDump(*dumpPath, strings.Split(*languages, ","))
+	if err != nil {
+		log.Fatalf("Eroare Import: Nu am putut deschide dump-ul: %v", err)
+	}
+	defer dump.Close()
+
+	// [RO] 3. Scriere în Loturi
+	ctx := context.Background()
+	batch := make([]domainentity.KnowledgeBaseEntry, 0, *batchSize)
+	imported := 0
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := knowledgeBase.UpsertKnowledgeBaseEntries(ctx, batch); err != nil {
+			log.Fatalf("Eroare Import: Lotul a eșuat după %d intrări: %v", imported, err)
+		}
+		imported += len(batch)
+		batch = batch[:0]
+		if imported%(*batchSize*100) == 0 {
+			log.Printf("📚 Importate %d intrări...", imported)
+		}
+	}
+
+	for {
+		entry, err := dump.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Eroare Import: %v", err)
+		}
+
+		batch = append(batch, *entry)
+		if len(batch) >= *batchSize {
+			flush()
+		}
+	}
+	flush()
+
+	log.Printf("✅ Import finalizat: %d intrări în baza de cunoștințe.", imported)
+}
@@ -12,14 +12,18 @@ import (
 	"go.temporal.io/sdk/worker"
 	"google.golang.org/grpc"
 
+	"github.com/yourorg/truthweave/internal/domain/review"
 	// "github.com/yourorg/truthweave/internal/infrastructure/arweave"
 	"github.com/yourorg/truthweave/internal/infrastructure/dgraph"
+	"github.com/yourorg/truthweave/internal/infrastructure/gazetteer"
 	"github.com/yourorg/truthweave/internal/infrastructure/gdelt"
 	"github.com/yourorg/truthweave/internal/infrastructure/gemini"
+	"github.com/yourorg/truthweave/internal/infrastructure/nats"
 	"github.com/yourorg/truthweave/internal/infrastructure/postgres"
 
 	// "github.com/yourorg/truthweave/internal/infrastructure/solana"
 	"github.com/yourorg/truthweave/internal/infrastructure/temporal"
+	"github.com/yourorg/truthweave/internal/usecase/entity"
 	"github.com/yourorg/truthweave/pkg/config"
 )
 
@@ -58,9 +62,32 @@ func main() {
 		log.Fatalf("Eroare AI: %v", err)
 	}
 
+	// Entity Resolution (Registru Postgres + Index Trigram Dgraph + Wikidata offline + Embeddings)
+	entityResolver := entity.NewEntityResolutionService(
+		postgres.NewPostgresEntityRegistryRepository(db),
+		dgraphRepo,
+		postgres.NewPostgresKnowledgeBaseRepository(db),
+		aiClient,
+	)
+
+	// Gazetteer Offline (țări + regiuni; fallback de geocodare și geocodare inversă)
+	offlineGazetteer, err := gazetteer.NewOfflineGazetteer(cfg.GazetteerPath)
+	if err != nil {
+		log.Fatalf("Eroare Gazetteer: %v", err)
+	}
+
 	// GDELT (Project V2 Source)
 	gdeltClient := gdelt.NewGDELTAdapter()
 
+	// NATS JetStream (opțional): fără el, semnalele de trend rămân doar în Postgres
+	var eventPublisher *nats.JetStreamEventPublisher
+	if cfg.NATSURL != "" {
+		if eventPublisher, err = nats.NewJetStreamEventPublisher(cfg.NATSURL); err != nil {
+			log.Printf("Avertisment NATS: %v (continuăm fără publicare)", err)
+			eventPublisher = nil
+		}
+	}
+
 	// [RO] 3. Conectare la Temporal Server
 	tClient, err := client.Dial(client.Options{
 		HostPort: cfg.TemporalHost,
@@ -72,7 +99,7 @@ func main() {
 
 	// [RO] 4. Pornire Worker
 	// "truthweave-task-queue" este canalul pe care ascultăm comenzi.
-	w := worker.New(tClient, "truthweave-task-queue", worker.Options{})
+	w := worker.New(tClient, temporal.TaskQueueName, worker.Options{})
 
 	// Instanța care conține metodele ce vor fi executate
 	activities := &temporal.NewsProcessingActivities{
@@ -80,7 +107,26 @@ func main() {
 		KnowledgeGraph:         dgraphRepo,
 		Database:               pgRepo,
 		NewsFetcher:            gdeltClient,
+		Orchestrator:           temporal.NewTemporalOrchestratorClient(tClient),
+		EntityResolver:         entityResolver,
+		Gazetteer:              offlineGazetteer,
+		Analytics:              postgres.NewPostgresAnalyticsRollupRepository(db),
+		Trends:                 postgres.NewPostgresTrendSignalRepository(db),
+		Events:                 eventPublisher,
+		Reviews:                postgres.NewPostgresEditorialReviewRepository(db),
+		Disputes:               postgres.NewPostgresReaderDisputeRepository(db),
+		CommunityRatings:       postgres.NewPostgresCommunityRatingRepository(db),
+		Perspectives:           postgres.NewPostgresStoryPerspectiveRepository(db),
 		DeduplicationThreshold: cfg.DeduplicationThreshold,
+		StoryClusterThreshold:  cfg.StoryClusterThreshold,
+		DisputeThreshold:       cfg.DisputeReanalysisThreshold,
+		ReviewPolicy: review.ReviewPolicy{
+			UncertaintyMargin:         cfg.ReviewUncertaintyMargin,
+			EmotionIntensityThreshold: cfg.ReviewEmotionThreshold,
+			CausalImpactThreshold:     cfg.ReviewCausalThreshold,
+			Timeout:                   cfg.ReviewTimeout,
+			TimeoutAction:             cfg.ReviewTimeoutAction,
+		},
 	}
 
 	// Înregistrăm "Rețetele" (Flow-ul și Activitățile)
@@ -88,6 +134,13 @@ func main() {
 	w.RegisterWorkflow(temporal.GlobalNewsIngestionWorkflow)
 	w.RegisterWorkflow(temporal.CausalChainWorkflow)    // [RO] New: Causal Loop Engine
 	w.RegisterWorkflow(temporal.RebalanceGraphWorkflow) // [RO] New: Retroactive Causality
+	w.RegisterWorkflow(temporal.LinkGraphNodesMigrationWorkflow)
+	w.RegisterWorkflow(temporal.AnalyticsRollupWorkflow)
+	w.RegisterWorkflow(temporal.TrendDetectionWorkflow)
+	w.RegisterWorkflow(temporal.ArticleChunkBackfillWorkflow)
+	w.RegisterWorkflow(temporal.DisputeReanalysisWorkflow)
+	w.RegisterWorkflow(temporal.CommunityScoringWorkflow)
+	w.RegisterWorkflow(temporal.StoryPerspectiveWorkflow)
 	w.RegisterActivity(activities)
 
 	log.Println("👷 Muncitorul TruthWeave este gata de treabă! Aștept comenzi...")
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	domain "github.com/yourorg/truthweave/internal/domain/analytics"
+	"github.com/yourorg/truthweave/internal/usecase/analytics"
+)
+
+// [RO] Manipulator Analitică (Serii de Timp și Trenduri)
+// Emoția și adevărul în timp: global sau pe țară / sector / narațiune; vârfurile de volum.
+type AnalyticsRequestHandlers struct {
+	timeSeriesService *analytics.AnalyticsTimeSeriesService
+	trendService      *analytics.TrendSignalService
+}
+
+// [RO] Constructor Analitică
+func NewAnalyticsRequestHandlers(service *analytics.AnalyticsTimeSeriesService, trendService *analytics.TrendSignalService) *AnalyticsRequestHandlers {
+	return &AnalyticsRequestHandlers{timeSeriesService: service, trendService: trendService}
+}
+
+// [RO] Înregistrare Rute Analitică
+func (handler *AnalyticsRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] GET /analytics/timeseries?granularity=day&country=RO&from=2026-01-01 -> Serie de timp
+		apiGroup.GET("/analytics/timeseries", handler.HandleTimeSeriesRequest)
+
+		// [RO] GET /analytics/trends?hours=24&limit=50 -> Semnale "breaking" recente
+		apiGroup.GET("/analytics/trends", handler.HandleTrendsRequest)
+	}
+}
+
+// [RO] Înregistrare Rute Admin Analitică
+func (handler *AnalyticsRequestHandlers) RegisterAdminEndpoints(router *gin.Engine) {
+	adminGroup := router.Group("/admin")
+	{
+		// [RO] POST /admin/analytics/rollups/schedule -> Pornește cron-ul de agregare (idempotent)
+		adminGroup.POST("/analytics/rollups/schedule", handler.HandleScheduleRollupsRequest)
+
+		// [RO] POST /admin/analytics/rollups/backfill?days=90 -> Reconstruiește istoricul
+		adminGroup.POST("/analytics/rollups/backfill", handler.HandleRollupBackfillRequest)
+
+		// [RO] POST /admin/analytics/trends/schedule -> Pornește cron-ul de detectare a trendurilor
+		adminGroup.POST("/analytics/trends/schedule", handler.HandleScheduleTrendDetectionRequest)
+	}
+}
+
+// [RO] Manipulator: Serie de Timp
+// Dimensiunea se alege prin cel mult unul dintre parametrii country / sector / narrative.
+func (handler *AnalyticsRequestHandlers) HandleTimeSeriesRequest(c *gin.Context) {
+	query := domain.TimeSeriesQuery{Granularity: c.Query("granularity")}
+	for _, dimension := range []string{domain.DimensionCountry, domain.DimensionSector, domain.DimensionNarrative} {
+		value := c.Query(dimension)
+		if value == "" {
+			continue
+		}
+		if query.Dimension != "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Alegeți o singură dimensiune: country, sector sau narrative."})
+			return
+		}
+		query.Dimension, query.Value = dimension, value
+	}
+
+	from, errFrom := parseQueryDate(c.Query("from"), false)
+	to, errTo := parseQueryDate(c.Query("to"), true)
+	if errFrom != nil || errTo != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Datele from/to trebuie să fie YYYY-MM-DD sau RFC3339."})
+		return
+	}
+	query.From, query.To = from, to
+
+	// [RO] Erorile de validare sunt ale clientului (400), restul sunt ale noastre (500).
+	if err := query.Normalize(time.Now()); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	series, err := handler.timeSeriesService.RetrieveTimeSeries(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Eroare la citirea seriei de timp: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Seria de timp nu a putut fi calculată."})
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// [RO] Manipulator: Programare Cron Agregate
+func (handler *AnalyticsRequestHandlers) HandleScheduleRollupsRequest(c *gin.Context) {
+	jobID, err := handler.timeSeriesService.ScheduleRollups(c.Request.Context())
+	if err != nil {
+		log.Printf("Eroare la programarea agregatelor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut programa agregatele."})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"job_id": jobID, "cron": analytics.RollupCronSchedule})
+}
+
+// [RO] Manipulator: Reconstrucție Istoric
+func (handler *AnalyticsRequestHandlers) HandleRollupBackfillRequest(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days <= 0 || days > analytics.MaxRollupBackfillDays {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Parametrul days trebuie să fie între 1 și " + strconv.Itoa(analytics.MaxRollupBackfillDays) + "."})
+		return
+	}
+
+	jobID, err := handler.timeSeriesService.StartRollupBackfill(c.Request.Context(), days)
+	if err != nil {
+		log.Printf("Eroare la pornirea reconstrucției agregatelor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut porni reconstrucția."})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"job_id": jobID, "days": days})
+}
+
+// [RO] Manipulator: Trenduri Recente
+func (handler *AnalyticsRequestHandlers) HandleTrendsRequest(c *gin.Context) {
+	hours := boundedQueryInt(c, "hours", analytics.DefaultTrendHours, analytics.MaxTrendHours)
+	limit := boundedQueryInt(c, "limit", analytics.DefaultTrendLimit, analytics.MaxTrendLimit)
+
+	signals, err := handler.trendService.RetrieveRecentSignals(c.Request.Context(), hours, limit)
+	if err != nil {
+		log.Printf("Eroare la citirea trendurilor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Trendurile nu au putut fi citite."})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"signals": signals})
+}
+
+// [RO] Manipulator: Programare Cron Trenduri
+func (handler *AnalyticsRequestHandlers) HandleScheduleTrendDetectionRequest(c *gin.Context) {
+	jobID, err := handler.trendService.ScheduleTrendDetection(c.Request.Context())
+	if err != nil {
+		log.Printf("Eroare la programarea detectării trendurilor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut programa detectarea trendurilor."})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"job_id": jobID, "cron": analytics.TrendDetectionCronSchedule})
+}
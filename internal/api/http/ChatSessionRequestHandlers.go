@@ -0,0 +1,181 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	domain "github.com/yourorg/truthweave/internal/domain/chat"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
+	"github.com/yourorg/truthweave/internal/usecase/chat"
+)
+
+// [RO] Manipulator Conversații cu Oracolul (Chat cu Memorie)
+// Spre deosebire de POST /chat (o singură întrebare), sesiunea ține minte replicile anterioare.
+type ChatSessionRequestHandlers struct {
+	chatService *chat.ChatSessionService
+}
+
+// [RO] Constructor Controller Conversații
+func NewChatSessionRequestHandlers(service *chat.ChatSessionService) *ChatSessionRequestHandlers {
+	return &ChatSessionRequestHandlers{chatService: service}
+}
+
+// [RO] Înregistrare Rute Conversații
+func (handler *ChatSessionRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] POST /chat/sessions -> Conversație nouă (opțional despre un articol)
+		apiGroup.POST("/chat/sessions", handler.HandleCreateSessionRequest)
+
+		// [RO] GET /chat/sessions/:id -> Istoricul conversației
+		apiGroup.GET("/chat/sessions/:id", handler.HandleGetSessionRequest)
+
+		// [RO] POST /chat/sessions/:id/messages -> Întrebare nouă în conversație
+		apiGroup.POST("/chat/sessions/:id/messages", handler.HandlePostMessageRequest)
+
+		// [RO] POST /chat/sessions/:id/messages/stream -> Același mesaj, răspuns în flux (SSE)
+		apiGroup.POST("/chat/sessions/:id/messages/stream", handler.HandleStreamMessageRequest)
+	}
+}
+
+// [RO] Manipulator: Conversație Nouă
+func (handler *ChatSessionRequestHandlers) HandleCreateSessionRequest(c *gin.Context) {
+	var requestBody struct {
+		ArticleID string `json:"article_id"`
+	}
+	// [RO] Corpul e opțional: fără el, conversația e generală.
+	if c.Request.ContentLength > 0 {
+		if err := c.BindJSON(&requestBody); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Format invalid."})
+			return
+		}
+	}
+	if requestBody.ArticleID != "" {
+		if _, err := uuid.Parse(requestBody.ArticleID); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+			return
+		}
+	}
+
+	session, err := handler.chatService.StartChatSession(c.Request.Context(), requestBody.ArticleID)
+	if err != nil {
+		log.Printf("Eroare la crearea conversației: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Conversația nu a putut fi creată."})
+		return
+	}
+	c.JSON(http.StatusCreated, session)
+}
+
+// [RO] Manipulator: Istoric Conversație
+func (handler *ChatSessionRequestHandlers) HandleGetSessionRequest(c *gin.Context) {
+	sessionID, ok := parseChatSessionID(c)
+	if !ok {
+		return
+	}
+
+	transcript, err := handler.chatService.RetrieveChatTranscript(c.Request.Context(), sessionID)
+	if errors.Is(err, domain.ErrChatSessionNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		log.Printf("Eroare la citirea conversației: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Conversația nu a putut fi citită."})
+		return
+	}
+	c.JSON(http.StatusOK, transcript)
+}
+
+// [RO] Manipulator: Mesaj Nou
+func (handler *ChatSessionRequestHandlers) HandlePostMessageRequest(c *gin.Context) {
+	sessionID, ok := parseChatSessionID(c)
+	if !ok {
+		return
+	}
+
+	var requestBody struct {
+		Content     string `json:"content"`
+		Unsupported string `json:"unsupported"` // flag (implicit) sau remove
+	}
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Format invalid."})
+		return
+	}
+	if _, err := domain.NormalizeMessage(requestBody.Content); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	unsupportedMode, err := grounding.NormalizeUnsupportedMode(requestBody.Unsupported)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	exchange, err := handler.chatService.SendChatMessage(c.Request.Context(), sessionID, requestBody.Content, unsupportedMode)
+	if errors.Is(err, domain.ErrChatSessionNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		log.Printf("Eroare în conversația %s: %v", sessionID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Oracolul nu a putut răspunde."})
+		return
+	}
+	c.JSON(http.StatusOK, exchange)
+}
+
+// [RO] Manipulator: Mesaj Nou, Răspuns în Flux (SSE)
+// Evenimente: `token` pentru fiecare bucată, apoi `done` cu întrebarea reformulată, citările
+// și verificarea propozițiilor (doar marcate: textul a ajuns deja la client).
+// Dacă clientul închide conexiunea, generarea se oprește și replicile nu se salvează.
+func (handler *ChatSessionRequestHandlers) HandleStreamMessageRequest(c *gin.Context) {
+	sessionID, ok := parseChatSessionID(c)
+	if !ok {
+		return
+	}
+
+	var requestBody struct {
+		Content string `json:"content"`
+	}
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Format invalid."})
+		return
+	}
+	if _, err := domain.NormalizeMessage(requestBody.Content); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stream := newServerSentEventStream(c)
+	exchange, err := handler.chatService.StreamChatMessage(c.Request.Context(), sessionID, requestBody.Content, stream.SendToken)
+	if errors.Is(err, domain.ErrChatSessionNotFound) {
+		stream.Fail(http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Eroare în fluxul conversației %s: %v", sessionID, err)
+		stream.Fail(http.StatusInternalServerError, "Oracolul nu a putut răspunde.")
+		return
+	}
+
+	_ = stream.Send(sseEventDone, gin.H{
+		"session_id":        exchange.SessionID,
+		"retrieval_query":   exchange.RetrievalQuery,
+		"citations":         exchange.Citations,
+		"sentences":         exchange.Sentences,
+		"unsupported_count": exchange.UnsupportedCount,
+	})
+}
+
+// [RO] ID-ul conversației din URL (răspunde direct cu 400 dacă e invalid)
+func parseChatSessionID(c *gin.Context) (uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	domain "github.com/yourorg/truthweave/internal/domain/community"
+	"github.com/yourorg/truthweave/internal/usecase/community"
+)
+
+// [RO] Manipulator Evaluări ale Comunității
+//
+// Cititorii evaluează utilitatea și corectitudinea articolelor și a contraargumentelor.
+// Scorul comunității (consens de punte) se calculează periodic și stă lângă scorul modelului.
+type CommunityRatingRequestHandlers struct {
+	ratingService *community.CommunityRatingService
+}
+
+// [RO] Constructor Comunitate
+func NewCommunityRatingRequestHandlers(service *community.CommunityRatingService) *CommunityRatingRequestHandlers {
+	return &CommunityRatingRequestHandlers{ratingService: service}
+}
+
+// [RO] Înregistrare Rute Comunitate
+func (handler *CommunityRatingRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] POST /raters -> Identitate nouă de evaluator (jeton semnat de server)
+		apiGroup.POST("/raters", handler.HandleRegisterRaterRequest)
+
+		// [RO] POST /news/:id/ratings -> Evaluează articolul sau contraargumentul (antet X-Rater-Token)
+		apiGroup.POST("/news/:id/ratings", handler.HandleSubmitRatingRequest)
+
+		// [RO] GET /news/:id/community -> Semnalul de încredere al comunității
+		apiGroup.GET("/news/:id/community", handler.HandleCommunityScoresRequest)
+	}
+}
+
+// [RO] Înregistrare Rute Admin Comunitate
+func (handler *CommunityRatingRequestHandlers) RegisterAdminEndpoints(router *gin.Engine) {
+	adminGroup := router.Group("/admin")
+	{
+		// [RO] POST /admin/community/scoring/schedule -> Pornește cron-ul consensului (idempotent)
+		adminGroup.POST("/community/scoring/schedule", handler.HandleScheduleScoringRequest)
+	}
+}
+
+// [RO] Manipulator: Identitate de Evaluator
+func (handler *CommunityRatingRequestHandlers) HandleRegisterRaterRequest(c *gin.Context) {
+	token, err := handler.ratingService.RegisterRater(c.Request.Context(), c.ClientIP())
+	switch {
+	case errors.Is(err, domain.ErrRaterIssuanceRateLimited):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		log.Printf("Eroare la emiterea identității de evaluator: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Identitatea de evaluator nu a putut fi creată momentan."})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"rater_token": token})
+}
+
+// [RO] Manipulator: Evaluare Nouă
+// Evaluatorul este cel din jetonul X-Rater-Token (emis de POST /raters), nu un câmp din corp.
+func (handler *CommunityRatingRequestHandlers) HandleSubmitRatingRequest(c *gin.Context) {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	raterID, err := handler.ratingService.ResolveRater(c.GetHeader("X-Rater-Token"))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var requestBody struct {
+		ItemType  string `json:"item_type"`
+		Dimension string `json:"dimension"`
+		Rating    string `json:"rating"`
+	}
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "JSON Invalid."})
+		return
+	}
+	if requestBody.ItemType == "" {
+		requestBody.ItemType = domain.ItemArticle
+	}
+
+	rating := domain.Rating{ItemType: requestBody.ItemType, Dimension: requestBody.Dimension, RaterID: raterID, Rating: requestBody.Rating}
+	if err := rating.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	saved, err := handler.ratingService.SubmitRating(c.Request.Context(), articleID, rating)
+	switch {
+	case errors.Is(err, domain.ErrRatedArticleNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		log.Printf("Eroare la salvarea evaluării: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Evaluarea nu a putut fi salvată momentan."})
+		return
+	}
+
+	c.JSON(http.StatusCreated, saved)
+}
+
+// [RO] Manipulator: Scorurile Comunității
+func (handler *CommunityRatingRequestHandlers) HandleCommunityScoresRequest(c *gin.Context) {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	scores, err := handler.ratingService.RetrieveCommunityScores(c.Request.Context(), articleID)
+	if err != nil {
+		log.Printf("Eroare la citirea scorurilor comunității: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Scorurile comunității nu pot fi citite momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"scores": scores})
+}
+
+// [RO] Manipulator: Programare Cron Consens
+func (handler *CommunityRatingRequestHandlers) HandleScheduleScoringRequest(c *gin.Context) {
+	jobID, err := handler.ratingService.ScheduleCommunityScoring(c.Request.Context())
+	if err != nil {
+		log.Printf("Eroare la programarea consensului comunității: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut programa calculul consensului."})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"job_id": jobID, "cron": community.CommunityScoringCronSchedule})
+}
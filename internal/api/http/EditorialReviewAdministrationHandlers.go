@@ -0,0 +1,92 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	domain "github.com/yourorg/truthweave/internal/domain/review"
+	"github.com/yourorg/truthweave/internal/usecase/review"
+)
+
+// [RO] Manipulator Coadă de Verificare Editorială
+//
+// Analizele nesigure sau controversate nu se publică automat: workflow-ul lor așteaptă aici
+// decizia unui editor (approve, edit, reject) până la termen.
+type EditorialReviewAdministrationHandlers struct {
+	reviewService *review.EditorialReviewService
+}
+
+// [RO] Constructor Admin Verificare
+func NewEditorialReviewAdministrationHandlers(service *review.EditorialReviewService) *EditorialReviewAdministrationHandlers {
+	return &EditorialReviewAdministrationHandlers{reviewService: service}
+}
+
+// [RO] Înregistrare Rute Admin Verificare
+func (handler *EditorialReviewAdministrationHandlers) RegisterAdminEndpoints(router *gin.Engine) {
+	adminGroup := router.Group("/admin")
+	{
+		// [RO] GET /admin/review?limit=50 -> Ciornele care așteaptă o decizie
+		adminGroup.GET("/review", handler.HandleListPendingReviewsRequest)
+
+		// [RO] POST /admin/review/:id/decision -> approve / edit / reject
+		adminGroup.POST("/review/:id/decision", handler.HandleEditorialDecisionRequest)
+	}
+}
+
+// [RO] Manipulator: Lista de Așteptare
+func (handler *EditorialReviewAdministrationHandlers) HandleListPendingReviewsRequest(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(review.DefaultPendingLimit)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Parametrul limit trebuie să fie un număr."})
+		return
+	}
+
+	items, err := handler.reviewService.ListPendingReviews(c.Request.Context(), limit)
+	if err != nil {
+		log.Printf("Eroare la citirea cozii de verificare: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Coada de verificare nu poate fi citită momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reviews": items})
+}
+
+// [RO] Manipulator: Decizia Editorului
+// Răspunde cu 202: decizia a fost trimisă workflow-ului, care o aplică și publică (sau nu) articolul.
+func (handler *EditorialReviewAdministrationHandlers) HandleEditorialDecisionRequest(c *gin.Context) {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	var decision domain.EditorialDecision
+	if err := c.BindJSON(&decision); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "JSON Invalid."})
+		return
+	}
+	if err := decision.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := handler.reviewService.SubmitEditorialDecision(c.Request.Context(), articleID, decision)
+	switch {
+	case errors.Is(err, domain.ErrReviewNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, domain.ErrReviewAlreadyResolved):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		log.Printf("Eroare la trimiterea deciziei editoriale: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Decizia nu a putut fi trimisă momentan."})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, item)
+}
@@ -0,0 +1,115 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/usecase/entity"
+)
+
+// [RO] Manipulator Administrare Entități
+//
+// Corecturi manuale ale rezolvării automate: unificarea a două entități care sunt
+// de fapt aceeași (merge) sau separarea unei entități care amestecă doi oameni (split).
+// Fiecare operațiune rămâne în jurnalul de audit.
+type EntityAdministrationHandlers struct {
+	resolutionService *entity.EntityResolutionService
+}
+
+// [RO] Constructor Admin Entități
+func NewEntityAdministrationHandlers(service *entity.EntityResolutionService) *EntityAdministrationHandlers {
+	return &EntityAdministrationHandlers{resolutionService: service}
+}
+
+// [RO] Înregistrare Rute Admin Entități
+func (handler *EntityAdministrationHandlers) RegisterAdminEndpoints(router *gin.Engine) {
+	adminGroup := router.Group("/admin")
+	{
+		// [RO] POST /admin/entities/merge -> Unifică sursa în țintă
+		adminGroup.POST("/entities/merge", handler.HandleMergeEntitiesRequest)
+
+		// [RO] POST /admin/entities/:id/split -> Mută alias-urile date într-o entitate nouă
+		adminGroup.POST("/entities/:id/split", handler.HandleSplitEntityRequest)
+
+		// [RO] GET /admin/entities/:id/audit -> Istoricul unificărilor/separărilor
+		adminGroup.GET("/entities/:id/audit", handler.HandleEntityAuditRequest)
+	}
+}
+
+// [RO] Manipulator: Unificare
+func (handler *EntityAdministrationHandlers) HandleMergeEntitiesRequest(c *gin.Context) {
+	var requestBody struct {
+		SourceID string `json:"source_id"`
+		TargetID string `json:"target_id"`
+		Actor    string `json:"actor"`
+		Reason   string `json:"reason"`
+	}
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "JSON Invalid."})
+		return
+	}
+
+	sourceID, errSource := uuid.Parse(requestBody.SourceID)
+	targetID, errTarget := uuid.Parse(requestBody.TargetID)
+	if errSource != nil || errTarget != nil || requestBody.Actor == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Avem nevoie de source_id, target_id și actor."})
+		return
+	}
+
+	audit, err := handler.resolutionService.MergeEntities(c.Request.Context(), sourceID, targetID, requestBody.Actor, requestBody.Reason)
+	if err != nil {
+		log.Printf("Eroare la unificarea entităților: %v", err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, audit)
+}
+
+// [RO] Manipulator: Separare
+func (handler *EntityAdministrationHandlers) HandleSplitEntityRequest(c *gin.Context) {
+	sourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	var requestBody struct {
+		Aliases []string `json:"aliases"`
+		NewName string   `json:"new_name"`
+		Actor   string   `json:"actor"`
+		Reason  string   `json:"reason"`
+	}
+	if err := c.BindJSON(&requestBody); err != nil || requestBody.Actor == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "JSON Invalid (aliases și actor sunt obligatorii)."})
+		return
+	}
+
+	result, err := handler.resolutionService.SplitEntity(c.Request.Context(), sourceID, requestBody.Aliases, requestBody.NewName, requestBody.Actor, requestBody.Reason)
+	if err != nil {
+		log.Printf("Eroare la separarea entității: %v", err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// [RO] Manipulator: Jurnal Audit
+func (handler *EntityAdministrationHandlers) HandleEntityAuditRequest(c *gin.Context) {
+	entityID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	records, err := handler.resolutionService.RetrieveMergeAudit(c.Request.Context(), entityID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"audit": records})
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	domain "github.com/yourorg/truthweave/internal/domain/entity"
+	"github.com/yourorg/truthweave/internal/usecase/entity"
+)
+
+// [RO] Limite Interogare Entități
+const (
+	defaultEntityProfileDays = 90
+	maxEntityProfileDays     = 365
+	defaultSuggestionLimit   = 10
+	maxSuggestionLimit       = 25
+)
+
+// [RO] Manipulator Cereri Entități (Pagini de Entitate)
+//
+// Expune profilul public al unei persoane/organizații/loc și autocompletarea
+// folosită de ecranul de căutare din aplicația Android.
+type EntityRequestHandlers struct {
+	profileService *entity.EntityProfileService
+}
+
+// [RO] Constructor Controller Entități
+func NewEntityRequestHandlers(service *entity.EntityProfileService) *EntityRequestHandlers {
+	return &EntityRequestHandlers{profileService: service}
+}
+
+// [RO] Înregistrare Rute Entități
+func (handler *EntityRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] GET /entities?q=bid -> Autocompletare (SearchScreen)
+		apiGroup.GET("/entities", handler.HandleEntitySuggestionRequest)
+
+		// [RO] GET /entities/:id?days=90 -> Profil, articole, evoluție, co-apariții
+		apiGroup.GET("/entities/:id", handler.HandleEntityProfileRequest)
+	}
+}
+
+// [RO] Manipulator: Profil Entitate
+func (handler *EntityRequestHandlers) HandleEntityProfileRequest(c *gin.Context) {
+	entityID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	days := boundedQueryInt(c, "days", defaultEntityProfileDays, maxEntityProfileDays)
+
+	profile, err := handler.profileService.RetrieveEntityProfile(c.Request.Context(), entityID, time.Duration(days)*24*time.Hour)
+	switch {
+	case errors.Is(err, domain.ErrEntityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		log.Printf("Eroare la citirea profilului entității %s: %v", entityID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Profilul entității nu a putut fi încărcat momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// [RO] Manipulator: Autocompletare
+func (handler *EntityRequestHandlers) HandleEntitySuggestionRequest(c *gin.Context) {
+	query := c.Query("q")
+	limit := boundedQueryInt(c, "limit", defaultSuggestionLimit, maxSuggestionLimit)
+
+	suggestions, err := handler.profileService.SuggestEntities(c.Request.Context(), query, limit)
+	if err != nil {
+		log.Printf("Eroare la autocompletarea entităților: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Sugestiile nu au putut fi încărcate momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"entities": suggestions})
+}
+
+// [RO] Parametru numeric din URL, cu valoare implicită și plafon
+func boundedQueryInt(c *gin.Context, name string, fallback int, maximum int) int {
+	value, err := strconv.Atoi(c.Query(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	if value > maximum {
+		return maximum
+	}
+	return value
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourorg/truthweave/internal/usecase/article"
+)
+
+// [RO] Manipulator Administrare Graf
+//
+// Operațiuni de mentenanță asupra Grafului de Cunoștințe (Dgraph) și a indexului
+// de căutare al Oracolului, disponibile doar din panoul de control.
+type GraphAdministrationHandlers struct {
+	orchestrationService *article.NewsArticleOrchestrationService
+}
+
+// [RO] Constructor Admin Graf
+func NewGraphAdministrationHandlers(service *article.NewsArticleOrchestrationService) *GraphAdministrationHandlers {
+	return &GraphAdministrationHandlers{orchestrationService: service}
+}
+
+// [RO] Înregistrare Rute Admin Graf
+func (handler *GraphAdministrationHandlers) RegisterAdminEndpoints(router *gin.Engine) {
+	adminGroup := router.Group("/admin")
+	{
+		// [RO] POST /admin/graph/events/:id/rebalance -> Recalculează cauzele unui eveniment
+		adminGroup.POST("/graph/events/:id/rebalance", handler.HandleRebalanceEventRequest)
+
+		// [RO] POST /admin/graph/migrations/link-nodes -> Leagă nodurile vechi Article <-> Event
+		adminGroup.POST("/graph/migrations/link-nodes", handler.HandleLinkNodesMigrationRequest)
+
+		// [RO] POST /admin/search/migrations/chunks -> Indexează pe pasaje articolele vechi
+		adminGroup.POST("/search/migrations/chunks", handler.HandleChunkBackfillRequest)
+	}
+}
+
+// [RO] Manipulator: Rebalansare la Cerere
+func (handler *GraphAdministrationHandlers) HandleRebalanceEventRequest(c *gin.Context) {
+	eventID := c.Param("id")
+
+	if err := handler.orchestrationService.TriggerGraphRebalance(c.Request.Context(), eventID); err != nil {
+		log.Printf("Eroare la programarea rebalansării: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut porni rebalansarea."})
+		return
+	}
+
+	// [RO] Răspuns: 202 Accepted (rularea efectivă are loc în Worker)
+	c.JSON(http.StatusAccepted, gin.H{"event_id": eventID})
+}
+
+// [RO] Manipulator: Migrare Legături Articol <-> Eveniment
+func (handler *GraphAdministrationHandlers) HandleLinkNodesMigrationRequest(c *gin.Context) {
+	response, err := handler.orchestrationService.StartGraphLinkMigration(c.Request.Context())
+	if err != nil {
+		log.Printf("Eroare la pornirea migrării: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut porni migrarea."})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"job_id": response.JobID})
+}
+
+// [RO] Manipulator: Indexarea pe Pasaje a Arhivei
+func (handler *GraphAdministrationHandlers) HandleChunkBackfillRequest(c *gin.Context) {
+	response, err := handler.orchestrationService.StartArticleChunkBackfill(c.Request.Context())
+	if err != nil {
+		log.Printf("Eroare la pornirea indexării pe pasaje: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut porni indexarea."})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"job_id": response.JobID})
+}
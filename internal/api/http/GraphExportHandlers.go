@@ -0,0 +1,115 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	domaingraph "github.com/yourorg/truthweave/internal/domain/graph"
+	"github.com/yourorg/truthweave/internal/usecase/graph"
+)
+
+// [RO] Manipulator Export Graf
+//
+// Descărcarea subgrafului cauzal pentru cercetători și jurnaliști de date
+// (Gephi, Graphviz, NetworkX, procesoare Linked Data).
+type GraphExportHandlers struct {
+	exportService *graph.GraphExportService
+}
+
+// [RO] Constructor Controller Export
+func NewGraphExportHandlers(service *graph.GraphExportService) *GraphExportHandlers {
+	return &GraphExportHandlers{exportService: service}
+}
+
+// [RO] Înregistrare Rute Export
+func (handler *GraphExportHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] GET /graph/export?format=gexf&narrative=...&from=2026-01-01 -> Fișier descărcabil (flux)
+		apiGroup.GET("/graph/export", handler.HandleGraphExportRequest)
+	}
+}
+
+// [RO] Manipulator: Export Subgraf
+func (handler *GraphExportHandlers) HandleGraphExportRequest(c *gin.Context) {
+	format := c.DefaultQuery("format", domaingraph.FormatGraphML)
+	contentType, ok := graph.ContentType(format)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": graph.ErrUnsupportedExportFormat.Error()})
+		return
+	}
+
+	from, errFrom := parseQueryDate(c.Query("from"), false)
+	to, errTo := parseQueryDate(c.Query("to"), true)
+	if errFrom != nil || errTo != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dată invalidă (RFC3339 sau YYYY-MM-DD)."})
+		return
+	}
+
+	filter := domaingraph.ExportFilter{
+		NarrativeID: c.Query("narrative"),
+		RootEventID: c.Query("root_event"),
+		EntityID:    c.Query("entity"),
+		From:        from,
+		To:          to,
+		Depth:       boundedQueryInt(c, "depth", domaingraph.DefaultExportDepth, domaingraph.MaxExportDepth),
+		MaxEvents:   boundedQueryInt(c, "limit", domaingraph.DefaultExportMaxEvents, domaingraph.MaxExportMaxEvents),
+	}
+
+	response := &exportResponseWriter{
+		context:     c,
+		contentType: contentType,
+		filename:    "truthweave-graph." + format,
+	}
+
+	if err := handler.exportService.Export(c.Request.Context(), format, filter, response); err != nil {
+		// [RO] Dacă fluxul a început deja, documentul e trunchiat; nu mai putem schimba statusul.
+		if c.Writer.Written() {
+			log.Printf("Export graf întrerupt: %v", err)
+			return
+		}
+		if errors.Is(err, graph.ErrUnsupportedExportFormat) || errors.Is(err, domaingraph.ErrInvertedExportWindow) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		log.Printf("Eroare la exportul grafului: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Graful nu poate fi exportat momentan."})
+	}
+}
+
+// [RO] Răspuns de Export
+// Antetele de fișier (Content-Type, Content-Disposition) se trimit doar la prima scriere,
+// adică după ce serviciul a primit prima pagină din graf; până atunci putem încă răspunde cu o eroare JSON.
+type exportResponseWriter struct {
+	context     *gin.Context
+	contentType string
+	filename    string
+}
+
+func (response *exportResponseWriter) Write(data []byte) (int, error) {
+	if !response.context.Writer.Written() {
+		response.context.Header("Content-Type", response.contentType)
+		response.context.Header("Content-Disposition", "attachment; filename=\""+response.filename+"\"")
+		response.context.Status(http.StatusOK)
+	}
+	return response.context.Writer.Write(data)
+}
+
+// [RO] Dată din URL: RFC3339 sau doar ziua (YYYY-MM-DD, UTC). Lipsă -> zero (fără limită).
+// Pentru capătul ferestrei (`endOfDay`), o zi simplă include toată ziua.
+func parseQueryDate(value string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil || !endOfDay {
+		return day, err
+	}
+	return day.Add(24*time.Hour - time.Nanosecond), nil
+}
@@ -1,9 +1,15 @@
 package http
 
 import (
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	domain "github.com/yourorg/truthweave/internal/domain/article"
+	domainchat "github.com/yourorg/truthweave/internal/domain/chat"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
 	"github.com/yourorg/truthweave/internal/usecase/article"
 )
 
@@ -33,15 +39,27 @@ func (handler *NewsArticleRequestHandlers) RegisterAPIEndpoints(router *gin.Engi
 		// [RO] GET /news/:id -> Citește o știre analizată
 		apiGroup.GET("/news/:id", handler.HandleGetNewsRequest)
 
-		// [RO] GET /news/feed -> Obține fluxul de noutăți (cu reclame)
+		// [RO] GET /news/feed?country=RO&limit=20&perspectives=true -> Obține fluxul de noutăți (cu reclame)
 		apiGroup.GET("/news/feed", handler.HandleFeedRequest)
 
 		// [RO] POST /chat -> Vorbește cu Oracolul
 		apiGroup.POST("/chat", handler.HandleOracleChatRequest)
 
-		// [RO] GET /oracle/gaia-map -> Harta Adevărului
+		// [RO] POST /chat/stream -> Aceeași întrebare, răspuns în flux (SSE)
+		apiGroup.POST("/chat/stream", handler.HandleOracleChatStreamRequest)
+
+		// [RO] GET /oracle/gaia-map?bbox=minLng,minLat,maxLng,maxLat&zoom=3 -> Harta Adevărului (grupată)
 		apiGroup.GET("/oracle/gaia-map", handler.HandleGaiaMapRequest)
+
+		// [RO] GET /oracle/gaia-map.geojson -> Aceleași filtre, știri individuale (GIS, panouri web)
+		apiGroup.GET("/oracle/gaia-map.geojson", handler.HandleGaiaGeoJSONRequest)
+
+		// [RO] GET /oracle/gaia-map/countries -> Aceleași filtre, agregat pe țări
+		apiGroup.GET("/oracle/gaia-map/countries", handler.HandleGaiaCountriesRequest)
 	}
+
+	// [RO] GET /tiles/{z}/{x}/{y}.mvt -> Plăci vectoriale pentru glob (doar zona vizibilă)
+	router.GET("/tiles/:z/:x/:tile", handler.HandleGaiaVectorTileRequest)
 }
 
 // [RO] Manipulator: Ingestie (Procesare)
@@ -83,15 +101,38 @@ func (handler *NewsArticleRequestHandlers) HandleGetNewsRequest(c *gin.Context)
 			"truth_stats": gin.H{
 				"score":          newsArticle.TruthScore,
 				"bias_direction": newsArticle.BiasRating,
+				"community":      newsArticle.CommunityScores,
+			},
+			"location": gin.H{
+				"country_code": newsArticle.CountryCode,
+				"region_code":  newsArticle.RegionCode,
+			},
+			"sector":           newsArticle.Sector,
+			"counter_argument": newsArticle.CounterArgument,
+			"updated_at":       newsArticle.ProcessedAt,
+			"resolution_notes": newsArticle.ResolutionNotes,
+			// [RO] Suprapunerea "ce am neutralizat": pozițiile (în caractere) se referă la original_text
+			"neutralized": gin.H{
+				"original_text": newsArticle.RawContent,
+				"annotations":   neutralizations(newsArticle.Neutralizations),
 			},
-			"updated_at": newsArticle.ProcessedAt,
 		},
 	})
 }
 
 // [RO] Manipulator: Flux de Știri
 func (handler *NewsArticleRequestHandlers) HandleFeedRequest(c *gin.Context) {
-	feed, err := handler.orchestrationService.GeneratePersonalizedNewsFeed(c.Request.Context())
+	query := domain.NewsFeedQuery{
+		CountryCode:         c.Query("country"),
+		Limit:               boundedQueryInt(c, "limit", domain.DefaultNewsFeedLimit, domain.MaxNewsFeedLimit),
+		IncludePerspectives: c.Query("perspectives") == "true",
+	}
+	if _, err := domain.NormalizeCountryCode(query.CountryCode); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	feed, err := handler.orchestrationService.GeneratePersonalizedNewsFeed(c.Request.Context(), query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -101,7 +142,36 @@ func (handler *NewsArticleRequestHandlers) HandleFeedRequest(c *gin.Context) {
 }
 
 // [RO] Manipulator: Chat Oracle
+// Răspunsul vine cu citări numerotate [n] (fragment + poziții în articol) și verificarea fiecărei propoziții.
 func (handler *NewsArticleRequestHandlers) HandleOracleChatRequest(c *gin.Context) {
+	var requestBody struct {
+		ArticleID   string `json:"article_id"`
+		Question    string `json:"question"`
+		Unsupported string `json:"unsupported"` // flag (implicit) sau remove
+	}
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Format invalid."})
+		return
+	}
+	unsupportedMode, err := grounding.NormalizeUnsupportedMode(requestBody.Unsupported)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := handler.orchestrationService.PerformOracleContextualSearch(c.Request.Context(), requestBody.Question, requestBody.ArticleID, unsupportedMode)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// [RO] Manipulator: Chat Oracle în Flux (SSE)
+// Evenimente: `token` pentru fiecare bucată de răspuns, apoi `done` cu citările și verificarea propozițiilor.
+// Întrebarea trece prin aceeași validare ca mesajele din conversații (NormalizeMessage).
+func (handler *NewsArticleRequestHandlers) HandleOracleChatStreamRequest(c *gin.Context) {
 	var requestBody struct {
 		ArticleID string `json:"article_id"`
 		Question  string `json:"question"`
@@ -110,26 +180,150 @@ func (handler *NewsArticleRequestHandlers) HandleOracleChatRequest(c *gin.Contex
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Format invalid."})
 		return
 	}
+	question, err := domainchat.NormalizeMessage(requestBody.Question)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stream := newServerSentEventStream(c)
+	result, err := handler.orchestrationService.StreamOracleContextualSearch(c.Request.Context(), question, requestBody.ArticleID, stream.SendToken)
+	if err != nil {
+		log.Printf("Eroare în fluxul Oracolului (articol %s): %v", requestBody.ArticleID, err)
+		stream.Fail(http.StatusInternalServerError, "Oracolul nu a putut răspunde.")
+		return
+	}
+	_ = stream.Send(sseEventDone, gin.H{
+		"citations":         result.Citations,
+		"sentences":         result.Sentences,
+		"unsupported_count": result.UnsupportedCount,
+	})
+}
+
+// [RO] Manipulator: Harta Gaia
+func (handler *NewsArticleRequestHandlers) HandleGaiaMapRequest(c *gin.Context) {
+	query, ok := parseGaiaMapQuery(c)
+	if !ok {
+		return
+	}
 
-	answer, citations, err := handler.orchestrationService.PerformOracleContextualSearch(c.Request.Context(), requestBody.Question, requestBody.ArticleID)
+	clusters, err := handler.orchestrationService.AggregateGlobalTruthMap(c.Request.Context(), query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"answer":    answer,
-		"citations": citations,
+		"zoom":          query.Zoom,
+		"cell_size_deg": query.CellSizeDegrees(),
+		"clusters":      clusters,
 	})
 }
 
-// [RO] Manipulator: Harta Gaia
-func (handler *NewsArticleRequestHandlers) HandleGaiaMapRequest(c *gin.Context) {
-	points, err := handler.orchestrationService.AggregateGlobalTruthMap(c.Request.Context())
+// [RO] Manipulator: Harta Gaia (GeoJSON)
+func (handler *NewsArticleRequestHandlers) HandleGaiaGeoJSONRequest(c *gin.Context) {
+	query, ok := parseGaiaMapQuery(c)
+	if !ok {
+		return
+	}
+	limit := boundedQueryInt(c, "limit", domain.DefaultGaiaFeatureLimit, domain.MaxGaiaFeatureLimit)
+
+	collection, err := handler.orchestrationService.ExportGaiaFeatureCollection(c.Request.Context(), query, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, points)
+	c.Header("Content-Type", "application/geo+json")
+	c.JSON(http.StatusOK, collection)
+}
+
+// [RO] Manipulator: Harta Gaia pe Țări
+func (handler *NewsArticleRequestHandlers) HandleGaiaCountriesRequest(c *gin.Context) {
+	query, ok := parseGaiaMapQuery(c)
+	if !ok {
+		return
+	}
+
+	countries, err := handler.orchestrationService.AggregateTruthMapByCountry(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"countries": countries})
+}
+
+// [RO] Manipulator: Placă Vectorială (Mapbox Vector Tile)
+func (handler *NewsArticleRequestHandlers) HandleGaiaVectorTileRequest(c *gin.Context) {
+	tileName, isVectorTile := strings.CutSuffix(c.Param("tile"), ".mvt")
+	z, errZ := strconv.Atoi(c.Param("z"))
+	x, errX := strconv.Atoi(c.Param("x"))
+	y, errY := strconv.Atoi(tileName)
+	tile := domain.GaiaTile{Z: z, X: x, Y: y}
+	if !isVectorTile || errZ != nil || errX != nil || errY != nil || tile.Validate() != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Placă invalidă. Format: /tiles/{z}/{x}/{y}.mvt"})
+		return
+	}
+
+	filter, ok := parseGaiaMapQuery(c)
+	if !ok {
+		return
+	}
+
+	encoded, err := handler.orchestrationService.RenderGaiaVectorTile(c.Request.Context(), tile, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// [RO] Plăcile se schimbă doar când apar știri noi; un cache scurt scutește baza de date la panoramare.
+	c.Header("Cache-Control", "public, max-age=60")
+	c.Data(http.StatusOK, "application/vnd.mapbox-vector-tile", encoded)
+}
+
+// [RO] Parametrii Hărții Gaia din URL
+// Răspunde direct cu 400 (și returnează false) dacă un parametru este invalid.
+func parseGaiaMapQuery(c *gin.Context) (domain.GaiaMapQuery, bool) {
+	query := domain.NewGaiaMapQuery()
+
+	if bbox := c.Query("bbox"); bbox != "" {
+		if err := query.ParseBoundingBox(bbox); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return query, false
+		}
+	}
+
+	if zoom := c.Query("zoom"); zoom != "" {
+		value, err := strconv.Atoi(zoom)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Zoom invalid."})
+			return query, false
+		}
+		query.Zoom = value
+	}
+
+	from, errFrom := parseQueryDate(c.Query("from"), false)
+	to, errTo := parseQueryDate(c.Query("to"), true)
+	if errFrom != nil || errTo != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dată invalidă (RFC3339 sau YYYY-MM-DD)."})
+		return query, false
+	}
+	query.From, query.To = from, to
+	query.Emotion = c.Query("emotion")
+	query.CountryCode = c.Query("country")
+
+	if err := query.Normalize(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return query, false
+	}
+	return query, true
+}
+
+// [RO] Lista goală (nu null) pentru articolele fără fragmente neutralizate
+func neutralizations(annotations []domain.NeutralizationAnnotation) []domain.NeutralizationAnnotation {
+	if annotations == nil {
+		return []domain.NeutralizationAnnotation{}
+	}
+	return annotations
 }
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	domain "github.com/yourorg/truthweave/internal/domain/dispute"
+	"github.com/yourorg/truthweave/internal/usecase/dispute"
+)
+
+// [RO] Manipulator Contestații ale Cititorilor
+//
+// Cititorii contestă scorul de adevăr, ratingul de părtinire sau o legătură cauzală, cu motiv
+// și dovezi. Peste prag, articolul este reanalizat, iar rezultatul apare ca notă de rezolvare.
+type ReaderDisputeRequestHandlers struct {
+	disputeService *dispute.ReaderDisputeService
+}
+
+// [RO] Constructor Contestații
+func NewReaderDisputeRequestHandlers(service *dispute.ReaderDisputeService) *ReaderDisputeRequestHandlers {
+	return &ReaderDisputeRequestHandlers{disputeService: service}
+}
+
+// [RO] Înregistrare Rute Contestații
+func (handler *ReaderDisputeRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] POST /news/:id/disputes -> Contestă analiza articolului
+		apiGroup.POST("/news/:id/disputes", handler.HandleSubmitDisputeRequest)
+
+		// [RO] GET /news/:id/disputes -> Contestațiile deschise și notele de rezolvare
+		apiGroup.GET("/news/:id/disputes", handler.HandleDisputeOverviewRequest)
+	}
+}
+
+// [RO] Manipulator: Contestație Nouă
+func (handler *ReaderDisputeRequestHandlers) HandleSubmitDisputeRequest(c *gin.Context) {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	var requestBody domain.Dispute
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "JSON Invalid."})
+		return
+	}
+
+	// [RO] Starea, ID-ul și data le decide serviciul; din corp luăm doar contestația
+	submitted := domain.Dispute{
+		Target:       requestBody.Target,
+		CauseEventID: requestBody.CauseEventID,
+		Reason:       requestBody.Reason,
+		EvidenceURLs: requestBody.EvidenceURLs,
+		SubmitterID:  domain.SubmitterFingerprint(c.ClientIP()),
+	}
+	if err := submitted.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	receipt, err := handler.disputeService.SubmitDispute(c.Request.Context(), articleID, submitted)
+	switch {
+	case errors.Is(err, domain.ErrDisputedArticleNotFound), errors.Is(err, domain.ErrUnknownCausalLink):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, domain.ErrDisputeRateLimited):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		log.Printf("Eroare la salvarea contestației: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Contestația nu a putut fi salvată momentan."})
+		return
+	}
+
+	c.JSON(http.StatusCreated, receipt)
+}
+
+// [RO] Manipulator: Situația Contestațiilor
+func (handler *ReaderDisputeRequestHandlers) HandleDisputeOverviewRequest(c *gin.Context) {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	overview, err := handler.disputeService.RetrieveDisputeOverview(c.Request.Context(), articleID)
+	if err != nil {
+		log.Printf("Eroare la citirea contestațiilor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Contestațiile nu pot fi citite momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, overview)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domain "github.com/yourorg/truthweave/internal/domain/search"
+	"github.com/yourorg/truthweave/internal/usecase/search"
+)
+
+// [RO] Manipulator Căutare (SearchScreen)
+// Căutare hibridă în arhiva de știri: cuvinte + înțeles, cu filtre și paginare.
+type SearchRequestHandlers struct {
+	searchService *search.HybridSearchService
+}
+
+// [RO] Constructor Controller Căutare
+func NewSearchRequestHandlers(service *search.HybridSearchService) *SearchRequestHandlers {
+	return &SearchRequestHandlers{searchService: service}
+}
+
+// [RO] Înregistrare Rute Căutare
+func (handler *SearchRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] GET /search?q=inflatie&source=reuters.com&min_truth=0.7&page=2 -> Rezultate cu fragmente evidențiate
+		apiGroup.GET("/search", handler.HandleSearchRequest)
+	}
+}
+
+// [RO] Manipulator: Căutare
+func (handler *SearchRequestHandlers) HandleSearchRequest(c *gin.Context) {
+	query := domain.SearchQuery{
+		Text:     c.Query("q"),
+		Source:   c.Query("source"),
+		Emotion:  c.Query("emotion"),
+		Page:     boundedQueryInt(c, "page", 1, domain.MaxPage),
+		PageSize: boundedQueryInt(c, "page_size", domain.DefaultPageSize, domain.MaxPageSize),
+	}
+
+	from, errFrom := parseQueryDate(c.Query("from"), false)
+	to, errTo := parseQueryDate(c.Query("to"), true)
+	if errFrom != nil || errTo != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Dată invalidă (RFC3339 sau YYYY-MM-DD)."})
+		return
+	}
+	query.From, query.To = from, to
+
+	for name, target := range map[string]**float64{"min_truth": &query.MinTruthScore, "max_truth": &query.MaxTruthScore} {
+		raw := c.Query(name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Parametrul " + name + " trebuie să fie un număr între 0 și 1."})
+			return
+		}
+		*target = &value
+	}
+
+	// [RO] Erorile de validare sunt ale clientului (400), restul sunt ale noastre (500).
+	if err := query.Normalize(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := handler.searchService.SearchNewsArticles(c.Request.Context(), query)
+	if err != nil {
+		log.Printf("Eroare la căutare: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Căutarea nu a putut fi efectuată."})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
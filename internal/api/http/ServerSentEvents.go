@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// [RO] Evenimentele fluxului de răspuns (SSE)
+const (
+	sseEventToken = "token" // {"text": "..."} - o bucată din răspuns
+	sseEventDone  = "done"  // Ultimul eveniment: citările (și restul metadatelor)
+	sseEventError = "error" // Eroare apărută după ce fluxul a început
+)
+
+// [RO] Flux Server-Sent Events
+// Antetele se trimit abia la primul eveniment: o eroare apărută înainte (sesiune inexistentă,
+// căutare eșuată) primește încă un răspuns JSON obișnuit, cu codul HTTP potrivit.
+type serverSentEventStream struct {
+	c       *gin.Context
+	started bool
+}
+
+func newServerSentEventStream(c *gin.Context) *serverSentEventStream {
+	return &serverSentEventStream{c: c}
+}
+
+// [RO] Trimite un eveniment și golește imediat bufferul (altfel proxy-urile adună tot răspunsul).
+// Returnează eroarea contextului dacă clientul s-a deconectat, ca generarea să se oprească.
+func (stream *serverSentEventStream) Send(event string, payload interface{}) error {
+	if err := stream.c.Request.Context().Err(); err != nil {
+		return err
+	}
+	if !stream.started {
+		header := stream.c.Writer.Header()
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-cache")
+		header.Set("Connection", "keep-alive")
+		header.Set("X-Accel-Buffering", "no")
+		stream.c.Status(http.StatusOK)
+		stream.started = true
+	}
+	stream.c.SSEvent(event, payload)
+	stream.c.Writer.Flush()
+	return nil
+}
+
+// [RO] Trimite o bucată de răspuns
+func (stream *serverSentEventStream) SendToken(token string) error {
+	return stream.Send(sseEventToken, gin.H{"text": token})
+}
+
+// [RO] Încheie fluxul cu o eroare
+// Înainte de primul eveniment: JSON cu `status`; după: evenimentul `error`.
+// La deconectare nu mai are cui răspunde.
+func (stream *serverSentEventStream) Fail(status int, message string) {
+	if stream.c.Request.Context().Err() != nil {
+		return
+	}
+	if !stream.started {
+		stream.c.JSON(status, gin.H{"error": message})
+		return
+	}
+	_ = stream.Send(sseEventError, gin.H{"error": message})
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	domain "github.com/yourorg/truthweave/internal/domain/perspective"
+	"github.com/yourorg/truthweave/internal/usecase/perspective"
+)
+
+// [RO] Manipulator Perspective ale Poveștii
+//
+// Punctele de vedere etichetate ale unei povești (grup de articole), fiecare cu sursele din corpus,
+// plus istoricul reviziilor. În feed, aceleași perspective apar cu `perspectives=true`.
+type StoryPerspectiveRequestHandlers struct {
+	perspectiveService *perspective.StoryPerspectiveService
+}
+
+// [RO] Constructor Perspective
+func NewStoryPerspectiveRequestHandlers(service *perspective.StoryPerspectiveService) *StoryPerspectiveRequestHandlers {
+	return &StoryPerspectiveRequestHandlers{perspectiveService: service}
+}
+
+// [RO] Înregistrare Rute Perspective
+func (handler *StoryPerspectiveRequestHandlers) RegisterAPIEndpoints(router *gin.Engine) {
+	apiGroup := router.Group("/api/v1")
+	{
+		// [RO] GET /stories/:id/perspectives?revision=N -> Perspectivele (implicit ultima revizie)
+		apiGroup.GET("/stories/:id/perspectives", handler.HandlePerspectivesRequest)
+
+		// [RO] GET /stories/:id/perspectives/revisions -> Istoricul reviziilor
+		apiGroup.GET("/stories/:id/perspectives/revisions", handler.HandleRevisionsRequest)
+	}
+}
+
+// [RO] Înregistrare Rute Admin Perspective
+func (handler *StoryPerspectiveRequestHandlers) RegisterAdminEndpoints(router *gin.Engine) {
+	adminGroup := router.Group("/admin")
+	{
+		// [RO] POST /admin/stories/:id/perspectives/refresh -> Regenerare (doar dacă articolele s-au schimbat)
+		adminGroup.POST("/stories/:id/perspectives/refresh", handler.HandleRefreshRequest)
+	}
+}
+
+// [RO] Manipulator: Perspectivele Poveștii
+func (handler *StoryPerspectiveRequestHandlers) HandlePerspectivesRequest(c *gin.Context) {
+	storyClusterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+	revision, err := strconv.Atoi(c.DefaultQuery("revision", "0"))
+	if err != nil || revision < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Parametrul revision trebuie să fie un număr pozitiv."})
+		return
+	}
+
+	set, err := handler.perspectiveService.RetrievePerspectives(c.Request.Context(), storyClusterID, revision)
+	switch {
+	case errors.Is(err, domain.ErrPerspectivesNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		log.Printf("Eroare la citirea perspectivelor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Perspectivele nu pot fi citite momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, set)
+}
+
+// [RO] Manipulator: Istoricul Reviziilor
+func (handler *StoryPerspectiveRequestHandlers) HandleRevisionsRequest(c *gin.Context) {
+	storyClusterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(domain.DefaultRevisionLimit)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Parametrul limit trebuie să fie un număr."})
+		return
+	}
+
+	revisions, err := handler.perspectiveService.ListRevisions(c.Request.Context(), storyClusterID, limit)
+	if err != nil {
+		log.Printf("Eroare la citirea reviziilor perspectivelor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Istoricul perspectivelor nu poate fi citit momentan."})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+// [RO] Manipulator: Regenerare Manuală
+func (handler *StoryPerspectiveRequestHandlers) HandleRefreshRequest(c *gin.Context) {
+	storyClusterID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID Invalid."})
+		return
+	}
+
+	jobID, err := handler.perspectiveService.RefreshPerspectives(c.Request.Context(), storyClusterID)
+	if err != nil {
+		log.Printf("Eroare la regenerarea perspectivelor: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Nu am putut porni regenerarea perspectivelor."})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"job_id": jobID})
+}
@@ -0,0 +1,42 @@
+package analytics
+
+import (
+	"context"
+	"time"
+)
+
+// [RO] Fereastra implicită de recalculare a agregatelor
+// Acoperă ziua precedentă, ca articolele întârziate de după miezul nopții să ajungă în agregatul corect.
+const DefaultRollupLookback = 24 * time.Hour
+
+// [RO] Fereastra de Recalculare
+// Aliniată la zile întregi (UTC), ca atât intervalele orare, cât și cele zilnice să fie recalculate complet:
+// [începutul zilei lui now-lookback, începutul zilei de mâine).
+func RollupWindow(now time.Time, lookback time.Duration) (time.Time, time.Time) {
+	if lookback <= 0 {
+		lookback = DefaultRollupLookback
+	}
+	day := TimeSeriesQuery{Granularity: GranularityDay}
+	return day.Truncate(now.Add(-lookback)), day.Truncate(now).Add(24 * time.Hour)
+}
+
+// [RO] Interfața Agregatelor Analitice (Postgres)
+//
+// Serii de timp pre-calculate (pe oră și pe zi) pentru numărul de articole, scorul de adevăr
+// și emoția globală, ca API-ul să nu scaneze tot tabelul de articole.
+type AnalyticsRollupPersistenceInterface interface {
+	// [RO] Recalculează agregatele global / țară / sector din [from, to)
+	RefreshRollups(ctx context.Context, from time.Time, to time.Time) error
+
+	// [RO] Recalculează agregatele pe narațiuni (narrative.id -> grupurile de poveste ale narațiunii)
+	RefreshNarrativeRollups(ctx context.Context, narratives map[string][]string, from time.Time, to time.Time) error
+
+	// [RO] Citește intervalele stocate (doar cele cu articole, în ordine cronologică)
+	RetrieveTimeSeries(ctx context.Context, query TimeSeriesQuery) ([]TimeSeriesBucket, error)
+}
+
+// [RO] Sursa Narațiunilor (Graful de Cunoștințe)
+// O narațiune pornește dintr-un eveniment rădăcină; grupurile ei sunt poveștile evenimentelor declanșate.
+type NarrativeSourceInterface interface {
+	RetrieveNarrativeStoryClusters(ctx context.Context) (map[string][]string, error)
+}
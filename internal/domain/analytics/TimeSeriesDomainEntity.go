@@ -0,0 +1,204 @@
+package analytics
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+// [RO] Granularitatea Seriei de Timp
+const (
+	GranularityHour = "hour"
+	GranularityDay  = "day"
+)
+
+// [RO] Dimensiunile de Agregare
+const (
+	DimensionGlobal    = "global"    // Toate articolele
+	DimensionCountry   = "country"   // Cod ISO 3166-1 alpha-2 (ex: "RO")
+	DimensionSector    = "sector"    // Vezi article.Sectors()
+	DimensionNarrative = "narrative" // narrative.id din graf
+)
+
+// [RO] Valoarea dimensiunii globale (cheia primară nu acceptă NULL)
+const GlobalDimensionValue = "*"
+
+// [RO] Limite Serie de Timp
+const (
+	DefaultHourlyWindow = 48 * time.Hour
+	DefaultDailyWindow  = 30 * 24 * time.Hour
+
+	// [RO] Câte intervale returnăm cel mult (≈ 31 zile orare sau 2 ani zilnici)
+	MaxTimeSeriesBuckets = 750
+)
+
+// [RO] Cererea Seriei de Timp
+// Intervalul [From, To] este aliniat la începutul intervalelor (UTC).
+type TimeSeriesQuery struct {
+	Granularity string
+	Dimension   string
+	Value       string
+	From        time.Time
+	To          time.Time
+}
+
+// [RO] Un Interval din Serie
+// Scorul mediu se calculează din suma stocată (media mediilor orare ar fi greșită).
+type TimeSeriesBucket struct {
+	BucketStart       time.Time      `json:"bucket_start"`
+	ArticleCount      int            `json:"article_count"`
+	AverageTruthScore float64        `json:"avg_truth_score"`
+	DominantEmotion   string         `json:"dominant_emotion,omitempty"`
+	EmotionCounts     map[string]int `json:"emotion_counts"`
+}
+
+// [RO] Seria de Timp (răspunsul API)
+type TimeSeries struct {
+	Granularity string             `json:"granularity"`
+	Dimension   string             `json:"dimension"`
+	Value       string             `json:"value,omitempty"`
+	From        time.Time          `json:"from"`
+	To          time.Time          `json:"to"`
+	Buckets     []TimeSeriesBucket `json:"buckets"`
+}
+
+// [RO] Validare și Valori Implicite
+// Fără fereastră: ultimele 48 de ore (orar) sau 30 de zile (zilnic), până la `now`.
+func (query *TimeSeriesQuery) Normalize(now time.Time) error {
+	query.Granularity = strings.ToLower(strings.TrimSpace(query.Granularity))
+	switch query.Granularity {
+	case "":
+		query.Granularity = GranularityDay
+	case GranularityHour, GranularityDay:
+	default:
+		return errors.New("[RO] Eroare: Granularitatea trebuie să fie hour sau day.")
+	}
+
+	if err := query.normalizeDimension(); err != nil {
+		return err
+	}
+
+	if query.To.IsZero() {
+		query.To = now
+	}
+	if query.From.IsZero() {
+		window := DefaultDailyWindow
+		if query.Granularity == GranularityHour {
+			window = DefaultHourlyWindow
+		}
+		query.From = query.To.Add(-window)
+	}
+	query.From, query.To = query.Truncate(query.From), query.Truncate(query.To)
+	if query.To.Before(query.From) {
+		return errors.New("[RO] Eroare: Fereastra de timp este inversată (to < from).")
+	}
+	if len(query.BucketStarts()) > MaxTimeSeriesBuckets {
+		return errors.New("[RO] Eroare: Fereastra este prea mare pentru granularitatea cerută.")
+	}
+	return nil
+}
+
+func (query *TimeSeriesQuery) normalizeDimension() error {
+	query.Dimension = strings.ToLower(strings.TrimSpace(query.Dimension))
+	query.Value = strings.TrimSpace(query.Value)
+
+	switch query.Dimension {
+	case "", DimensionGlobal:
+		query.Dimension, query.Value = DimensionGlobal, GlobalDimensionValue
+		return nil
+	case DimensionCountry:
+		countryCode, err := article.NormalizeCountryCode(query.Value)
+		if err != nil {
+			return err
+		}
+		query.Value = countryCode
+	case DimensionSector:
+		query.Value = article.NormalizeSector(query.Value)
+		if query.Value == "" {
+			return errors.New("[RO] Eroare: Sector necunoscut.")
+		}
+	case DimensionNarrative:
+	default:
+		return errors.New("[RO] Eroare: Dimensiunea trebuie să fie global, country, sector sau narrative.")
+	}
+
+	if query.Value == "" {
+		return errors.New("[RO] Eroare: Dimensiunea cere o valoare (ex: country=RO).")
+	}
+	return nil
+}
+
+// [RO] Pasul dintre Intervale
+func (query TimeSeriesQuery) Step() time.Duration {
+	if query.Granularity == GranularityHour {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// [RO] Începutul Intervalului care conține momentul dat (UTC)
+func (query TimeSeriesQuery) Truncate(moment time.Time) time.Time {
+	moment = moment.UTC()
+	if query.Granularity == GranularityHour {
+		return moment.Truncate(time.Hour)
+	}
+	return time.Date(moment.Year(), moment.Month(), moment.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// [RO] Toate Începuturile de Interval din fereastră (inclusiv To)
+func (query TimeSeriesQuery) BucketStarts() []time.Time {
+	var starts []time.Time
+	for moment := query.From; !moment.After(query.To); moment = moment.Add(query.Step()) {
+		starts = append(starts, moment)
+		if len(starts) > MaxTimeSeriesBuckets {
+			break
+		}
+	}
+	return starts
+}
+
+// [RO] Completarea Golurilor
+// Agregatele stochează doar intervalele cu articole; graficele au nevoie și de zerouri.
+func FillTimeSeriesGaps(query TimeSeriesQuery, stored []TimeSeriesBucket) []TimeSeriesBucket {
+	byStart := make(map[time.Time]TimeSeriesBucket, len(stored))
+	for _, bucket := range stored {
+		byStart[bucket.BucketStart.UTC()] = bucket
+	}
+
+	starts := query.BucketStarts()
+	buckets := make([]TimeSeriesBucket, 0, len(starts))
+	for _, start := range starts {
+		bucket, ok := byStart[start]
+		if !ok {
+			bucket = TimeSeriesBucket{}
+		}
+		bucket.BucketStart = start
+		if bucket.EmotionCounts == nil {
+			bucket.EmotionCounts = map[string]int{}
+		}
+		bucket.DominantEmotion = DominantEmotion(bucket.EmotionCounts)
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// [RO] Emoția Dominantă
+// Cea mai frecventă; la egalitate câștigă prima în ordine alfabetică (rezultat stabil).
+func DominantEmotion(counts map[string]int) string {
+	emotions := make([]string, 0, len(counts))
+	for emotion := range counts {
+		emotions = append(emotions, emotion)
+	}
+	sort.Strings(emotions)
+
+	dominant, best := "", 0
+	for _, emotion := range emotions {
+		if counts[emotion] > best {
+			dominant, best = emotion, counts[emotion]
+		}
+	}
+	return dominant
+}
@@ -0,0 +1,75 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2026, 3, 21, 10, 30, 0, 0, time.UTC)
+
+func TestTimeSeriesQuery_NormalizeDefaults(t *testing.T) {
+	query := TimeSeriesQuery{}
+	require.NoError(t, query.Normalize(testNow))
+	assert.Equal(t, GranularityDay, query.Granularity)
+	assert.Equal(t, DimensionGlobal, query.Dimension)
+	assert.Equal(t, GlobalDimensionValue, query.Value)
+	assert.Equal(t, time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC), query.From)
+	assert.Equal(t, time.Date(2026, 3, 21, 0, 0, 0, 0, time.UTC), query.To)
+	assert.Len(t, query.BucketStarts(), 31)
+
+	hourly := TimeSeriesQuery{Granularity: "HOUR", Dimension: DimensionCountry, Value: "ro"}
+	require.NoError(t, hourly.Normalize(testNow))
+	assert.Equal(t, "RO", hourly.Value)
+	assert.Equal(t, time.Date(2026, 3, 21, 10, 0, 0, 0, time.UTC), hourly.To)
+	assert.Len(t, hourly.BucketStarts(), 49)
+
+	sector := TimeSeriesQuery{Dimension: DimensionSector, Value: "Business"}
+	require.NoError(t, sector.Normalize(testNow))
+	assert.Equal(t, "economy", sector.Value)
+}
+
+func TestTimeSeriesQuery_NormalizeRejectsInvalid(t *testing.T) {
+	invalid := []TimeSeriesQuery{
+		{Granularity: "minute"},
+		{Dimension: "planet", Value: "Mars"},
+		{Dimension: DimensionCountry, Value: "ROU"},
+		{Dimension: DimensionSector, Value: "astrology"},
+		{Dimension: DimensionNarrative},
+		{From: testNow, To: testNow.Add(-48 * time.Hour)},
+		{Granularity: GranularityHour, From: testNow.Add(-60 * 24 * time.Hour), To: testNow},
+	}
+	for _, query := range invalid {
+		assert.Error(t, query.Normalize(testNow), "%+v", query)
+	}
+}
+
+func TestFillTimeSeriesGaps(t *testing.T) {
+	query := TimeSeriesQuery{Granularity: GranularityDay, From: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)}
+	stored := []TimeSeriesBucket{{
+		BucketStart:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		ArticleCount:      3,
+		AverageTruthScore: 0.7,
+		EmotionCounts:     map[string]int{"Fear": 1, "Anger": 1, "Joy": 1},
+	}}
+
+	buckets := FillTimeSeriesGaps(query, stored)
+	require.Len(t, buckets, 3)
+	assert.Equal(t, 0, buckets[0].ArticleCount)
+	assert.NotNil(t, buckets[0].EmotionCounts)
+	assert.Equal(t, "", buckets[0].DominantEmotion)
+	assert.Equal(t, 3, buckets[1].ArticleCount)
+	assert.Equal(t, "Anger", buckets[1].DominantEmotion) // [RO] Egalitate: ordinea alfabetică
+	assert.Equal(t, time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), buckets[2].BucketStart)
+}
+
+func TestRollupWindow(t *testing.T) {
+	from, to := RollupWindow(testNow, 0)
+	assert.Equal(t, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2026, 3, 22, 0, 0, 0, 0, time.UTC), to)
+
+	from, _ = RollupWindow(testNow, 90*24*time.Hour)
+	assert.Equal(t, time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC), from)
+}
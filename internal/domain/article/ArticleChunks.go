@@ -0,0 +1,134 @@
+package article
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
+)
+
+// [RO] Parametrii Indexului pe Pasaje (Chunks)
+const (
+	// [RO] Lungimea țintă a unui pasaj (caractere). Pasajele se taie la final de propoziție;
+	// o singură propoziție mai lungă devine pasaj întreg.
+	ChunkTargetRunes = 1000
+
+	// [RO] Cât text se repetă între pasaje vecine, ca o afirmație de la graniță să nu se piardă
+	ChunkOverlapRunes = 200
+
+	// [RO] Articolele foarte lungi (transcrieri, rapoarte) sunt indexate doar până aici
+	MaxChunksPerArticle = 64
+)
+
+// [RO] Pasaj Indexat al unui Articol
+// Start/End sunt poziții în caractere în conținutul articolului: [Start, End).
+type ArticleChunk struct {
+	ArticleID uuid.UUID
+	Index     int // 0, 1, 2... în ordinea din text
+	Text      string
+	Start     int
+	End       int
+	Embedding []float32
+}
+
+// [RO] Pasaj Găsit la Căutarea Vectorială
+type ArticleChunkMatch struct {
+	Chunk      ArticleChunk
+	Title      string // Titlul articolului părinte
+	Similarity float64
+}
+
+// [RO] Pasajele Găsite, Grupate pe Articol
+// Similarity este cea a celui mai bun pasaj; pasajele sunt în ordinea din text.
+type ArticleChunkGroup struct {
+	ArticleID  uuid.UUID
+	Title      string
+	Similarity float64
+	Chunks     []ArticleChunk
+}
+
+// [RO] Împarte Conținutul în Pasaje Suprapuse
+// Propoziții consecutive până la ChunkTargetRunes; următorul pasaj reia propozițiile din ultimele
+// ChunkOverlapRunes caractere ale celui anterior.
+func SplitArticleIntoChunks(articleID uuid.UUID, content string) []ArticleChunk {
+	runes := []rune(content)
+	sentences := grounding.SplitSentences(content)
+
+	var chunks []ArticleChunk
+	for first := 0; first < len(sentences) && len(chunks) < MaxChunksPerArticle; {
+		last := first
+		for last+1 < len(sentences) && sentences[last+1].End-sentences[first].Start <= ChunkTargetRunes {
+			last++
+		}
+
+		start, end := sentences[first].Start, sentences[last].End
+		chunks = append(chunks, ArticleChunk{
+			ArticleID: articleID,
+			Index:     len(chunks),
+			Text:      string(runes[start:end]),
+			Start:     start,
+			End:       end,
+		})
+		if last == len(sentences)-1 {
+			break
+		}
+
+		// [RO] Următorul pasaj începe cu propozițiile care încap în suprapunere (dar înaintează mereu)
+		next := last + 1
+		for next-1 > first && end-sentences[next-1].Start <= ChunkOverlapRunes {
+			next--
+		}
+		first = next
+	}
+	return chunks
+}
+
+// [RO] Grupează Pasajele Găsite pe Articolul Părinte
+// Articolele sunt ordonate după cel mai bun pasaj; din fiecare păstrăm cel mult `chunksPerArticle`
+// pasaje (cele mai apropiate), iar pasajele suprapuse ale aceluiași articol sunt unite.
+func GroupChunkMatches(matches []ArticleChunkMatch, maxArticles int, chunksPerArticle int) []ArticleChunkGroup {
+	sorted := append([]ArticleChunkMatch(nil), matches...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Similarity > sorted[j].Similarity })
+
+	var groups []ArticleChunkGroup
+	indexByArticle := map[uuid.UUID]int{}
+	for _, match := range sorted {
+		position, known := indexByArticle[match.Chunk.ArticleID]
+		if !known {
+			if len(groups) == maxArticles {
+				continue
+			}
+			position = len(groups)
+			indexByArticle[match.Chunk.ArticleID] = position
+			groups = append(groups, ArticleChunkGroup{ArticleID: match.Chunk.ArticleID, Title: match.Title, Similarity: match.Similarity})
+		}
+		if len(groups[position].Chunks) < chunksPerArticle {
+			groups[position].Chunks = append(groups[position].Chunks, match.Chunk)
+		}
+	}
+
+	for i := range groups {
+		groups[i].Chunks = mergeOverlappingChunks(groups[i].Chunks)
+	}
+	return groups
+}
+
+// [RO] Unește pasajele care se suprapun sau se ating (în ordinea din text)
+func mergeOverlappingChunks(chunks []ArticleChunk) []ArticleChunk {
+	sort.Slice(chunks, func(i, j int) bool { return chunks[i].Start < chunks[j].Start })
+
+	var merged []ArticleChunk
+	for _, chunk := range chunks {
+		if len(merged) == 0 || chunk.Start > merged[len(merged)-1].End {
+			merged = append(merged, chunk)
+			continue
+		}
+		previous := &merged[len(merged)-1]
+		if chunk.End > previous.End {
+			tail := []rune(chunk.Text)[previous.End-chunk.Start:]
+			previous.Text += string(tail)
+			previous.End = chunk.End
+		}
+	}
+	return merged
+}
@@ -0,0 +1,69 @@
+package article
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func longArticle(sentences int) string {
+	var builder strings.Builder
+	for i := 0; i < sentences; i++ {
+		builder.WriteString(fmt.Sprintf("Sentence number %d describes one more detail of the long investigation. ", i))
+	}
+	return strings.TrimSpace(builder.String())
+}
+
+func TestSplitArticleIntoChunks_OverlapsAndKeepsOffsets(t *testing.T) {
+	content := longArticle(60)
+	runes := []rune(content)
+
+	chunks := SplitArticleIntoChunks(uuid.New(), content)
+
+	require.Greater(t, len(chunks), 2)
+	for i, chunk := range chunks {
+		assert.Equal(t, i, chunk.Index)
+		assert.Equal(t, chunk.Text, string(runes[chunk.Start:chunk.End]))
+		assert.LessOrEqual(t, chunk.End-chunk.Start, ChunkTargetRunes)
+		if i > 0 {
+			assert.Less(t, chunk.Start, chunks[i-1].End, "[RO] Pasajele vecine se suprapun")
+			assert.Greater(t, chunk.Start, chunks[i-1].Start, "[RO] Fiecare pasaj înaintează")
+		}
+	}
+	assert.Equal(t, len(runes), chunks[len(chunks)-1].End, "[RO] Sfârșitul articolului e acoperit")
+}
+
+func TestSplitArticleIntoChunks_ShortAndEmptyContent(t *testing.T) {
+	assert.Len(t, SplitArticleIntoChunks(uuid.New(), "One short sentence."), 1)
+	assert.Empty(t, SplitArticleIntoChunks(uuid.New(), "   "))
+}
+
+func TestGroupChunkMatches_GroupsByParentAndMergesOverlaps(t *testing.T) {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	content := longArticle(60)
+	chunks := SplitArticleIntoChunks(first, content)
+
+	matches := []ArticleChunkMatch{
+		{Chunk: ArticleChunk{ArticleID: second, Text: "other", Start: 0, End: 5}, Title: "B", Similarity: 0.70},
+		{Chunk: chunks[1], Title: "A", Similarity: 0.91},
+		{Chunk: chunks[0], Title: "A", Similarity: 0.88},
+		{Chunk: chunks[3], Title: "A", Similarity: 0.80},
+		{Chunk: ArticleChunk{ArticleID: third, Text: "third", Start: 0, End: 5}, Title: "C", Similarity: 0.60},
+	}
+
+	groups := GroupChunkMatches(matches, 2, 2)
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, first, groups[0].ArticleID)
+	assert.Equal(t, 0.91, groups[0].Similarity)
+	require.Len(t, groups[0].Chunks, 1, "[RO] Pasajele 0 și 1 se suprapun, deci sunt unite; al treilea depășește limita")
+	merged := groups[0].Chunks[0]
+	assert.Equal(t, chunks[0].Start, merged.Start)
+	assert.Equal(t, chunks[1].End, merged.End)
+	assert.Equal(t, string([]rune(content)[merged.Start:merged.End]), merged.Text)
+	assert.Equal(t, second, groups[1].ArticleID)
+}
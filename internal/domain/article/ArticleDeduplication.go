@@ -0,0 +1,55 @@
+package article
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Parametrii Deduplicării Semantice
+const (
+	// [RO] Similaritatea cosine de la care un articol nou e considerat o copie a unuia existent
+	DefaultDeduplicationThreshold = 0.90
+
+	// [RO] Cât de departe în trecut căutăm originalul (copiile sindicalizate apar în câteva zile)
+	DeduplicationWindow = 14 * 24 * time.Hour
+)
+
+// [RO] Metoda prin care a fost găsit originalul
+const (
+	DuplicateMethodSemantic = "semantic" // Vectorul întregului text (pgvector)
+)
+
+// [RO] Articol Similar (Rezultatul Căutării Vectoriale)
+// Similaritatea este 1 - distanța cosine: 1.0 = același înțeles, 0 = fără legătură.
+type SimilarArticle struct {
+	Article    *NewsArticleEntity
+	Similarity float64
+}
+
+// [RO] Filtre Opționale pentru Căutarea Vectorială
+// Valorile zero înseamnă "fără filtru".
+type SimilarityFilter struct {
+	PublishedAfter  time.Time
+	PublishedBefore time.Time
+	SourceDomain    string // Ex: "reuters.com"
+}
+
+// [RO] Legătura Copie -> Original
+// Copia nu devine articol separat: păstrăm doar URL-ul ei și trimiterea la original.
+type DuplicateLink struct {
+	OriginalURL string    `json:"original_url"`
+	DuplicateOf uuid.UUID `json:"duplicate_of"`
+	Similarity  float64   `json:"similarity"`
+	Method      string    `json:"method"`
+	DetectedAt  time.Time `json:"detected_at"`
+}
+
+// [RO] Pragul Efectiv de Deduplicare
+// Un prag nesetat sau imposibil (în afara (0, 1]) revine la valoarea implicită.
+func EffectiveDeduplicationThreshold(configured float64) float64 {
+	if configured <= 0 || configured > 1 {
+		return DefaultDeduplicationThreshold
+	}
+	return configured
+}
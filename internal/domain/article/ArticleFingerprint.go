@@ -0,0 +1,132 @@
+package article
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Parametrii Amprentei Lexicale (SimHash)
+const (
+	// [RO] Sub atâtea cuvinte textul e prea scurt (pagini de eroare, paywall) ca amprenta să însemne ceva.
+	MinFingerprintTokens = 20
+
+	// [RO] Mărimea unei "șindrile": secvențe de 3 cuvinte consecutive
+	FingerprintShingleSize = 3
+
+	// [RO] Câți biți din 64 pot diferi între o copie și original (titlu schimbat, semnătură de agenție)
+	NearDuplicateMaxDistance = 3
+
+	// [RO] SimHash-ul e tăiat în 4 benzi de 16 biți. Două amprente la distanță <= 3 au sigur
+	// o bandă identică (principiul cutiei), deci căutarea candidaților se face pe egalitate, cu index.
+	SimHashBandCount = 4
+	simHashBandBits  = 64 / SimHashBandCount
+)
+
+// [RO] Metodele lexicale de deduplicare (înaintea vectorului)
+const (
+	DuplicateMethodExact   = "exact"   // Același text, după normalizare
+	DuplicateMethodSimHash = "simhash" // Aproape același text (câțiva biți diferiți)
+)
+
+// [RO] Amprenta Lexicală a unui Text
+type ContentFingerprint struct {
+	ContentHash string // SHA-256 al textului normalizat
+	SimHash     uint64
+}
+
+// [RO] Benzile SimHash-ului (16 biți fiecare), pentru indexul LSH
+func (fingerprint ContentFingerprint) Bands() [SimHashBandCount]int {
+	var bands [SimHashBandCount]int
+	for i := range bands {
+		bands[i] = int((fingerprint.SimHash >> (i * simHashBandBits)) & (1<<simHashBandBits - 1))
+	}
+	return bands
+}
+
+// [RO] Articol cu Amprentă Cunoscută (candidat din index)
+type FingerprintCandidate struct {
+	ArticleID   uuid.UUID
+	Fingerprint ContentFingerprint
+}
+
+// [RO] Potrivire Lexicală
+type FingerprintMatch struct {
+	ArticleID  uuid.UUID
+	Method     string
+	Distance   int
+	Similarity float64 // 1 - distanța / 64
+}
+
+// [RO] Calculează Amprenta
+// Textul este redus la cuvinte (litere și cifre, litere mici), deci spațiile, punctuația
+// și majusculele nu contează. Returnează false pentru texte prea scurte.
+func ComputeContentFingerprint(text string) (ContentFingerprint, bool) {
+	tokens := fingerprintTokens(text)
+	if len(tokens) < MinFingerprintTokens {
+		return ContentFingerprint{}, false
+	}
+
+	digest := sha256.Sum256([]byte(strings.Join(tokens, " ")))
+
+	// [RO] SimHash: fiecare șindrilă votează pe fiecare bit (+1 dacă bitul e 1 în hash-ul ei, -1 altfel).
+	var votes [64]int
+	for start := 0; start+FingerprintShingleSize <= len(tokens); start++ {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(strings.Join(tokens[start:start+FingerprintShingleSize], " ")))
+		shingleHash := hasher.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if shingleHash&(1<<bit) != 0 {
+				votes[bit]++
+			} else {
+				votes[bit]--
+			}
+		}
+	}
+
+	var simHash uint64
+	for bit, vote := range votes {
+		if vote > 0 {
+			simHash |= 1 << bit
+		}
+	}
+	return ContentFingerprint{ContentHash: hex.EncodeToString(digest[:]), SimHash: simHash}, true
+}
+
+// [RO] Distanța Hamming (câți biți diferă)
+func HammingDistance(a uint64, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// [RO] Cea Mai Bună Potrivire dintre Candidați
+// Copia exactă bate orice; altfel, cea mai mică distanță <= NearDuplicateMaxDistance. Nil = text nou.
+func BestFingerprintMatch(fingerprint ContentFingerprint, candidates []FingerprintCandidate) *FingerprintMatch {
+	var best *FingerprintMatch
+	for _, candidate := range candidates {
+		if candidate.Fingerprint.ContentHash == fingerprint.ContentHash {
+			return &FingerprintMatch{ArticleID: candidate.ArticleID, Method: DuplicateMethodExact, Similarity: 1}
+		}
+		distance := HammingDistance(fingerprint.SimHash, candidate.Fingerprint.SimHash)
+		if distance > NearDuplicateMaxDistance || (best != nil && distance >= best.Distance) {
+			continue
+		}
+		best = &FingerprintMatch{
+			ArticleID:  candidate.ArticleID,
+			Method:     DuplicateMethodSimHash,
+			Distance:   distance,
+			Similarity: 1 - float64(distance)/64,
+		}
+	}
+	return best
+}
+
+func fingerprintTokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
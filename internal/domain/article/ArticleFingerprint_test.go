@@ -0,0 +1,58 @@
+package article
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const wireStory = `BRUSSELS (Reuters) - European Union finance ministers agreed on Tuesday to extend
+the emergency energy price cap for another six months, diplomats said, after a late-night
+session in which several member states pushed for a broader reform of the electricity market
+and warned that households would face sharply higher bills next winter without the measure.`
+
+func TestComputeContentFingerprint_IgnoresFormatting(t *testing.T) {
+	original, ok := ComputeContentFingerprint(wireStory)
+	require.True(t, ok)
+
+	reformatted, ok := ComputeContentFingerprint(strings.ToUpper(strings.ReplaceAll(wireStory, "\n", "   ")))
+	require.True(t, ok)
+	assert.Equal(t, original, reformatted)
+
+	_, ok = ComputeContentFingerprint("Access denied. Please enable cookies.")
+	assert.False(t, ok, "[RO] Textele scurte nu primesc amprentă")
+}
+
+func TestBestFingerprintMatch(t *testing.T) {
+	original, _ := ComputeContentFingerprint(wireStory)
+	republished, _ := ComputeContentFingerprint(wireStory + " Reporting by Jan Strupczewski.")
+	unrelated, _ := ComputeContentFingerprint(strings.Repeat("Farmers blocked the motorway near Lyon to protest fuel prices. ", 3))
+
+	distance := HammingDistance(original.SimHash, republished.SimHash)
+	require.LessOrEqual(t, distance, NearDuplicateMaxDistance)
+
+	// [RO] O bandă comună e garantată de distanța mică (indexul LSH o găsește).
+	sharedBand := false
+	for i, band := range original.Bands() {
+		sharedBand = sharedBand || band == republished.Bands()[i]
+	}
+	assert.True(t, sharedBand)
+
+	originalID, unrelatedID := uuid.New(), uuid.New()
+	candidates := []FingerprintCandidate{{ArticleID: unrelatedID, Fingerprint: unrelated}, {ArticleID: originalID, Fingerprint: original}}
+
+	match := BestFingerprintMatch(republished, candidates)
+	require.NotNil(t, match)
+	assert.Equal(t, originalID, match.ArticleID)
+	assert.Equal(t, DuplicateMethodSimHash, match.Method)
+
+	exact := BestFingerprintMatch(original, candidates)
+	require.NotNil(t, exact)
+	assert.Equal(t, DuplicateMethodExact, exact.Method)
+	assert.Equal(t, 1.0, exact.Similarity)
+
+	assert.Nil(t, BestFingerprintMatch(unrelated, []FingerprintCandidate{{ArticleID: originalID, Fingerprint: original}}))
+}
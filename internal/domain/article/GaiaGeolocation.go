@@ -0,0 +1,102 @@
+package article
+
+import "github.com/yourorg/truthweave/internal/domain/geo"
+
+// [RO] Distanța maximă (km) la care acceptăm coordonatele modelului față de locurile menționate.
+// Peste ea, considerăm că modelul a "ghicit" și folosim coordonatele din baza de cunoștințe.
+const maxModelPlaceDistanceKm = 300.0
+
+// [RO] Coordonate Plauzibile
+// (0,0) este "Null Island" — valoarea implicită a unui model care nu știe locul, nu un loc real.
+func (point GaiaPoint) HasPlausibleCoordinates() bool {
+	if point.Latitude == 0 && point.Longitude == 0 {
+		return false
+	}
+	return point.Latitude >= -90 && point.Latitude <= 90 && point.Longitude >= -180 && point.Longitude <= 180
+}
+
+// [RO] Geocodare cu Fallback din Baza de Cunoștințe
+// Locurile menționate și legate de Wikidata au coordonate sigure. Le folosim când:
+// 1. Modelul nu a dat coordonate plauzibile.
+// 2. Coordonatele modelului sunt departe de toate locurile menționate în știre.
+// Emoția și intensitatea punctului rămân cele ale modelului.
+func ResolveGaiaGeolocation(point GaiaPoint, mentions []NamedEntity) GaiaPoint {
+	var places []NamedEntity
+	for _, mention := range mentions {
+		if mention.Type == "Place" && mention.HasCoordinates {
+			places = append(places, mention)
+		}
+	}
+	if len(places) == 0 {
+		return point
+	}
+
+	if point.HasPlausibleCoordinates() {
+		for _, place := range places {
+			if geo.DistanceKm(point.Latitude, point.Longitude, place.Latitude, place.Longitude) <= maxModelPlaceDistanceKm {
+				return point
+			}
+		}
+	}
+
+	point.Latitude = places[0].Latitude
+	point.Longitude = places[0].Longitude
+	return point
+}
+
+// [RO] Plasarea pe Hartă
+// Punctul final plus țara/regiunea în care se află (coduri ISO 3166).
+type GaiaPlacement struct {
+	Point       GaiaPoint `json:"point"`
+	CountryCode string    `json:"country_code,omitempty"`
+	RegionCode  string    `json:"region_code,omitempty"`
+}
+
+// [RO] Geocodare Completă (Baza de Cunoștințe + Gazetteer)
+//  1. Fallback-ul din baza de cunoștințe (ResolveGaiaGeolocation).
+//  2. Dacă punctul tot nu e plauzibil, căutăm locurile menționate în gazetteer, după nume;
+//     o regiune ("Cluj") bate o țară ("Romania"), pentru că e mai precisă.
+//  3. Geocodare inversă: punctul final primește codul țării și al regiunii.
+func PlaceGaiaGeolocation(point GaiaPoint, mentions []NamedEntity, gazetteer geo.GazetteerLookupInterface) GaiaPlacement {
+	point = ResolveGaiaGeolocation(point, mentions)
+	if gazetteer == nil {
+		return GaiaPlacement{Point: point}
+	}
+
+	if !point.HasPlausibleCoordinates() {
+		var best *geo.Place
+		for _, mention := range mentions {
+			if mention.Type != "Place" {
+				continue
+			}
+			place, ok := gazetteer.LookupPlaceByName(mention.Name)
+			if !ok {
+				continue
+			}
+			if best == nil || (best.Level == geo.LevelCountry && place.Level == geo.LevelRegion) {
+				best = place
+			}
+		}
+		if best != nil {
+			point.Latitude, point.Longitude = best.Latitude, best.Longitude
+			placement := GaiaPlacement{Point: point, CountryCode: best.CountryCode}
+			if best.Level == geo.LevelRegion {
+				placement.RegionCode = best.Code
+			}
+			return placement
+		}
+	}
+
+	placement := GaiaPlacement{Point: point}
+	if !point.HasPlausibleCoordinates() {
+		return placement
+	}
+	country, region := gazetteer.ReverseGeocode(point.Latitude, point.Longitude)
+	if country != nil {
+		placement.CountryCode = country.Code
+	}
+	if region != nil {
+		placement.RegionCode = region.Code
+	}
+	return placement
+}
@@ -0,0 +1,84 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yourorg/truthweave/internal/domain/geo"
+)
+
+func TestResolveGaiaGeolocation(t *testing.T) {
+	bucharest := NamedEntity{Name: "Bucharest", Type: "Place", HasCoordinates: true, Latitude: 44.43, Longitude: 26.10}
+
+	// [RO] Null Island -> coordonatele din baza de cunoștințe
+	resolved := ResolveGaiaGeolocation(GaiaPoint{Emotion: "F"}, []NamedEntity{bucharest})
+	assert.Equal(t, 44.43, resolved.Latitude)
+	assert.Equal(t, "F", resolved.Emotion)
+
+	// [RO] Modelul e aproape de locul menționat (Ploiești) -> păstrăm coordonatele modelului
+	near := GaiaPoint{Latitude: 44.94, Longitude: 26.02}
+	assert.Equal(t, near, ResolveGaiaGeolocation(near, []NamedEntity{bucharest}))
+
+	// [RO] Modelul a pus evenimentul la Paris -> corectăm
+	far := ResolveGaiaGeolocation(GaiaPoint{Latitude: 48.85, Longitude: 2.35}, []NamedEntity{bucharest})
+	assert.Equal(t, 26.10, far.Longitude)
+
+	// [RO] Fără locuri cunoscute -> neschimbat (chiar și (0,0); politica "No Null Island" se aplică la afișare)
+	assert.Equal(t, GaiaPoint{}, ResolveGaiaGeolocation(GaiaPoint{}, []NamedEntity{{Name: "NATO", Type: "Organization"}}))
+}
+
+// [RO] Gazetteer de test: un singur nume cunoscut per loc, geocodare inversă fixă.
+type fakeGazetteer struct {
+	places  map[string]*geo.Place
+	country *geo.Place
+	region  *geo.Place
+}
+
+func (gazetteer fakeGazetteer) LookupPlaceByName(name string) (*geo.Place, bool) {
+	place, ok := gazetteer.places[name]
+	return place, ok
+}
+
+func (gazetteer fakeGazetteer) ReverseGeocode(latitude float64, longitude float64) (*geo.Place, *geo.Place) {
+	return gazetteer.country, gazetteer.region
+}
+
+func TestPlaceGaiaGeolocation(t *testing.T) {
+	romania := &geo.Place{Code: "RO", CountryCode: "RO", Level: geo.LevelCountry, Latitude: 45.94, Longitude: 24.97}
+	cluj := &geo.Place{Code: "RO-CJ", CountryCode: "RO", Level: geo.LevelRegion, Latitude: 46.78, Longitude: 23.6}
+	gazetteer := fakeGazetteer{
+		places:  map[string]*geo.Place{"Romania": romania, "Cluj": cluj},
+		country: romania,
+		region:  cluj,
+	}
+
+	// [RO] (0,0) fără baza de cunoștințe -> regiunea menționată bate țara, indiferent de ordine
+	placement := PlaceGaiaGeolocation(GaiaPoint{Emotion: "F"}, []NamedEntity{
+		{Name: "Romania", Type: "Place"},
+		{Name: "NATO", Type: "Organization"},
+		{Name: "Cluj", Type: "Place"},
+	}, gazetteer)
+	assert.Equal(t, 46.78, placement.Point.Latitude)
+	assert.Equal(t, "F", placement.Point.Emotion)
+	assert.Equal(t, "RO", placement.CountryCode)
+	assert.Equal(t, "RO-CJ", placement.RegionCode)
+
+	// [RO] Doar țara -> centroidul țării, fără regiune
+	placement = PlaceGaiaGeolocation(GaiaPoint{}, []NamedEntity{{Name: "Romania", Type: "Place"}}, gazetteer)
+	assert.Equal(t, 24.97, placement.Point.Longitude)
+	assert.Equal(t, "RO", placement.CountryCode)
+	assert.Empty(t, placement.RegionCode)
+
+	// [RO] Coordonate plauzibile ale modelului -> păstrate, doar geocodare inversă
+	model := GaiaPoint{Latitude: 46.77, Longitude: 23.59}
+	placement = PlaceGaiaGeolocation(model, nil, gazetteer)
+	assert.Equal(t, model, placement.Point)
+	assert.Equal(t, "RO-CJ", placement.RegionCode)
+
+	// [RO] Niciun loc cunoscut -> rămâne pe Null Island, fără coduri
+	placement = PlaceGaiaGeolocation(GaiaPoint{}, []NamedEntity{{Name: "Atlantis", Type: "Place"}}, gazetteer)
+	assert.Equal(t, GaiaPlacement{}, placement)
+
+	// [RO] Fără gazetteer -> doar fallback-ul din baza de cunoștințe
+	assert.Equal(t, GaiaPlacement{Point: model}, PlaceGaiaGeolocation(model, nil, nil))
+}
@@ -0,0 +1,140 @@
+package article
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// [RO] Limite Hartă Gaia
+const (
+	DefaultGaiaZoom = 2
+	MaxGaiaZoom     = 18
+
+	// [RO] Câte grupuri (clustere) trimitem cel mult aplicației mobile.
+	MaxGaiaClusters = 2000
+
+	// [RO] Câte celule de grilă încap pe lățimea unei "plăci" de hartă la un nivel de zoom.
+	gaiaCellsPerTile = 4
+)
+
+// [RO] Cererea Hărții Gaia
+// Zona vizibilă (bbox), nivelul de zoom și filtrele de timp/emoție/țară.
+type GaiaMapQuery struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+	Zoom         int
+	From         time.Time
+	To           time.Time
+	Emotion      string
+	CountryCode  string // ISO 3166-1 alpha-2 (ex: "RO"); gol = toate țările
+}
+
+// [RO] Grup de Știri pe Hartă (Cluster)
+// Un punct pe glob care reprezintă toate știrile dintr-o celulă a grilei.
+// Poziția este media coordonatelor (nu centrul celulei), ca punctul să stea unde sunt știrile.
+type GaiaCluster struct {
+	ID                string  `json:"id"` // Celula grilei: "zoom/rând/coloană"
+	Latitude          float64 `json:"lat"`
+	Longitude         float64 `json:"lng"`
+	Count             int     `json:"count"`
+	AverageTruthScore float64 `json:"avg_truth_score"`
+	DominantEmotion   string  `json:"dominant_emotion"`
+
+	// [RO] Setat doar pentru grupurile cu o singură știre (atingere -> deschide articolul).
+	ArticleID string `json:"article_id,omitempty"`
+}
+
+// [RO] Agregat pe Țară
+// Aceleași măsuri ca un grup de hartă, dar cheia este țara (geocodarea inversă a articolului).
+type GaiaCountryAggregate struct {
+	CountryCode       string  `json:"country_code"`
+	Latitude          float64 `json:"lat"`
+	Longitude         float64 `json:"lng"`
+	Count             int     `json:"count"`
+	AverageTruthScore float64 `json:"avg_truth_score"`
+	DominantEmotion   string  `json:"dominant_emotion"`
+}
+
+// [RO] Zona Implicită: Tot Globul
+func NewGaiaMapQuery() GaiaMapQuery {
+	return GaiaMapQuery{MinLatitude: -90, MinLongitude: -180, MaxLatitude: 90, MaxLongitude: 180, Zoom: DefaultGaiaZoom}
+}
+
+// [RO] Parsare Zonă Vizibilă
+// Format: "minLng,minLat,maxLng,maxLat" (ordinea GeoJSON). minLng > maxLng înseamnă că zona
+// traversează antimeridianul (ex: "170,-20,-170,20" pentru Fiji).
+func (query *GaiaMapQuery) ParseBoundingBox(bbox string) error {
+	parts := strings.Split(bbox, ",")
+	if len(parts) != 4 {
+		return errors.New("[RO] Eroare: bbox trebuie să fie minLng,minLat,maxLng,maxLat.")
+	}
+
+	var values [4]float64
+	for index, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return errors.New("[RO] Eroare: bbox conține o valoare nenumerică.")
+		}
+		values[index] = value
+	}
+
+	minLng, minLat, maxLng, maxLat := values[0], values[1], values[2], values[3]
+	if minLat < -90 || maxLat > 90 || minLat > maxLat {
+		return errors.New("[RO] Eroare: Latitudinile din bbox sunt în afara intervalului [-90, 90] sau inversate.")
+	}
+	if minLng < -180 || minLng > 180 || maxLng < -180 || maxLng > 180 {
+		return errors.New("[RO] Eroare: Longitudinile din bbox sunt în afara intervalului [-180, 180].")
+	}
+
+	query.MinLongitude, query.MinLatitude, query.MaxLongitude, query.MaxLatitude = minLng, minLat, maxLng, maxLat
+	return nil
+}
+
+// [RO] Validare și Valori Implicite
+func (query *GaiaMapQuery) Normalize() error {
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		return errors.New("[RO] Eroare: Fereastra de timp este inversată (to < from).")
+	}
+	countryCode, err := NormalizeCountryCode(query.CountryCode)
+	if err != nil {
+		return err
+	}
+	query.CountryCode = countryCode
+	if query.Zoom < 0 {
+		query.Zoom = 0
+	}
+	if query.Zoom > MaxGaiaZoom {
+		query.Zoom = MaxGaiaZoom
+	}
+	return nil
+}
+
+// [RO] Zona traversează antimeridianul (180°)?
+func (query GaiaMapQuery) CrossesAntimeridian() bool {
+	return query.MinLongitude > query.MaxLongitude
+}
+
+// [RO] Mărimea Celulei de Grilă (grade)
+// O placă de hartă la zoom z acoperă 360/2^z grade; o împărțim în câteva celule,
+// ca la zoom mic să vedem continente, iar la zoom mare străzi.
+func (query GaiaMapQuery) CellSizeDegrees() float64 {
+	return 360.0 / math.Pow(2, float64(query.Zoom)) / gaiaCellsPerTile
+}
+
+// [RO] Normalizare Cod de Țară
+// "ro" -> "RO"; orice altceva decât două litere este respins.
+func NormalizeCountryCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return "", nil
+	}
+	if len(code) != 2 || code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+		return "", errors.New("[RO] Eroare: Codul țării trebuie să fie ISO 3166-1 alpha-2 (ex: RO).")
+	}
+	return code, nil
+}
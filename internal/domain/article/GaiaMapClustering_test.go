@@ -0,0 +1,60 @@
+package article
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGaiaMapQuery_ParseBoundingBox(t *testing.T) {
+	query := NewGaiaMapQuery()
+	assert.NoError(t, query.ParseBoundingBox("20.2, 43.6, 29.7, 48.3")) // România
+	assert.Equal(t, 20.2, query.MinLongitude)
+	assert.Equal(t, 48.3, query.MaxLatitude)
+	assert.False(t, query.CrossesAntimeridian())
+
+	// [RO] Fiji: zona traversează antimeridianul
+	assert.NoError(t, query.ParseBoundingBox("170,-20,-170,-10"))
+	assert.True(t, query.CrossesAntimeridian())
+
+	for _, invalid := range []string{"1,2,3", "a,b,c,d", "0,50,10,40", "0,-91,10,10", "-181,0,10,10", "0,0,NaN,10"} {
+		assert.Error(t, query.ParseBoundingBox(invalid), invalid)
+	}
+}
+
+func TestGaiaMapQuery_Normalize(t *testing.T) {
+	query := NewGaiaMapQuery()
+	query.Zoom = 40
+	assert.NoError(t, query.Normalize())
+	assert.Equal(t, MaxGaiaZoom, query.Zoom)
+
+	query.CountryCode = " ro "
+	assert.NoError(t, query.Normalize())
+	assert.Equal(t, "RO", query.CountryCode)
+
+	query.CountryCode = "ROU"
+	assert.Error(t, query.Normalize())
+	query.CountryCode = ""
+
+	query.From = time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	query.To = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Error(t, query.Normalize())
+}
+
+func TestGaiaMapQuery_CellSizeShrinksWithZoom(t *testing.T) {
+	query := NewGaiaMapQuery()
+
+	query.Zoom = 0
+	assert.Equal(t, 90.0, query.CellSizeDegrees())
+
+	query.Zoom = 2
+	assert.Equal(t, 22.5, query.CellSizeDegrees())
+
+	// [RO] Celulele se aliniază pe 180°: niciuna nu traversează antimeridianul.
+	for zoom := 0; zoom <= MaxGaiaZoom; zoom++ {
+		query.Zoom = zoom
+		cells := 180 / query.CellSizeDegrees()
+		assert.Equal(t, float64(int(cells)), cells)
+	}
+}
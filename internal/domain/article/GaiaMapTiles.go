@@ -0,0 +1,82 @@
+package article
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// [RO] Limite Export Geografic
+const (
+	// [RO] Latitudinea maximă a proiecției Web Mercator (hărțile web nu desenează polii).
+	MaxMercatorLatitude = 85.05112878
+
+	// [RO] Rezoluția internă a unei plăci vectoriale (standardul Mapbox).
+	VectorTileExtent = 4096
+
+	DefaultGaiaFeatureLimit = 1000
+	MaxGaiaFeatureLimit     = 5000
+)
+
+// [RO] Știre pe Hartă (negrupată)
+// Folosită de exportul GeoJSON pentru panouri web și unelte GIS.
+type GaiaArticlePoint struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	URL         string    `json:"url"`
+	Latitude    float64   `json:"lat"`
+	Longitude   float64   `json:"lng"`
+	TruthScore  float64   `json:"truth_score"`
+	Emotion     string    `json:"emotion"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// [RO] Placă de Hartă (Slippy Map: z/x/y)
+type GaiaTile struct {
+	Z int
+	X int
+	Y int
+}
+
+// [RO] Validare Placă
+func (tile GaiaTile) Validate() error {
+	if tile.Z < 0 || tile.Z > MaxGaiaZoom {
+		return errors.New("[RO] Eroare: Zoom-ul plăcii este în afara intervalului suportat.")
+	}
+	limit := 1 << uint(tile.Z)
+	if tile.X < 0 || tile.X >= limit || tile.Y < 0 || tile.Y >= limit {
+		return errors.New("[RO] Eroare: Coordonatele plăcii sunt în afara grilei pentru acest zoom.")
+	}
+	return nil
+}
+
+// [RO] Zona acoperită de placă, ca cerere de hartă (zoom-ul plăcii decide gruparea)
+func (tile GaiaTile) MapQuery() GaiaMapQuery {
+	tiles := math.Exp2(float64(tile.Z))
+	tileLatitude := func(y float64) float64 {
+		return math.Atan(math.Sinh(math.Pi*(1-2*y/tiles))) * 180 / math.Pi
+	}
+
+	return GaiaMapQuery{
+		MinLongitude: float64(tile.X)/tiles*360 - 180,
+		MaxLongitude: float64(tile.X+1)/tiles*360 - 180,
+		MaxLatitude:  tileLatitude(float64(tile.Y)),
+		MinLatitude:  tileLatitude(float64(tile.Y + 1)),
+		Zoom:         tile.Z,
+	}
+}
+
+// [RO] Proiecție în Coordonatele Plăcii
+// Returnează poziția (0..extent) a unui punct geografic în interiorul plăcii.
+// Punctele din afara plăcii dau valori în afara intervalului; apelantul le ignoră.
+func (tile GaiaTile) Project(latitude float64, longitude float64, extent int) (int, int) {
+	latitude = math.Max(-MaxMercatorLatitude, math.Min(MaxMercatorLatitude, latitude))
+	tiles := math.Exp2(float64(tile.Z))
+	latRad := latitude * math.Pi / 180
+
+	worldX := (longitude + 180) / 360 * tiles
+	worldY := (1 - math.Log(math.Tan(latRad)+1/math.Cos(latRad))/math.Pi) / 2 * tiles
+
+	return int(math.Floor((worldX - float64(tile.X)) * float64(extent))),
+		int(math.Floor((worldY - float64(tile.Y)) * float64(extent)))
+}
@@ -0,0 +1,38 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGaiaTile_MapQueryAndProjection(t *testing.T) {
+	// [RO] Zoom 0: o singură placă, tot globul (fără poli).
+	world := GaiaTile{}.MapQuery()
+	assert.Equal(t, -180.0, world.MinLongitude)
+	assert.Equal(t, 180.0, world.MaxLongitude)
+	assert.InDelta(t, MaxMercatorLatitude, world.MaxLatitude, 1e-6)
+
+	// [RO] Zoom 1, placa nord-est: 0..180 longitudine, 0..85 latitudine.
+	northEast := GaiaTile{Z: 1, X: 1, Y: 0}
+	query := northEast.MapQuery()
+	assert.Equal(t, 0.0, query.MinLongitude)
+	assert.InDelta(t, 0, query.MinLatitude, 1e-9)
+	assert.Equal(t, 1, query.Zoom)
+
+	// [RO] București cade în interiorul plăcii: jumătatea stângă, sub mijloc (Mercator întinde nordul).
+	x, y := northEast.Project(44.43, 26.10, VectorTileExtent)
+	assert.Equal(t, 593, x)
+	assert.Equal(t, 2965, y)
+
+	// [RO] New York este în afara plăcii (longitudine negativă).
+	x, _ = northEast.Project(40.71, -74.0, VectorTileExtent)
+	assert.Less(t, x, 0)
+}
+
+func TestGaiaTile_Validate(t *testing.T) {
+	assert.NoError(t, GaiaTile{Z: 3, X: 7, Y: 7}.Validate())
+	assert.Error(t, GaiaTile{Z: 3, X: 8, Y: 0}.Validate())
+	assert.Error(t, GaiaTile{Z: -1}.Validate())
+	assert.Error(t, GaiaTile{Z: MaxGaiaZoom + 1}.Validate())
+}
@@ -0,0 +1,141 @@
+package article
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// [RO] Ce a neutralizat rescrierea
+const (
+	AnnotationLoadedWord        = "loaded_word"        // Cuvânt încărcat emoțional ("masacru", "scandalos")
+	AnnotationUnattributedClaim = "unattributed_claim" // Afirmație fără sursă ("se spune că", "toată lumea știe")
+	AnnotationSpeculation       = "speculation"        // Presupunere prezentată ca fapt ("va duce sigur la")
+)
+
+// [RO] Ce s-a întâmplat cu fragmentul în rescriere
+const (
+	AnnotationActionRemoved = "removed"
+	AnnotationActionChanged = "changed"
+)
+
+// [RO] Limitele Adnotărilor
+const (
+	MaxNeutralizationAnnotations = 60
+	maxAnnotationOriginalRunes   = 400
+)
+
+// [RO] Adnotare de Neutralizare
+// Un fragment din textul original (RawContent) pe care rescrierea neutră l-a scos sau l-a schimbat.
+// Start/End sunt poziții în caractere (nu octeți) din RawContent: [Start, End).
+type NeutralizationAnnotation struct {
+	Kind        string `json:"kind"`
+	Start       int    `json:"start"`
+	End         int    `json:"end"`
+	Original    string `json:"original"`
+	Replacement string `json:"replacement,omitempty"` // Gol = fragmentul a fost scos
+	Action      string `json:"action"`
+	Note        string `json:"note,omitempty"` // De ce a fost neutralizat (scurt)
+}
+
+// [RO] Ancorează Adnotările în Textul Original
+//
+// Modelul numără greu caracterele, așa că poziția lui e doar un indiciu: fragmentul citat
+// (Original) este căutat exact în text și se alege apariția cea mai apropiată de indiciu.
+// Modelul a văzut textul scăpat (EscapeUntrustedContent: `&lt;`, fără caractere invizibile),
+// deci căutăm întâi acolo și ducem pozițiile înapoi în RawContent; Original devine fragmentul
+// din RawContent, ca Start/End și Original să descrie mereu același text.
+// Sunt eliminate tipurile necunoscute, citatele inexistente în text, fragmentele lăsate neschimbate
+// și suprapunerile (rămâne fragmentul care începe primul); rezultatul e sortat după poziție.
+func AnchorNeutralizationAnnotations(rawContent string, drafts []NeutralizationAnnotation) []NeutralizationAnnotation {
+	rawRunes := []rune(rawContent)
+	promptContent, offsets := escapeUntrustedContentWithOffsets(rawContent)
+
+	var anchored []NeutralizationAnnotation
+	for _, draft := range drafts {
+		if draft.Kind != AnnotationLoadedWord && draft.Kind != AnnotationUnattributedClaim && draft.Kind != AnnotationSpeculation {
+			continue
+		}
+		original := strings.TrimSpace(draft.Original)
+		replacement := strings.TrimSpace(draft.Replacement)
+		if original == "" || original == replacement || utf8.RuneCountInString(original) > maxAnnotationOriginalRunes {
+			continue
+		}
+
+		start, end, found := anchorInRawContent(promptContent, offsets, original, draft.Start)
+		if !found {
+			continue
+		}
+
+		annotation := NeutralizationAnnotation{
+			Kind:        draft.Kind,
+			Start:       start,
+			End:         end,
+			Original:    string(rawRunes[start:end]),
+			Replacement: replacement,
+			Action:      AnnotationActionChanged,
+			Note:        strings.TrimSpace(draft.Note),
+		}
+		if replacement == "" {
+			annotation.Action = AnnotationActionRemoved
+		}
+		anchored = append(anchored, annotation)
+	}
+
+	sort.SliceStable(anchored, func(i, j int) bool {
+		if anchored[i].Start != anchored[j].Start {
+			return anchored[i].Start < anchored[j].Start
+		}
+		return anchored[i].End > anchored[j].End
+	})
+
+	result := []NeutralizationAnnotation{}
+	for _, annotation := range anchored {
+		if len(result) > 0 && annotation.Start < result[len(result)-1].End {
+			continue
+		}
+		result = append(result, annotation)
+		if len(result) == MaxNeutralizationAnnotations {
+			break
+		}
+	}
+	return result
+}
+
+// [RO] Poziția în RawContent a unui citat din textul scăpat (cel trimis modelului)
+// Începutul e caracterul original al primului caracter citat; sfârșitul e imediat după
+// caracterul original al ultimului, deci un `&lt;` citat întreg acoperă exact `<`.
+func anchorInRawContent(promptContent string, offsets []int, quote string, hint int) (int, int, bool) {
+	start, found := nearestOccurrence(promptContent, quote, hint)
+	if !found {
+		return 0, 0, false
+	}
+	end := start + utf8.RuneCountInString(quote)
+	return offsets[start], offsets[end-1] + 1, true
+}
+
+// [RO] Apariția citatului cea mai apropiată de poziția indicată (în caractere)
+func nearestOccurrence(text string, quote string, hint int) (int, bool) {
+	best, bestDistance := 0, -1
+	byteOffset, runeOffset := 0, 0
+	for {
+		index := strings.Index(text[byteOffset:], quote)
+		if index < 0 {
+			break
+		}
+		runeOffset += utf8.RuneCountInString(text[byteOffset : byteOffset+index])
+		distance := runeOffset - hint
+		if distance < 0 {
+			distance = -distance
+		}
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = runeOffset, distance
+		}
+
+		// [RO] Următoarea căutare pornește de la caracterul de după începutul acestei apariții
+		_, size := utf8.DecodeRuneInString(text[byteOffset+index:])
+		byteOffset += index + size
+		runeOffset++
+	}
+	return best, bestDistance >= 0
+}
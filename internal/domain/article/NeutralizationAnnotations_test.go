@@ -0,0 +1,57 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnchorNeutralizationAnnotations_UsesCharacterOffsetsOfQuotedText(t *testing.T) {
+	raw := "Guvernul a anunțat o reformă scandaloasă. Se spune că reforma va distruge sigur economia. Opoziția numește reforma scandaloasă."
+
+	annotations := AnchorNeutralizationAnnotations(raw, []NeutralizationAnnotation{
+		{Kind: AnnotationSpeculation, Original: "va distruge sigur economia", Replacement: "ar putea afecta economia", Start: 70},
+		{Kind: AnnotationLoadedWord, Original: "scandaloasă", Replacement: "controversată", Start: 120},
+		{Kind: AnnotationUnattributedClaim, Original: "Se spune că", Start: 0},
+		{Kind: AnnotationLoadedWord, Original: "distruge", Start: 62},     // În interiorul unui fragment deja adnotat
+		{Kind: AnnotationLoadedWord, Original: "catastrofală", Start: 10}, // Nu apare în text
+		{Kind: "tone", Original: "Guvernul", Start: 0},
+		{Kind: AnnotationLoadedWord, Original: "reformă", Replacement: "reformă"}, // Neschimbat
+	})
+	require.Len(t, annotations, 3)
+
+	runes := []rune(raw)
+	for _, annotation := range annotations {
+		assert.Equal(t, annotation.Original, string(runes[annotation.Start:annotation.End]), "[RO] Pozițiile sunt în caractere, nu în octeți")
+	}
+
+	assert.Equal(t, AnnotationUnattributedClaim, annotations[0].Kind)
+	assert.Equal(t, AnnotationActionRemoved, annotations[0].Action)
+	assert.Equal(t, AnnotationSpeculation, annotations[1].Kind)
+	assert.Equal(t, AnnotationActionChanged, annotations[1].Action)
+
+	// [RO] "scandaloasă" apare de două ori: indiciul alege a doua apariție
+	assert.Equal(t, AnnotationLoadedWord, annotations[2].Kind)
+	assert.Greater(t, annotations[2].Start, 100)
+
+	assert.NotNil(t, AnchorNeutralizationAnnotations(raw, nil))
+}
+
+func TestAnchorNeutralizationAnnotations_MapsEscapedQuotesBackToRawContent(t *testing.T) {
+	// [RO] Modelul a văzut "&lt;3" și "catastrofală" fără caracterul invizibil din mijloc.
+	raw := "Fanii <3 echipa. Un sezon catas\u200btrofală pentru rivali."
+
+	annotations := AnchorNeutralizationAnnotations(raw, []NeutralizationAnnotation{
+		{Kind: AnnotationLoadedWord, Original: "&lt;3 echipa", Replacement: "susțin echipa", Start: 5},
+		{Kind: AnnotationLoadedWord, Original: "catastrofală", Replacement: "slab", Start: 25},
+	})
+	require.Len(t, annotations, 2)
+
+	runes := []rune(raw)
+	for _, annotation := range annotations {
+		assert.Equal(t, annotation.Original, string(runes[annotation.Start:annotation.End]))
+	}
+	assert.Equal(t, "<3 echipa", annotations[0].Original)
+	assert.Equal(t, "catas\u200btrofală", annotations[1].Original)
+}
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/community"
+	"github.com/yourorg/truthweave/internal/domain/dispute"
+	"github.com/yourorg/truthweave/internal/domain/perspective"
 )
 
 // [RO] Entitate de Domeniu: Știre (Articol de Presă)
@@ -68,6 +71,11 @@ type NewsArticleEntity struct {
 	// Când a analizat sistemul nostru această știre.
 	ProcessedAt time.Time `json:"processed_at"`
 
+	// [RO] Grupul Poveștii (Story Cluster)
+	// Toate articolele care relatează aceeași poveste împart acest ID.
+	// Din el derivăm identitatea Evenimentului din graf (vezi causality.StoryEventID).
+	StoryClusterID uuid.UUID `json:"story_cluster_id"`
+
 	// --- Secțiunea Verificare Blockchain ---
 
 	// [RO] Identificator Arhivă Arweave
@@ -86,10 +94,20 @@ type NewsArticleEntity struct {
 	// Coordonatele GPS asociate principalului eveniment din știre, pentru afișarea pe Globul 3D.
 	Geolocation GaiaPoint `json:"geo_location"`
 
+	// [RO] Țara și Regiunea (ISO 3166)
+	// Obținute din gazetteer-ul offline (geocodare inversă sau numele locului menționat).
+	// Permit filtrarea fluxului și a hărții pe țări (ex: "RO", "RO-CJ").
+	CountryCode string `json:"country_code,omitempty"`
+	RegionCode  string `json:"region_code,omitempty"`
+
 	// [RO] Emoția Globală
 	// Sentimentul general detectat în text (ex: "Frică", "Bucurie", "Furie").
 	GlobalEmotion string `json:"global_emotion"`
 
+	// [RO] Sectorul (Domeniul Tematic)
+	// Una dintre cheile din NewsSector.go (ex: "economy"); gol dacă modelul nu a putut decide.
+	Sector string `json:"sector,omitempty"`
+
 	// [RO] Cauze (De ce s-a întâmplat?)
 	// Lista de evenimente anterioare care au dus la această știre.
 	Causes []CausalEventLink `json:"causes,omitempty"`
@@ -105,6 +123,29 @@ type NewsArticleEntity struct {
 	// [RO] Entități Menționate
 	// Lista de persoane, organizații sau locuri detectate în text.
 	Mentions []NamedEntity `json:"mentions,omitempty"`
+
+	// [RO] Ce a Neutralizat Rescrierea
+	// Fragmentele din RawContent (poziții în caractere) scoase sau schimbate în Content:
+	// cuvinte încărcate, afirmații fără sursă și speculații. Baza suprapunerii "ce am neutralizat".
+	Neutralizations []NeutralizationAnnotation `json:"neutralizations,omitempty"`
+
+	// [RO] Semnale pentru Verificare Umană
+	// Motivele (ReviewReason*) pentru care analiza poate fi dictată de pagina sursă
+	// (ex: instrucțiuni ascunse în text); gol = analiză fără anomalii.
+	ReviewFlags []string `json:"review_flags,omitempty"`
+
+	// [RO] Note de Rezolvare
+	// Rezultatul reanalizelor cerute de contestațiile cititorilor (cele mai noi întâi).
+	ResolutionNotes []dispute.ResolutionNote `json:"resolution_notes,omitempty"`
+
+	// [RO] Încrederea Comunității
+	// Evaluările cititorilor (utilitate, corectitudine) trecute prin consensul de punte;
+	// un semnal separat de TruthScore, care rămâne scorul modelului.
+	CommunityScores []community.ItemScore `json:"community_scores,omitempty"`
+
+	// [RO] Perspectivele Poveștii
+	// Ultima revizie a punctelor de vedere pentru grupul articolului (doar la cerere, ex: în feed).
+	Perspectives *perspective.PerspectiveSet `json:"perspectives,omitempty"`
 }
 
 // [RO] Punct Geografic (Gaia)
@@ -123,6 +164,18 @@ type NamedEntity struct {
 	Name  string  `json:"name"`
 	Type  string  `json:"type"` // Person, Organization, Place
 	Score float64 `json:"score"`
+
+	// [RO] ID-ul Canonic (completat de rezolvarea entităților)
+	// "Biden" și "President Biden" primesc același ID.
+	CanonicalID string `json:"canonical_id,omitempty"`
+
+	// [RO] Legătura cu Baza de Cunoștințe (Wikidata)
+	// Pentru locuri, coordonatele sunt fallback-ul de geocodare al Hărții Gaia.
+	WikidataQID    string  `json:"wikidata_qid,omitempty"`
+	Description    string  `json:"description,omitempty"`
+	HasCoordinates bool    `json:"has_coordinates,omitempty"`
+	Latitude       float64 `json:"lat,omitempty"`
+	Longitude      float64 `json:"lng,omitempty"`
 }
 
 // [RO] Legătură Cauzală
@@ -146,8 +199,19 @@ type AIAnalysisResult struct {
 	Summary         string            `json:"summary"`
 	Location        GaiaPoint         `json:"location"`
 	GlobalEmotion   string            `json:"global_emotion"`
+	Sector          string            `json:"sector"`
 	CausalRelations []CausalEventLink `json:"causal_relations"`
 	CounterArgument string            `json:"counter_argument"`
+
+	// [RO] Fragmentele neutralizate; pozițiile modelului sunt doar indicii,
+	// activitatea le ancorează în text (AnchorNeutralizationAnnotations)
+	Annotations []NeutralizationAnnotation `json:"annotations"`
+
+	// [RO] Modelul a găsit în text instrucțiuni adresate lui (cerut explicit în prompt)
+	InjectionSuspected bool `json:"injection_suspected"`
+
+	// [RO] Completat de activitate (ReviewAnalysisIntegrity), nu de model
+	ReviewFlags []string `json:"review_flags,omitempty"`
 }
 
 // [RO] Sentiment AI
@@ -46,3 +46,11 @@ func TestNewsArticleEntity_VerifyDataIntegrity(t *testing.T) {
 		})
 	}
 }
+
+func TestEffectiveDeduplicationThreshold(t *testing.T) {
+	for configured, want := range map[float64]float64{0: DefaultDeduplicationThreshold, 1.5: DefaultDeduplicationThreshold, 0.95: 0.95, 1: 1} {
+		if got := EffectiveDeduplicationThreshold(configured); got != want {
+			t.Errorf("EffectiveDeduplicationThreshold(%v) = %v, want %v", configured, got, want)
+		}
+	}
+}
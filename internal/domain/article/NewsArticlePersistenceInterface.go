@@ -17,6 +17,7 @@ type NewsArticlePersistenceInterface interface {
 	// [RO] Salvează Știrea
 	// Scrie permanent articolul și toate relațiile lui în baza de date.
 	// Returnează o eroare dacă operațiunea eșuează.
+	// Dacă URL-ul există deja, `article.ID` (și grupul poveștii) devin cele ale rândului existent.
 	PersistNewsArticle(execution_context context.Context, article *NewsArticleEntity) error
 
 	// [RO] Găsește Știrea după ID
@@ -27,10 +28,32 @@ type NewsArticlePersistenceInterface interface {
 	// Folosește vectori matematici pentru a găsi alte articole care vorbesc despre
 	// același subiect, chiar dacă folosesc cuvinte diferite.
 	// (Ex: "Război" ~ "Conflict armat").
-	FindSemanticallySimilarArticles(execution_context context.Context, embedding []float32, limit int) ([]*NewsArticleEntity, error)
+	// Fiecare rezultat vine cu similaritatea cosine, cele mai apropiate primele.
+	FindSemanticallySimilarArticles(execution_context context.Context, embedding []float32, filter SimilarityFilter, limit int) ([]SimilarArticle, error)
+
+	// [RO] Caută Pasaje Similare (Indexul pe Pasaje)
+	// Ca mai sus, dar la nivel de pasaj: detaliile din articolele lungi nu se pierd în vectorul întregului text.
+	FindSimilarArticleChunks(execution_context context.Context, embedding []float32, limit int) ([]ArticleChunkMatch, error)
 
 	// [RO] Verifică Existența (Deduplicare)
 	// O verificare rapidă pentru a vedea dacă acest URL a mai fost procesat vreodată.
 	// Folosită pentru a nu consuma credite AI pe același articol de două ori.
 	CheckIfArticleExistsByURL(execution_context context.Context, url string) (bool, error)
+
+	// [RO] Harta Gaia (Grupare pe Server)
+	// Știrile cu coordonate reale din zona vizibilă, grupate pe celule de grilă după zoom.
+	// Punctele fără coordonate sau pe "Null Island" (0,0) nu apar niciodată.
+	RetrieveGaiaClusters(execution_context context.Context, query GaiaMapQuery) ([]GaiaCluster, error)
+
+	// [RO] Știrile de pe Hartă, Negrupate (GeoJSON)
+	// Același filtru ca gruparea, cele mai noi primele.
+	RetrieveGaiaArticles(execution_context context.Context, query GaiaMapQuery, limit int) ([]GaiaArticlePoint, error)
+
+	// [RO] Agregare pe Țări
+	// Același filtru ca harta; o intrare per țară, cele mai acoperite primele.
+	RetrieveGaiaCountryAggregates(execution_context context.Context, query GaiaMapQuery) ([]GaiaCountryAggregate, error)
+
+	// [RO] Cele Mai Noi Știri (Fluxul de Știri)
+	// Opțional filtrate pe țară.
+	RetrieveLatestNewsArticles(execution_context context.Context, query NewsFeedQuery) ([]*NewsArticleEntity, error)
 }
@@ -0,0 +1,30 @@
+package article
+
+// [RO] Limite Flux de Știri
+const (
+	DefaultNewsFeedLimit = 20
+	MaxNewsFeedLimit     = 100
+)
+
+// [RO] Cererea Fluxului de Știri
+// Cele mai noi articole, opțional doar dintr-o țară.
+type NewsFeedQuery struct {
+	CountryCode string // ISO 3166-1 alpha-2; gol = toate țările
+	Limit       int
+
+	// [RO] Comutatorul cititorului: fiecare articol vine cu perspectivele poveștii lui
+	IncludePerspectives bool
+}
+
+// [RO] Validare și Valori Implicite
+func (query *NewsFeedQuery) Normalize() error {
+	countryCode, err := NormalizeCountryCode(query.CountryCode)
+	if err != nil {
+		return err
+	}
+	query.CountryCode = countryCode
+	if query.Limit <= 0 || query.Limit > MaxNewsFeedLimit {
+		query.Limit = DefaultNewsFeedLimit
+	}
+	return nil
+}
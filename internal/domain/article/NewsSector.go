@@ -0,0 +1,58 @@
+package article
+
+import "strings"
+
+// [RO] Sectoare (Domeniul Tematic al Știrii)
+// Lista închisă pe care o cerem modelului; agregatele analitice se fac pe aceste chei.
+const (
+	SectorPolitics    = "politics"
+	SectorEconomy     = "economy"
+	SectorTechnology  = "technology"
+	SectorScience     = "science"
+	SectorHealth      = "health"
+	SectorEnvironment = "environment"
+	SectorConflict    = "conflict"
+	SectorSociety     = "society"
+	SectorCulture     = "culture"
+	SectorSports      = "sports"
+)
+
+// [RO] Sinonime frecvente în răspunsurile modelului
+var sectorAliases = map[string]string{
+	"business":      SectorEconomy,
+	"finance":       SectorEconomy,
+	"markets":       SectorEconomy,
+	"tech":          SectorTechnology,
+	"climate":       SectorEnvironment,
+	"war":           SectorConflict,
+	"security":      SectorConflict,
+	"defense":       SectorConflict,
+	"defence":       SectorConflict,
+	"healthcare":    SectorHealth,
+	"medicine":      SectorHealth,
+	"entertainment": SectorCulture,
+	"sport":         SectorSports,
+}
+
+// [RO] Toate Sectoarele (ordinea din prompt)
+func Sectors() []string {
+	return []string{
+		SectorPolitics, SectorEconomy, SectorTechnology, SectorScience, SectorHealth,
+		SectorEnvironment, SectorConflict, SectorSociety, SectorCulture, SectorSports,
+	}
+}
+
+// [RO] Normalizare Sector
+// "Business" -> "economy". Un sector necunoscut devine "" (nu inventăm categorii noi).
+func NormalizeSector(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if alias, ok := sectorAliases[value]; ok {
+		return alias
+	}
+	for _, sector := range Sectors() {
+		if value == sector {
+			return sector
+		}
+	}
+	return ""
+}
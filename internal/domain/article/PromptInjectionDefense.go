@@ -0,0 +1,189 @@
+package article
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// [RO] Motivele pentru care o analiză ajunge la verificarea umană
+const (
+	ReviewReasonInjectionPattern   = "injection_pattern"            // Textul conține instrucțiuni adresate modelului
+	ReviewReasonModelFlagged       = "model_reported_injection"     // Modelul însuși a semnalat instrucțiuni în text
+	ReviewReasonPerfectVerbatim    = "perfect_score_verbatim"       // Scor perfect + "rescriere" identică cu originalul
+	ReviewReasonScoreOutOfRange    = "score_out_of_range"           // Scor în afara [0, 1]
+	ReviewReasonOutputInstructions = "output_contains_instructions" // Instrucțiunile au ajuns în textul generat
+)
+
+// [RO] Pragurile Anomaliilor
+const (
+	// [RO] De la acest scor în sus, analiza e "perfectă" (rară pentru o știre reală)
+	PerfectTruthScore = 0.99
+
+	// [RO] Câte caractere invizibile (zero-width) tolerăm înainte de a bănui text ascuns
+	maxZeroWidthCharacters = 3
+
+	// [RO] Lungimea fragmentului păstrat ca dovadă
+	injectionExcerptRunes = 80
+)
+
+// [RO] Semnal de Injecție: un tipar găsit în textul nesigur
+type InjectionSignal struct {
+	Pattern string `json:"pattern"`
+	Offset  int    `json:"offset"` // Caractere de la începutul textului
+	Excerpt string `json:"excerpt"`
+}
+
+// [RO] Tiparele Instrucțiunilor (engleză și română)
+// `\b` din RE2 știe doar ASCII, deci tiparele românești se ancorează pe spații.
+var injectionPatterns = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"ignore_instructions", regexp.MustCompile(`(?i)\b(ignore|disregard|forget|override)\b[^.\n]{0,40}\b(previous|prior|above|earlier|all|any|system)\b[^.\n]{0,20}\b(instructions?|prompts?|rules?|directions?)\b`)},
+	{"ignore_instructions", regexp.MustCompile(`(?i)(ignoră|ignorați|ignora|uită|uitați)\s[^.\n]{0,40}(instrucțiunile|instructiunile|regulile)`)},
+	{"role_override", regexp.MustCompile(`(?i)\byou are (now )?(an? |the )?(ai|assistant|language model|llm|oracle|chatbot|gpt|gemini)\b`)},
+	{"role_override", regexp.MustCompile(`(?i)\b(act|behave|respond|answer) as (an? |the )?(ai|assistant|language model|oracle|system)\b`)},
+	{"system_prompt", regexp.MustCompile(`(?i)\b(system|developer) (prompt|message|instructions?)\b`)},
+	{"output_manipulation", regexp.MustCompile(`(?i)"?\b(truth_score|bias_rating|neutral_text|injection_suspected)\b"?\s*[:=]`)},
+	{"output_manipulation", regexp.MustCompile(`(?i)\b(set|give|assign|rate|return|output)\b[^.\n]{0,30}\b(truth|credibility|trust)\b[^.\n]{0,20}\b(1\.0|1|100%?|maximum|perfect)\b`)},
+	{"chat_markup", regexp.MustCompile(`(?i)</?\s*(system|assistant|user|instructions?)\s*>|\[/?INST\]|<\|im_(start|end)\|>`)},
+	{"hidden_text", bidiControlCharacters},
+}
+
+// [RO] Caractere care ascund sau reordonează textul pentru cititorul uman
+var (
+	bidiControlCharacters = regexp.MustCompile(`[\x{202A}-\x{202E}\x{2066}-\x{2069}]`)
+	zeroWidthCharacters   = regexp.MustCompile(`[\x{200B}-\x{200F}\x{2060}\x{FEFF}]`)
+)
+
+// [RO] Detectează Instrucțiunile din Textul Nesigur
+// Câte un semnal pentru fiecare tipar găsit (prima apariție), în ordinea din text.
+func DetectInjectionPatterns(text string) []InjectionSignal {
+	var signals []InjectionSignal
+	seen := map[string]bool{}
+	for _, candidate := range injectionPatterns {
+		if seen[candidate.name] {
+			continue
+		}
+		location := candidate.pattern.FindStringIndex(text)
+		if location == nil {
+			continue
+		}
+		seen[candidate.name] = true
+		signals = append(signals, newInjectionSignal(candidate.name, text, location[0]))
+	}
+
+	if matches := zeroWidthCharacters.FindAllStringIndex(text, -1); len(matches) > maxZeroWidthCharacters && !seen["hidden_text"] {
+		signals = append(signals, newInjectionSignal("hidden_text", text, matches[0][0]))
+	}
+
+	sort.SliceStable(signals, func(i, j int) bool { return signals[i].Offset < signals[j].Offset })
+	return signals
+}
+
+func newInjectionSignal(name string, text string, byteOffset int) InjectionSignal {
+	excerpt := []rune(text[byteOffset:])
+	if len(excerpt) > injectionExcerptRunes {
+		excerpt = excerpt[:injectionExcerptRunes]
+	}
+	return InjectionSignal{
+		Pattern: name,
+		Offset:  utf8.RuneCountInString(text[:byteOffset]),
+		Excerpt: strings.TrimSpace(zeroWidthCharacters.ReplaceAllString(string(excerpt), "")),
+	}
+}
+
+// [RO] Delimitează Textul Nesigur pentru Prompt
+//
+// Textul stă între `<untrusted_{label}_{nonce}>` și `</untrusted_{label}_{nonce}>`. Nonce-ul e aleator
+// (generat de apelant la fiecare cerere), deci pagina nu poate ghici eticheta de închidere;
+// în plus `<` și `>` sunt scăpate, iar caracterele de control și cele invizibile sunt eliminate.
+func DelimitUntrustedContent(label string, nonce string, content string) string {
+	tag := fmt.Sprintf("untrusted_%s_%s", label, nonce)
+	return fmt.Sprintf("<%s>\n%s\n</%s>", tag, EscapeUntrustedContent(content), tag)
+}
+
+// [RO] Scăparea Textului Nesigur (fără delimitare)
+func EscapeUntrustedContent(content string) string {
+	escaped, _ := escapeUntrustedContentWithOffsets(content)
+	return escaped
+}
+
+// [RO] Scăparea cu Harta Pozițiilor
+// offsets[i] este caracterul din `content` din care provine caracterul i al textului scăpat
+// ("&lt;" are patru caractere care provin toate din același `<`); caracterele eliminate nu apar.
+// Așa, un fragment citat de model din textul pe care l-a văzut poate fi dus înapoi în original.
+func escapeUntrustedContentWithOffsets(content string) (string, []int) {
+	var builder strings.Builder
+	var offsets []int
+	position := 0
+	for _, r := range content {
+		switch {
+		case zeroWidthCharacters.MatchString(string(r)), bidiControlCharacters.MatchString(string(r)):
+		case r < 0x20 && r != '\n' && r != '\t':
+		case r == '<':
+			builder.WriteString("&lt;")
+			offsets = append(offsets, position, position, position, position)
+		case r == '>':
+			builder.WriteString("&gt;")
+			offsets = append(offsets, position, position, position, position)
+		default:
+			builder.WriteRune(r)
+			offsets = append(offsets, position)
+		}
+		position++
+	}
+	return builder.String(), offsets
+}
+
+// [RO] Verifică Integritatea Analizei
+//
+// Returnează motivele (ReviewReason*) pentru care analiza trebuie văzută de un om; nil = în regulă.
+// Un scor perfect singur nu e suspect; împreună cu o "rescriere" care e de fapt textul original
+// este semnătura unei pagini care a dictat rezultatul.
+func ReviewAnalysisIntegrity(rawContent string, result AIAnalysisResult) []string {
+	var reasons []string
+	if len(DetectInjectionPatterns(rawContent)) > 0 {
+		reasons = append(reasons, ReviewReasonInjectionPattern)
+	}
+	if result.InjectionSuspected {
+		reasons = append(reasons, ReviewReasonModelFlagged)
+	}
+	if result.Score < 0 || result.Score > 1 {
+		reasons = append(reasons, ReviewReasonScoreOutOfRange)
+	}
+	if result.Score >= PerfectTruthScore && isVerbatimRewrite(rawContent, result.RewrittenText) {
+		reasons = append(reasons, ReviewReasonPerfectVerbatim)
+	}
+	for _, generated := range []string{result.RewrittenText, result.Summary, result.CounterArgument} {
+		if hasInstructionPattern(generated) {
+			reasons = append(reasons, ReviewReasonOutputInstructions)
+			break
+		}
+	}
+	return reasons
+}
+
+// [RO] Rescrierea e (aproape) textul original? Aceeași amprentă lexicală sau aceleași cuvinte.
+func isVerbatimRewrite(rawContent string, rewritten string) bool {
+	original, okOriginal := ComputeContentFingerprint(rawContent)
+	rewrite, okRewrite := ComputeContentFingerprint(rewritten)
+	if okOriginal && okRewrite {
+		return original.ContentHash == rewrite.ContentHash ||
+			HammingDistance(original.SimHash, rewrite.SimHash) <= NearDuplicateMaxDistance
+	}
+	return strings.Join(fingerprintTokens(rawContent), " ") == strings.Join(fingerprintTokens(rewritten), " ")
+}
+
+// [RO] Textul generat conține instrucțiuni (nu și caractere ascunse, pe care modelul le poate copia inocent)
+func hasInstructionPattern(text string) bool {
+	for _, signal := range DetectInjectionPatterns(text) {
+		if signal.Pattern != "hidden_text" {
+			return true
+		}
+	}
+	return false
+}
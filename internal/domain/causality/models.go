@@ -1,10 +1,35 @@
 package causality
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // EventID is a strongly typed identifier
 type EventID string
 
+// ErrEventNotFound is returned when an event id has no node in the graph
+// (never stored, or merged away).
+var ErrEventNotFound = errors.New("event not found in graph")
+
+// storyEventNamespace scopes the deterministic (UUIDv5) event identifiers.
+var storyEventNamespace = uuid.MustParse("6f1f6c1e-4b1e-5a57-9d0c-7e2c1b3f9a10")
+
+// StoryEventID derives the Event identity from a story cluster key, so every
+// article reporting the same story resolves to the same Event node.
+func StoryEventID(clusterKey string) EventID {
+	return EventID(uuid.NewSHA1(storyEventNamespace, []byte(clusterKey)).String())
+}
+
+// IsLegacyEventID reports whether an event id is a random (UUIDv4) id created
+// before events were derived from story clusters.
+func IsLegacyEventID(eventID string) bool {
+	id, err := uuid.Parse(eventID)
+	return err == nil && id.Version() == 4
+}
+
 // CausalEvent is a Generic Event Node compatible with Go 1.24 (generics).
 // T allows us to store either Raw Text (for processing) or Vector Embeddings (for search).
 type CausalEvent[T any] struct {
@@ -12,7 +37,7 @@ type CausalEvent[T any] struct {
 	Timestamp      time.Time
 	Summary        string
 	NeutralSummary string    // Generated by "Emotional Noise Filter"
-	TrustScore     float64   // Calculated via "Bridging Consensus"
+	TrustScore     float64   // Model-estimated bridging score; reader consensus lives in community.ItemScore
 	Payload        T         // Generics: Could be string or float32 (vector)
 	Causes         []EventID // Upstream parents (DAG)
 	Effects        []EventID // Downstream children
@@ -0,0 +1,146 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
+)
+
+// [RO] Rolurile dintr-o Conversație
+const (
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// [RO] Limite Conversație
+const (
+	MaxMessageLength = 2000
+
+	// [RO] Bugetul istoricului trimis modelului (caractere, fără rezumat și fără surse).
+	// Peste el, replicile vechi sunt comprimate într-un rezumat.
+	HistoryBudgetChars = 6000
+
+	// [RO] Câte replici recente rămân mereu textuale (restul pot intra în rezumat)
+	KeepRecentTurns = 6
+)
+
+// [RO] Sesiunea cerută nu există (sau a fost ștearsă)
+var ErrChatSessionNotFound = errors.New("[RO] Eroare: Conversația nu a fost găsită.")
+
+// [RO] Sesiune de Chat cu Oracolul
+// Opțional legată de un articol (întrebările despre "această știre").
+type ChatSession struct {
+	ID        uuid.UUID `json:"id"`
+	ArticleID uuid.UUID `json:"article_id"` // uuid.Nil = conversație generală
+
+	// [RO] Rezumatul replicilor 1..SummarizedThrough (gol până la prima comprimare)
+	Summary           string `json:"summary,omitempty"`
+	SummarizedThrough int    `json:"summarized_through"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// [RO] Replică (Întrebare sau Răspuns)
+type ChatTurn struct {
+	SessionID uuid.UUID `json:"-"`
+	Sequence  int       `json:"sequence"` // 1, 2, 3... în ordinea conversației
+	Role      string    `json:"role"`
+	Content   string    `json:"content"`
+
+	// [RO] Doar la întrebări: întrebarea reformulată autonom, folosită la căutare
+	RetrievalQuery string `json:"retrieval_query,omitempty"`
+
+	// [RO] Doar la răspunsuri: fragmentele din articole care susțin răspunsul
+	Citations []grounding.Citation `json:"citations,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// [RO] Validarea Mesajului Utilizatorului
+func NormalizeMessage(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", fmt.Errorf("[RO] Eroare: Mesajul nu poate fi gol.")
+	}
+	if utf8.RuneCountInString(content) > MaxMessageLength {
+		return "", fmt.Errorf("[RO] Eroare: Mesajul depășește %d de caractere.", MaxMessageLength)
+	}
+	return content, nil
+}
+
+// [RO] Replicile Încă Nerezumate
+func (session ChatSession) PendingTurns(turns []ChatTurn) []ChatTurn {
+	var pending []ChatTurn
+	for _, turn := range turns {
+		if turn.Sequence > session.SummarizedThrough {
+			pending = append(pending, turn)
+		}
+	}
+	return pending
+}
+
+// [RO] Ce Trebuie Comprimat
+// Dacă replicile nerezumate depășesc bugetul, returnează replicile vechi de comprimat
+// (toate, mai puțin ultimele KeepRecentTurns). Altfel, nil.
+func (session ChatSession) TurnsToSummarize(turns []ChatTurn) []ChatTurn {
+	pending := session.PendingTurns(turns)
+	if len(pending) <= KeepRecentTurns || TranscriptLength(pending) <= HistoryBudgetChars {
+		return nil
+	}
+	return pending[:len(pending)-KeepRecentTurns]
+}
+
+// [RO] Lungimea Textuală a unor Replici
+func TranscriptLength(turns []ChatTurn) int {
+	total := 0
+	for _, turn := range turns {
+		total += utf8.RuneCountInString(turn.Content)
+	}
+	return total
+}
+
+// [RO] Transcrierea Conversației (pentru model)
+// Rezumatul (dacă există) urmat de replicile nerezumate, câte una pe linie.
+func (session ChatSession) RenderTranscript(turns []ChatTurn) string {
+	var builder strings.Builder
+	if session.Summary != "" {
+		builder.WriteString("Summary of earlier conversation: ")
+		builder.WriteString(session.Summary)
+		builder.WriteString("\n")
+	}
+	builder.WriteString(RenderTurns(session.PendingTurns(turns)))
+	return builder.String()
+}
+
+// [RO] Replicile ca Text ("User: ... / Assistant: ...")
+func RenderTurns(turns []ChatTurn) string {
+	var builder strings.Builder
+	for _, turn := range turns {
+		speaker := "User"
+		if turn.Role == RoleAssistant {
+			speaker = "Assistant"
+		}
+		builder.WriteString(speaker + ": " + turn.Content + "\n")
+	}
+	return builder.String()
+}
+
+// [RO] Interfața de Persistență a Conversațiilor
+type ChatSessionPersistenceInterface interface {
+	CreateChatSession(ctx context.Context, session ChatSession) error
+
+	// [RO] Sesiunea și toate replicile ei, în ordine; ErrChatSessionNotFound dacă nu există
+	RetrieveChatSession(ctx context.Context, id uuid.UUID) (*ChatSession, []ChatTurn, error)
+
+	AppendChatTurns(ctx context.Context, turns []ChatTurn) error
+
+	// [RO] Înlocuiește rezumatul (acoperă acum replicile 1..summarizedThrough)
+	UpdateChatSummary(ctx context.Context, id uuid.UUID, summary string, summarizedThrough int) error
+}
@@ -0,0 +1,61 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func conversation(count int, length int) []ChatTurn {
+	var turns []ChatTurn
+	for i := 1; i <= count; i++ {
+		role := RoleUser
+		if i%2 == 0 {
+			role = RoleAssistant
+		}
+		turns = append(turns, ChatTurn{Sequence: i, Role: role, Content: strings.Repeat("x", length)})
+	}
+	return turns
+}
+
+func TestTurnsToSummarize(t *testing.T) {
+	session := ChatSession{}
+
+	// [RO] Sub buget: nimic de comprimat, oricâte replici.
+	assert.Nil(t, session.TurnsToSummarize(conversation(20, 10)))
+
+	// [RO] Peste buget: totul, mai puțin ultimele KeepRecentTurns.
+	older := session.TurnsToSummarize(conversation(10, 1000))
+	require.Len(t, older, 10-KeepRecentTurns)
+	assert.Equal(t, 4, older[len(older)-1].Sequence)
+
+	// [RO] Replicile deja rezumate nu mai contează la buget.
+	session.SummarizedThrough = 4
+	assert.Nil(t, session.TurnsToSummarize(conversation(10, 1000)))
+}
+
+func TestRenderTranscript(t *testing.T) {
+	session := ChatSession{Summary: "User asked about the Suez blockage.", SummarizedThrough: 2}
+	turns := []ChatTurn{
+		{Sequence: 1, Role: RoleUser, Content: "old"},
+		{Sequence: 2, Role: RoleAssistant, Content: "old answer"},
+		{Sequence: 3, Role: RoleUser, Content: "What caused it?"},
+		{Sequence: 4, Role: RoleAssistant, Content: "A ship ran aground."},
+	}
+
+	assert.Equal(t, "Summary of earlier conversation: User asked about the Suez blockage.\n"+
+		"User: What caused it?\nAssistant: A ship ran aground.\n", session.RenderTranscript(turns))
+}
+
+func TestNormalizeMessage(t *testing.T) {
+	message, err := NormalizeMessage("  and what happened after that?  ")
+	require.NoError(t, err)
+	assert.Equal(t, "and what happened after that?", message)
+
+	_, err = NormalizeMessage("   ")
+	assert.Error(t, err)
+	_, err = NormalizeMessage(strings.Repeat("a", MaxMessageLength+1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package community
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+	"time"
+)
+
+// [RO] Parametrii Factorizării (Bridging Consensus, ca la Community Notes)
+//
+// Evaluarea prezisă: r̂ = μ + i_evaluator + i_element + f_evaluator · f_element.
+// Factorul (o singură dimensiune) învață "tabăra" evaluatorului; interceptul elementului
+// rămâne mare doar când aprecierea vine din ambele tabere. Interceptele sunt regularizate
+// mai puternic decât factorii, ca acordul partizan să fie explicat de factori, nu de intercept.
+type BridgingModel struct {
+	InterceptRegularization float64
+	FactorRegularization    float64
+	Iterations              int
+
+	MinRatings         int     // Sub acest număr de evaluări elementul rămâne needs_more_ratings
+	HelpfulIntercept   float64 // helpful: interceptul cel puțin atât...
+	MaxHelpfulFactor   float64 // ...și |factorul| sub atât
+	NotHelpfulBase     float64 // not_helpful: interceptul ≤ NotHelpfulBase - NotHelpfulSlope·|factor|
+	NotHelpfulSlope    float64
+	InitialFactorScale float64
+}
+
+// [RO] Model Implicit (pragurile publicate de Community Notes, pe scala 0–1)
+func NewBridgingModel() BridgingModel {
+	return BridgingModel{
+		InterceptRegularization: 1.5,
+		FactorRegularization:    0.3,
+		Iterations:              60,
+		MinRatings:              5,
+		HelpfulIntercept:        0.40,
+		MaxHelpfulFactor:        0.5,
+		NotHelpfulBase:          -0.05,
+		NotHelpfulSlope:         0.8,
+		InitialFactorScale:      0.5,
+	}
+}
+
+// [RO] Calculează Scorurile unei Dimensiuni
+// Determinist: aceleași evaluări dau aceleași scoruri (factorii pornesc dintr-un hash al evaluatorului).
+func (model BridgingModel) Score(ratings []Rating, scoredAt time.Time) []ItemScore {
+	if len(ratings) == 0 {
+		return nil
+	}
+
+	type observation struct {
+		rater int
+		item  int
+		value float64
+	}
+
+	raterIndex := map[string]int{}
+	itemIndex := map[ItemKey]int{}
+	var items []ItemKey
+	var raters []string
+	var observations []observation
+	for _, rating := range ratings {
+		value, ok := ratingValues[rating.Rating]
+		if !ok {
+			continue
+		}
+		rater, seen := raterIndex[rating.RaterID]
+		if !seen {
+			rater = len(raters)
+			raterIndex[rating.RaterID] = rater
+			raters = append(raters, rating.RaterID)
+		}
+		key := ItemKey{ItemType: rating.ItemType, ArticleID: rating.ArticleID, Dimension: rating.Dimension}
+		item, seen := itemIndex[key]
+		if !seen {
+			item = len(items)
+			itemIndex[key] = item
+			items = append(items, key)
+		}
+		observations = append(observations, observation{rater: rater, item: item, value: value})
+	}
+
+	raterIntercepts := make([]float64, len(raters))
+	raterFactors := make([]float64, len(raters))
+	itemIntercepts := make([]float64, len(items))
+	itemFactors := make([]float64, len(items))
+	for index, rater := range raters {
+		raterFactors[index] = model.initialFactor(rater)
+	}
+
+	byItem := make([][]observation, len(items))
+	byRater := make([][]observation, len(raters))
+	for _, obs := range observations {
+		byItem[obs.item] = append(byItem[obs.item], obs)
+		byRater[obs.rater] = append(byRater[obs.rater], obs)
+	}
+
+	// [RO] Cele mai mici pătrate alternante: fiecare pas are soluție exactă (sistem 2x2)
+	globalIntercept := 0.0
+	for iteration := 0; iteration < model.Iterations; iteration++ {
+		residual := 0.0
+		for _, obs := range observations {
+			residual += obs.value - raterIntercepts[obs.rater] - itemIntercepts[obs.item] - raterFactors[obs.rater]*itemFactors[obs.item]
+		}
+		globalIntercept = residual / (float64(len(observations)) + model.InterceptRegularization)
+
+		for item, itemObservations := range byItem {
+			var sumX, sumXX, sumY, sumXY float64
+			for _, obs := range itemObservations {
+				x := raterFactors[obs.rater]
+				y := obs.value - globalIntercept - raterIntercepts[obs.rater]
+				sumX += x
+				sumXX += x * x
+				sumY += y
+				sumXY += x * y
+			}
+			itemIntercepts[item], itemFactors[item] = model.solve(float64(len(itemObservations)), sumX, sumXX, sumY, sumXY)
+		}
+
+		for rater, raterObservations := range byRater {
+			var sumX, sumXX, sumY, sumXY float64
+			for _, obs := range raterObservations {
+				x := itemFactors[obs.item]
+				y := obs.value - globalIntercept - itemIntercepts[obs.item]
+				sumX += x
+				sumXX += x * x
+				sumY += y
+				sumXY += x * y
+			}
+			raterIntercepts[rater], raterFactors[rater] = model.solve(float64(len(raterObservations)), sumX, sumXX, sumY, sumXY)
+		}
+	}
+
+	scores := make([]ItemScore, len(items))
+	for item, key := range items {
+		scores[item] = ItemScore{
+			ItemKey:       key,
+			BridgingScore: itemIntercepts[item],
+			Polarization:  itemFactors[item],
+			RatingCount:   len(byItem[item]),
+			ScoredAt:      scoredAt,
+		}
+		scores[item].Status = model.status(scores[item])
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].BridgingScore > scores[j].BridgingScore })
+	return scores
+}
+
+// [RO] Regresie ridge pentru (intercept, factor) cu trăsăturile (1, x)
+func (model BridgingModel) solve(count, sumX, sumXX, sumY, sumXY float64) (float64, float64) {
+	a := count + model.InterceptRegularization
+	b := sumX
+	d := sumXX + model.FactorRegularization
+	determinant := a*d - b*b
+	if determinant == 0 {
+		return 0, 0
+	}
+	return (d*sumY - b*sumXY) / determinant, (a*sumXY - b*sumY) / determinant
+}
+
+// [RO] Starea elementului după pragurile modelului
+func (model BridgingModel) status(score ItemScore) string {
+	if score.RatingCount < model.MinRatings {
+		return StatusNeedsMoreRatings
+	}
+	polarization := math.Abs(score.Polarization)
+	if score.BridgingScore >= model.HelpfulIntercept && polarization < model.MaxHelpfulFactor {
+		return StatusHelpful
+	}
+	if score.BridgingScore <= model.NotHelpfulBase-model.NotHelpfulSlope*polarization {
+		return StatusNotHelpful
+	}
+	return StatusNeedsMoreRatings
+}
+
+// [RO] Factorul inițial al evaluatorului, în [-scale, scale], derivat din ID
+func (model BridgingModel) initialFactor(raterID string) float64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(raterID))
+	unit := float64(hash.Sum64()%2001)/1000 - 1
+	if unit == 0 {
+		unit = 0.001
+	}
+	return unit * model.InitialFactorScale
+}
@@ -0,0 +1,77 @@
+package community
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgingModel_RanksOnlyCrossCampAgreementHelpful(t *testing.T) {
+	bridging, partisanLeft, partisanRight, rejected, sparse := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	var ratings []Rating
+	rate := func(rater string, articleID uuid.UUID, value string) {
+		ratings = append(ratings, Rating{ItemType: ItemArticle, ArticleID: articleID, Dimension: DimensionHelpfulness, RaterID: rater, Rating: value})
+	}
+	// [RO] Două tabere inegale: 8 evaluatori "stânga", 4 "dreapta"
+	for index := 0; index < 12; index++ {
+		rater, left := fmt.Sprintf("right-rater-%02d", index), false
+		if index < 8 {
+			rater, left = fmt.Sprintf("left-rater-%02d", index), true
+		}
+		side := map[bool]string{true: RatingYes, false: RatingNo}
+
+		rate(rater, bridging, RatingYes)
+		rate(rater, partisanLeft, side[left])
+		rate(rater, partisanRight, side[!left])
+		rate(rater, rejected, RatingNo)
+		if index < 2 {
+			rate(rater, sparse, RatingYes)
+		}
+	}
+
+	scoredAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	scores := map[uuid.UUID]ItemScore{}
+	for _, score := range NewBridgingModel().Score(ratings, scoredAt) {
+		scores[score.ArticleID] = score
+	}
+	require.Len(t, scores, 5)
+
+	assert.Equal(t, StatusHelpful, scores[bridging].Status)
+	assert.Equal(t, 12, scores[bridging].RatingCount)
+	assert.Equal(t, scoredAt, scores[bridging].ScoredAt)
+
+	// [RO] Tabăra majoritară singură nu face un articol "helpful"
+	assert.Equal(t, StatusNeedsMoreRatings, scores[partisanLeft].Status)
+	assert.Less(t, scores[partisanLeft].BridgingScore, scores[bridging].BridgingScore)
+	assert.Less(t, scores[partisanLeft].Polarization*scores[partisanRight].Polarization, 0.0, "[RO] Taberele opuse au factori de semn opus")
+
+	assert.Equal(t, StatusNotHelpful, scores[rejected].Status)
+	assert.Equal(t, StatusNeedsMoreRatings, scores[sparse].Status, "[RO] Sub MinRatings")
+
+	// [RO] Determinist
+	again := NewBridgingModel().Score(ratings, scoredAt)
+	for _, score := range again {
+		assert.InDelta(t, scores[score.ArticleID].BridgingScore, score.BridgingScore, 1e-12)
+	}
+}
+
+func TestRating_Validate(t *testing.T) {
+	valid := Rating{ItemType: ItemCounterArgument, ArticleID: uuid.New(), Dimension: DimensionFairness, RaterID: "install-7f3a9c", Rating: RatingSomewhat}
+	assert.NoError(t, valid.Validate())
+
+	for _, mutate := range []func(*Rating){
+		func(r *Rating) { r.ItemType = "comment" },
+		func(r *Rating) { r.Dimension = "accuracy" },
+		func(r *Rating) { r.Rating = "5" },
+		func(r *Rating) { r.RaterID = "short" },
+	} {
+		invalid := valid
+		mutate(&invalid)
+		assert.Error(t, invalid.Validate())
+	}
+}
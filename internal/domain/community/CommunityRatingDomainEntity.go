@@ -0,0 +1,120 @@
+package community
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Ce poate evalua comunitatea
+const (
+	ItemArticle         = "article"
+	ItemCounterArgument = "counter_argument" // Contraargumentul ("Avocatul Diavolului") al articolului
+)
+
+// [RO] Întrebarea la care răspunde evaluatorul
+const (
+	DimensionHelpfulness = "helpfulness" // "Te-a ajutat să înțelegi subiectul?"
+	DimensionFairness    = "fairness"    // "Este corect față de toate părțile?"
+)
+
+// [RO] Răspunsurile posibile (și valoarea lor în model)
+const (
+	RatingYes      = "yes"
+	RatingSomewhat = "somewhat"
+	RatingNo       = "no"
+)
+
+// [RO] Starea Scorului Comunității
+const (
+	StatusHelpful          = "helpful"            // Apreciat de evaluatori cu puncte de vedere opuse
+	StatusNotHelpful       = "not_helpful"        // Respins, inclusiv de cei care ar fi trebuit să-l placă
+	StatusNeedsMoreRatings = "needs_more_ratings" // Prea puține evaluări sau apreciat de o singură tabără
+)
+
+// [RO] Limitele Evaluatorului (identificator pseudonim emis de server, vezi RaterCredentials)
+const (
+	MinRaterIDRunes = 8
+	MaxRaterIDRunes = 128
+)
+
+// [RO] Articolul evaluat nu există (sau nu a fost încă publicat)
+var ErrRatedArticleNotFound = errors.New("[RO] Articolul evaluat nu există.")
+
+// [RO] Toate dimensiunile, în ordinea în care sunt calculate
+var Dimensions = []string{DimensionHelpfulness, DimensionFairness}
+
+// [RO] Evaluarea unui Cititor
+// O evaluare nouă a aceluiași cititor pentru același element o înlocuiește pe cea veche.
+type Rating struct {
+	ItemType  string    `json:"item_type"`
+	ArticleID uuid.UUID `json:"article_id"`
+	Dimension string    `json:"dimension"`
+	RaterID   string    `json:"-"` // Derivat de server din jetonul evaluatorului
+	Rating    string    `json:"rating"`
+	RatedAt   time.Time `json:"rated_at"`
+}
+
+// [RO] Validarea Evaluării
+func (rating Rating) Validate() error {
+	if rating.ItemType != ItemArticle && rating.ItemType != ItemCounterArgument {
+		return fmt.Errorf("[RO] Eroare: item_type necunoscut %q (article sau counter_argument).", rating.ItemType)
+	}
+	if rating.Dimension != DimensionHelpfulness && rating.Dimension != DimensionFairness {
+		return fmt.Errorf("[RO] Eroare: dimensiune necunoscută %q (helpfulness sau fairness).", rating.Dimension)
+	}
+	if _, ok := ratingValues[rating.Rating]; !ok {
+		return fmt.Errorf("[RO] Eroare: rating necunoscut %q (yes, somewhat sau no).", rating.Rating)
+	}
+	length := utf8.RuneCountInString(strings.TrimSpace(rating.RaterID))
+	if length < MinRaterIDRunes || length > MaxRaterIDRunes {
+		return fmt.Errorf("[RO] Eroare: rater_id trebuie să aibă între %d și %d de caractere.", MinRaterIDRunes, MaxRaterIDRunes)
+	}
+	return nil
+}
+
+// [RO] Valoarea numerică a răspunsului (0 = nu, 1 = da)
+var ratingValues = map[string]float64{RatingNo: 0, RatingSomewhat: 0.5, RatingYes: 1}
+
+// [RO] Elementul evaluat, pe o dimensiune
+type ItemKey struct {
+	ItemType  string    `json:"item_type"`
+	ArticleID uuid.UUID `json:"article_id"`
+	Dimension string    `json:"dimension"`
+}
+
+// [RO] Semnalul de Încredere al Comunității
+// Separat de scorul modelului: BridgingScore este interceptul elementului din factorizare,
+// adică aprecierea care rămâne după ce scădem ce se explică prin tabăra evaluatorilor.
+// Polarization este factorul elementului: departe de 0 = apreciat de o singură tabără.
+type ItemScore struct {
+	ItemKey
+	BridgingScore float64   `json:"bridging_score"`
+	Polarization  float64   `json:"polarization"`
+	RatingCount   int       `json:"rating_count"`
+	Status        string    `json:"status"`
+	ScoredAt      time.Time `json:"scored_at"`
+}
+
+// [RO] Interfața de Persistență a Evaluărilor Comunității
+type CommunityRatingPersistenceInterface interface {
+	SaveRating(ctx context.Context, rating Rating) error
+
+	// [RO] Toate evaluările unei dimensiuni (intrarea factorizării)
+	RetrieveRatings(ctx context.Context, dimension string) ([]Rating, error)
+
+	// [RO] Înlocuiește scorurile calculate pentru elementele primite
+	SaveItemScores(ctx context.Context, scores []ItemScore) error
+
+	// [RO] Scorurile articolului și ale contraargumentului său
+	RetrieveItemScores(ctx context.Context, articleID uuid.UUID) ([]ItemScore, error)
+
+	// [RO] Identitățile emise (amprenta clientului, pentru limitarea emiterii)
+	SaveRaterIssuance(ctx context.Context, raterID string, issuerFingerprint string, issuedAt time.Time) error
+	CountRatersIssued(ctx context.Context, issuerFingerprint string, since time.Time) (int, error)
+}
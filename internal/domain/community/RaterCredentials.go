@@ -0,0 +1,90 @@
+package community
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// [RO] Limitele Identității Evaluatorului
+const (
+	// [RO] Câte elemente trebuie să fi evaluat cineva pe o dimensiune ca să conteze în consens
+	MinRaterHistory = 3
+
+	// [RO] Câte identități noi primește același client într-o fereastră
+	MaxRatersPerClient  = 3
+	RaterIssuanceWindow = 24 * time.Hour
+)
+
+// [RO] Jetonul evaluatorului lipsește, e modificat sau a fost semnat cu alt secret
+var ErrInvalidRaterToken = errors.New("[RO] Jetonul de evaluator lipsește sau nu este valid (cere unul nou: POST /api/v1/raters).")
+
+// [RO] Clientul a cerut prea multe identități de evaluator în fereastra curentă
+var ErrRaterIssuanceRateLimited = errors.New("[RO] Prea multe identități de evaluator cerute de pe acest dispozitiv. Încearcă mai târziu.")
+
+// [RO] Emitentul Identităților de Evaluator
+//
+// Serverul alege ID-ul evaluatorului (aleator) și îl semnează (HMAC-SHA256); clientul primește
+// jetonul `ID.semnătură` și îl trimite la fiecare evaluare. Fără secret, clientul nu poate
+// inventa identități noi: fiecare trece prin emitere, care e limitată per client.
+type RaterCredentials struct {
+	secret []byte
+}
+
+// [RO] Constructor Emitent (secretul vine din configurație: RATER_TOKEN_SECRET)
+func NewRaterCredentials(secret string) *RaterCredentials {
+	return &RaterCredentials{secret: []byte(secret)}
+}
+
+// [RO] Identitate Nouă: ID-ul evaluatorului și jetonul semnat
+func (credentials *RaterCredentials) Issue() (string, string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", err
+	}
+	raterID := "rater-" + hex.EncodeToString(random)
+	return raterID, raterID + "." + credentials.sign(raterID), nil
+}
+
+// [RO] ID-ul Evaluatorului dintr-un Jeton (ErrInvalidRaterToken dacă semnătura nu se potrivește)
+func (credentials *RaterCredentials) Verify(token string) (string, error) {
+	raterID, signature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || raterID == "" || !hmac.Equal([]byte(signature), []byte(credentials.sign(raterID))) {
+		return "", ErrInvalidRaterToken
+	}
+	return raterID, nil
+}
+
+func (credentials *RaterCredentials) sign(raterID string) string {
+	mac := hmac.New(sha256.New, credentials.secret)
+	mac.Write([]byte(raterID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// [RO] Amprenta Clientului care Cere Identități (nu păstrăm IP-ul în clar)
+func IssuerFingerprint(clientIP string) string {
+	sum := sha256.Sum256([]byte("truthweave-rater:" + clientIP))
+	return hex.EncodeToString(sum[:16])
+}
+
+// [RO] Evaluările Evaluatorilor cu Istoric
+// Un evaluator cu mai puțin de `minHistory` elemente evaluate (în lista primită) e ignorat:
+// identitățile nou create nu pot muta singure consensul.
+func EstablishedRaterRatings(ratings []Rating, minHistory int) []Rating {
+	history := map[string]int{}
+	for _, rating := range ratings {
+		history[rating.RaterID]++
+	}
+	established := make([]Rating, 0, len(ratings))
+	for _, rating := range ratings {
+		if history[rating.RaterID] >= minHistory {
+			established = append(established, rating)
+		}
+	}
+	return established
+}
@@ -0,0 +1,43 @@
+package community
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaterCredentials_RejectsClientChosenAndForgedTokens(t *testing.T) {
+	credentials := NewRaterCredentials("secret")
+	raterID, token, err := credentials.Issue()
+	require.NoError(t, err)
+
+	verified, err := credentials.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, raterID, verified)
+	assert.NoError(t, Rating{ItemType: ItemArticle, Dimension: DimensionFairness, RaterID: verified, Rating: RatingYes}.Validate())
+
+	signature := strings.SplitN(token, ".", 2)[1]
+	for _, forged := range []string{"", raterID, "rater-mine." + signature, token + "x"} {
+		_, err := credentials.Verify(forged)
+		assert.ErrorIs(t, err, ErrInvalidRaterToken, forged)
+	}
+	_, err = NewRaterCredentials("other").Verify(token)
+	assert.ErrorIs(t, err, ErrInvalidRaterToken)
+}
+
+func TestEstablishedRaterRatings_DropsRatersWithoutHistory(t *testing.T) {
+	var ratings []Rating
+	for i := 0; i < MinRaterHistory; i++ {
+		ratings = append(ratings, Rating{ArticleID: uuid.New(), RaterID: "rater-regular", Rating: RatingYes})
+	}
+	ratings = append(ratings, Rating{ArticleID: ratings[0].ArticleID, RaterID: "rater-fresh", Rating: RatingNo})
+
+	established := EstablishedRaterRatings(ratings, MinRaterHistory)
+	require.Len(t, established, MinRaterHistory)
+	for _, rating := range established {
+		assert.Equal(t, "rater-regular", rating.RaterID)
+	}
+}
@@ -0,0 +1,318 @@
+package dispute
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Ce poate contesta un cititor
+const (
+	TargetTruthScore = "truth_score"
+	TargetBiasRating = "bias_rating"
+	TargetCausalLink = "causal_link" // Legătura "cauzat de" dintre evenimentul articolului și alt eveniment
+)
+
+// [RO] Starea unei Contestații
+const (
+	StatusOpen     = "open"
+	StatusResolved = "resolved"
+)
+
+// [RO] Rezultatul Reanalizei
+const (
+	OutcomeCorrected = "corrected" // Analiza s-a schimbat
+	OutcomeUpheld    = "upheld"    // Analiza inițială rămâne
+)
+
+// [RO] Limitele Contestațiilor
+const (
+	MinReasonRunes  = 20
+	MaxReasonRunes  = 2000
+	MaxEvidenceURLs = 5
+
+	// [RO] Câte contestații cu dovezi distincte declanșează reanaliza
+	DefaultReanalysisThreshold = 3
+
+	// [RO] De la această diferență de scor în sus, reanaliza e o corectură
+	ScoreCorrectionDelta = 0.05
+
+	// [RO] Câte contestații poate trimite același client pe un articol într-o fereastră
+	MaxDisputesPerSubmitter = 3
+	SubmitterRateWindow     = 24 * time.Hour
+)
+
+// [RO] Articolul contestat nu există (sau nu a fost încă publicat)
+var ErrDisputedArticleNotFound = errors.New("[RO] Articolul contestat nu există.")
+
+// [RO] Clientul a trimis deja prea multe contestații pe acest articol
+var ErrDisputeRateLimited = errors.New("[RO] Ați trimis deja prea multe contestații pentru acest articol. Reveniți mai târziu.")
+
+// [RO] Evenimentul-cauză contestat nu este legat de evenimentul articolului în graf
+var ErrUnknownCausalLink = errors.New("[RO] Legătura cauzală contestată nu există pentru acest articol.")
+
+// [RO] Contestația unui Cititor
+type Dispute struct {
+	ID           uuid.UUID  `json:"id"`
+	ArticleID    uuid.UUID  `json:"article_id"`
+	Target       string     `json:"target"`
+	CauseEventID string     `json:"cause_event_id,omitempty"` // Doar pentru TargetCausalLink
+	Reason       string     `json:"reason"`
+	EvidenceURLs []string   `json:"evidence_urls"`
+	Status       string     `json:"status"`
+	SubmitterID  string     `json:"-"` // Amprenta clientului, derivată de server (vezi SubmitterFingerprint)
+	ResolutionID *uuid.UUID `json:"resolution_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// [RO] Validarea Contestației
+func (dispute Dispute) Validate() error {
+	switch dispute.Target {
+	case TargetTruthScore, TargetBiasRating:
+		if dispute.CauseEventID != "" {
+			return fmt.Errorf("[RO] Eroare: cause_event_id se trimite doar pentru target=causal_link.")
+		}
+	case TargetCausalLink:
+		if strings.TrimSpace(dispute.CauseEventID) == "" {
+			return fmt.Errorf("[RO] Eroare: pentru causal_link avem nevoie de cause_event_id.")
+		}
+	default:
+		return fmt.Errorf("[RO] Eroare: target necunoscut %q (truth_score, bias_rating sau causal_link).", dispute.Target)
+	}
+
+	length := utf8.RuneCountInString(strings.TrimSpace(dispute.Reason))
+	if length < MinReasonRunes || length > MaxReasonRunes {
+		return fmt.Errorf("[RO] Eroare: motivul trebuie să aibă între %d și %d de caractere.", MinReasonRunes, MaxReasonRunes)
+	}
+
+	if len(dispute.EvidenceURLs) == 0 || len(dispute.EvidenceURLs) > MaxEvidenceURLs {
+		return fmt.Errorf("[RO] Eroare: trimiteți între 1 și %d dovezi (evidence_urls).", MaxEvidenceURLs)
+	}
+	for _, evidence := range dispute.EvidenceURLs {
+		parsed, err := url.ParseRequestURI(evidence)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("[RO] Eroare: dovada %q nu este un URL http(s) valid.", evidence)
+		}
+	}
+	return nil
+}
+
+// [RO] Amprenta dovezilor: aceleași URL-uri (în orice ordine) = aceeași dovadă
+func (dispute Dispute) evidenceKey() string {
+	urls := make([]string, 0, len(dispute.EvidenceURLs))
+	for _, evidence := range dispute.EvidenceURLs {
+		urls = append(urls, strings.TrimSuffix(strings.ToLower(strings.TrimSpace(evidence)), "/"))
+	}
+	sort.Strings(urls)
+	return strings.Join(urls, " ")
+}
+
+// [RO] Amprenta Pseudonimă a Clientului
+// Adresa IP nu se salvează în clar; amprenta doar deosebește cititorii între ei.
+func SubmitterFingerprint(clientIP string) string {
+	digest := sha256.Sum256([]byte("truthweave-dispute:" + strings.TrimSpace(clientIP)))
+	return hex.EncodeToString(digest[:16])
+}
+
+// [RO] Cine a trimis contestația (contestațiile vechi, fără amprentă, contează fiecare separat)
+func (dispute Dispute) submitterKey() string {
+	if dispute.SubmitterID == "" {
+		return "dispute:" + dispute.ID.String()
+	}
+	return dispute.SubmitterID
+}
+
+// [RO] Situația Contestațiilor Deschise ale unui Articol
+type DisputeTally struct {
+	ArticleID          uuid.UUID      `json:"article_id"`
+	Open               int            `json:"open"`
+	DistinctEvidence   int            `json:"distinct_evidence"`
+	DistinctSubmitters int            `json:"distinct_submitters"`
+	ByTarget           map[string]int `json:"by_target"`
+	Threshold          int            `json:"threshold"`
+	ReanalysisDue      bool           `json:"reanalysis_due"`
+}
+
+// [RO] Agregă Contestațiile Deschise
+// Pragul trebuie atins atât de seturile de dovezi distincte, cât și de cititorii distincți:
+// aceeași dovadă trimisă de zece ori nu aduce informație nouă, iar un singur client cu dovezi
+// diferite nu forțează singur o reanaliză.
+func TallyOpenDisputes(articleID uuid.UUID, disputes []Dispute, threshold int) DisputeTally {
+	if threshold <= 0 {
+		threshold = DefaultReanalysisThreshold
+	}
+	tally := DisputeTally{ArticleID: articleID, ByTarget: map[string]int{}, Threshold: threshold}
+	evidence := map[string]bool{}
+	submitters := map[string]bool{}
+	for _, dispute := range disputes {
+		if dispute.Status != StatusOpen {
+			continue
+		}
+		tally.Open++
+		tally.ByTarget[dispute.Target]++
+		evidence[dispute.evidenceKey()] = true
+		submitters[dispute.submitterKey()] = true
+	}
+	tally.DistinctEvidence = len(evidence)
+	tally.DistinctSubmitters = len(submitters)
+	tally.ReanalysisDue = tally.DistinctEvidence >= threshold && tally.DistinctSubmitters >= threshold
+	return tally
+}
+
+// [RO] Dosarul Reanalizei: articolul, analiza actuală și contestațiile deschise
+type DisputeCase struct {
+	ArticleID      uuid.UUID
+	Title          string
+	RawContent     string
+	TruthScore     float64
+	BiasRating     string
+	StoryEventID   string            // Evenimentul articolului din graf (copilul legăturilor cauzale)
+	CauseSummaries map[string]string // cause_event_id -> rezumatul evenimentului contestat
+	MissingCauses  []string          // Evenimente-cauză care nu mai sunt în graf (legătura nu mai există)
+	Disputes       []Dispute
+}
+
+// [RO] Contestațiile de trimis modelului
+// Legăturile spre evenimente dispărute din graf nu mai au ce reevalua; ele se închid
+// odată cu nota, fără să ajungă în prompt.
+func (disputeCase DisputeCase) ReviewableDisputes() []Dispute {
+	missing := map[string]bool{}
+	for _, cause := range disputeCase.MissingCauses {
+		missing[cause] = true
+	}
+	var reviewable []Dispute
+	for _, item := range disputeCase.Disputes {
+		if item.Target == TargetCausalLink && missing[item.CauseEventID] {
+			continue
+		}
+		reviewable = append(reviewable, item)
+	}
+	return reviewable
+}
+
+// [RO] Verdictul Modelului la Reanaliză
+type ReanalysisVerdict struct {
+	TruthScore         float64  `json:"truth_score"`
+	BiasRating         string   `json:"bias_rating"`
+	RetractedCauses    []string `json:"retracted_cause_event_ids"`
+	Note               string   `json:"resolution_note"`
+	InjectionSuspected bool     `json:"injection_suspected"`
+}
+
+// [RO] Nota de Rezolvare (publicată pe articol)
+type ResolutionNote struct {
+	ID                 uuid.UUID   `json:"id"`
+	ArticleID          uuid.UUID   `json:"article_id"`
+	DisputeIDs         []uuid.UUID `json:"dispute_ids"`
+	Targets            []string    `json:"targets"`
+	Outcome            string      `json:"outcome"`
+	PreviousTruthScore float64     `json:"previous_truth_score"`
+	TruthScore         float64     `json:"truth_score"`
+	PreviousBiasRating string      `json:"previous_bias_rating"`
+	BiasRating         string      `json:"bias_rating"`
+	RetractedCauses    []string    `json:"retracted_cause_event_ids,omitempty"`
+	StoryEventID       string      `json:"story_event_id,omitempty"`
+	Note               string      `json:"note"`
+	CreatedAt          time.Time   `json:"created_at"`
+}
+
+// [RO] Rezolvă Contestațiile
+//
+// Se schimbă doar ce a fost contestat: scorul (dacă truth_score a fost contestat), ratingul
+// (bias_rating) și legăturile cauzale contestate pe care modelul le retrage. Dacă modelul a
+// semnalat instrucțiuni în contestații, analiza inițială rămâne neatinsă.
+func ResolveDisputes(disputeCase DisputeCase, verdict ReanalysisVerdict, now time.Time) ResolutionNote {
+	note := ResolutionNote{
+		ID:                 uuid.New(),
+		ArticleID:          disputeCase.ArticleID,
+		Outcome:            OutcomeUpheld,
+		PreviousTruthScore: disputeCase.TruthScore,
+		TruthScore:         disputeCase.TruthScore,
+		PreviousBiasRating: disputeCase.BiasRating,
+		BiasRating:         disputeCase.BiasRating,
+		StoryEventID:       disputeCase.StoryEventID,
+		Note:               strings.TrimSpace(verdict.Note),
+		CreatedAt:          now,
+	}
+
+	disputedTargets := map[string]bool{}
+	disputedCauses := map[string]bool{}
+	for _, dispute := range disputeCase.Disputes {
+		note.DisputeIDs = append(note.DisputeIDs, dispute.ID)
+		if !disputedTargets[dispute.Target] {
+			disputedTargets[dispute.Target] = true
+			note.Targets = append(note.Targets, dispute.Target)
+		}
+		if dispute.Target == TargetCausalLink {
+			disputedCauses[dispute.CauseEventID] = true
+		}
+	}
+	sort.Strings(note.Targets)
+
+	if verdict.InjectionSuspected {
+		note.Note = "Contestațiile conțineau instrucțiuni adresate modelului; analiza inițială a fost păstrată."
+		return note
+	}
+
+	if disputedTargets[TargetTruthScore] {
+		score := math.Min(1, math.Max(0, verdict.TruthScore))
+		if math.Abs(score-disputeCase.TruthScore) >= ScoreCorrectionDelta {
+			note.TruthScore = score
+			note.Outcome = OutcomeCorrected
+		}
+	}
+	if disputedTargets[TargetBiasRating] {
+		bias := strings.TrimSpace(verdict.BiasRating)
+		if bias != "" && !strings.EqualFold(bias, disputeCase.BiasRating) {
+			note.BiasRating = bias
+			note.Outcome = OutcomeCorrected
+		}
+	}
+	for _, cause := range verdict.RetractedCauses {
+		if disputedCauses[cause] {
+			note.RetractedCauses = append(note.RetractedCauses, cause)
+			note.Outcome = OutcomeCorrected
+		}
+	}
+
+	if note.Note == "" {
+		note.Note = "Analiza a fost reevaluată pe baza dovezilor trimise de cititori."
+	}
+	return note
+}
+
+// [RO] Interfața de Persistență a Contestațiilor
+type ReaderDisputePersistenceInterface interface {
+	SaveDispute(ctx context.Context, dispute Dispute) error
+
+	// [RO] Grupul poveștii articolului (uuid.Nil dacă nu are); ErrDisputedArticleNotFound dacă lipsește
+	RetrieveArticleStoryCluster(ctx context.Context, articleID uuid.UUID) (uuid.UUID, error)
+
+	// [RO] Câte contestații a trimis clientul pe articol de la `since` încoace (oricare stare)
+	CountSubmitterDisputes(ctx context.Context, articleID uuid.UUID, submitterID string, since time.Time) (int, error)
+
+	// [RO] Contestațiile deschise ale articolului, cele mai vechi întâi
+	ListOpenDisputes(ctx context.Context, articleID uuid.UUID) ([]Dispute, error)
+
+	// [RO] Notele publicate pe articol, cele mai noi întâi
+	RetrieveResolutionNotes(ctx context.Context, articleID uuid.UUID) ([]ResolutionNote, error)
+
+	// [RO] Într-o tranzacție: nota, corecturile articolului și închiderea contestațiilor rezolvate
+	ApplyDisputeResolution(ctx context.Context, note ResolutionNote) error
+}
+
+// [RO] Verificarea Legăturilor Cauzale (graful de cunoștințe)
+type CausalLinkVerifierInterface interface {
+	// [RO] true dacă evenimentul copil are muchia cauzală spre părinte
+	HasCausalEdge(ctx context.Context, parentID string, childID string) (bool, error)
+}
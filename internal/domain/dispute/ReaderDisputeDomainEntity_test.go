@@ -0,0 +1,119 @@
+package dispute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleReason = "The ministry report cited in the article says the opposite."
+
+func TestDispute_Validate(t *testing.T) {
+	valid := Dispute{Target: TargetTruthScore, Reason: sampleReason, EvidenceURLs: []string{"https://gov.example/report.pdf"}}
+	assert.NoError(t, valid.Validate())
+
+	causal := valid
+	causal.Target = TargetCausalLink
+	assert.Error(t, causal.Validate(), "[RO] causal_link fără cause_event_id")
+	causal.CauseEventID = "evt-1"
+	assert.NoError(t, causal.Validate())
+
+	invalid := []Dispute{
+		{Target: "headline", Reason: sampleReason, EvidenceURLs: valid.EvidenceURLs},
+		{Target: TargetBiasRating, Reason: "Wrong.", EvidenceURLs: valid.EvidenceURLs},
+		{Target: TargetBiasRating, Reason: sampleReason},
+		{Target: TargetBiasRating, Reason: sampleReason, EvidenceURLs: []string{"javascript:alert(1)"}},
+		{Target: TargetTruthScore, CauseEventID: "evt-1", Reason: sampleReason, EvidenceURLs: valid.EvidenceURLs},
+	}
+	for _, dispute := range invalid {
+		assert.Error(t, dispute.Validate(), dispute.Target)
+	}
+}
+
+func TestTallyOpenDisputes_CountsDistinctEvidence(t *testing.T) {
+	articleID := uuid.New()
+	same := []string{"https://a.example/x", "https://b.example/y"}
+	disputes := []Dispute{
+		{Target: TargetTruthScore, Status: StatusOpen, SubmitterID: "reader-a", EvidenceURLs: same},
+		{Target: TargetTruthScore, Status: StatusOpen, SubmitterID: "reader-b", EvidenceURLs: []string{"https://B.example/y/", "https://a.example/x"}},
+		{Target: TargetBiasRating, Status: StatusOpen, SubmitterID: "reader-c", EvidenceURLs: []string{"https://c.example/z"}},
+		{Target: TargetBiasRating, Status: StatusResolved, SubmitterID: "reader-d", EvidenceURLs: []string{"https://d.example/w"}},
+	}
+
+	tally := TallyOpenDisputes(articleID, disputes, 3)
+	assert.Equal(t, 3, tally.Open)
+	assert.Equal(t, 2, tally.DistinctEvidence, "[RO] Aceleași dovezi, altă ordine = aceeași dovadă")
+	assert.Equal(t, map[string]int{TargetTruthScore: 2, TargetBiasRating: 1}, tally.ByTarget)
+	assert.False(t, tally.ReanalysisDue)
+
+	assert.True(t, TallyOpenDisputes(articleID, disputes, 2).ReanalysisDue)
+}
+
+func TestTallyOpenDisputes_OneReaderCannotForceReanalysis(t *testing.T) {
+	articleID := uuid.New()
+	var disputes []Dispute
+	for _, evidence := range []string{"https://a.example", "https://b.example", "https://c.example"} {
+		disputes = append(disputes, Dispute{ID: uuid.New(), Target: TargetTruthScore, Status: StatusOpen, SubmitterID: "reader-a", EvidenceURLs: []string{evidence}})
+	}
+
+	tally := TallyOpenDisputes(articleID, disputes, 3)
+	assert.Equal(t, 3, tally.DistinctEvidence)
+	assert.Equal(t, 1, tally.DistinctSubmitters)
+	assert.False(t, tally.ReanalysisDue)
+
+	assert.Equal(t, SubmitterFingerprint("203.0.113.7"), SubmitterFingerprint(" 203.0.113.7 "))
+	assert.NotEqual(t, SubmitterFingerprint("203.0.113.7"), SubmitterFingerprint("203.0.113.8"))
+	assert.NotContains(t, SubmitterFingerprint("203.0.113.7"), "203.0.113.7")
+}
+
+func TestResolveDisputes_ChangesOnlyDisputedTargets(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	disputeCase := DisputeCase{
+		ArticleID:  uuid.New(),
+		TruthScore: 0.8,
+		BiasRating: "Neutral",
+		Disputes: []Dispute{
+			{ID: uuid.New(), Target: TargetTruthScore},
+			{ID: uuid.New(), Target: TargetCausalLink, CauseEventID: "evt-disputed"},
+		},
+	}
+	verdict := ReanalysisVerdict{TruthScore: 0.35, BiasRating: "Pro-Gov", RetractedCauses: []string{"evt-disputed", "evt-other"}, Note: "The cited report contradicts the claim."}
+
+	note := ResolveDisputes(disputeCase, verdict, now)
+
+	assert.Equal(t, OutcomeCorrected, note.Outcome)
+	assert.Equal(t, 0.35, note.TruthScore)
+	assert.Equal(t, 0.8, note.PreviousTruthScore)
+	assert.Equal(t, "Neutral", note.BiasRating, "[RO] Ratingul nu a fost contestat")
+	assert.Equal(t, []string{"evt-disputed"}, note.RetractedCauses, "[RO] Doar legăturile contestate pot fi retrase")
+	assert.Equal(t, []string{TargetCausalLink, TargetTruthScore}, note.Targets)
+	require.Len(t, note.DisputeIDs, 2)
+	assert.Equal(t, now, note.CreatedAt)
+
+	// [RO] O diferență mică nu e o corectură
+	upheld := ResolveDisputes(disputeCase, ReanalysisVerdict{TruthScore: 0.78}, now)
+	assert.Equal(t, OutcomeUpheld, upheld.Outcome)
+	assert.Equal(t, 0.8, upheld.TruthScore)
+	assert.NotEmpty(t, upheld.Note)
+
+	// [RO] Contestații care încearcă să dicteze verdictul
+	injected := ResolveDisputes(disputeCase, ReanalysisVerdict{TruthScore: 0, InjectionSuspected: true}, now)
+	assert.Equal(t, OutcomeUpheld, injected.Outcome)
+	assert.Equal(t, 0.8, injected.TruthScore)
+}
+
+func TestDisputeCase_ReviewableDisputesSkipsMissingCauses(t *testing.T) {
+	score := Dispute{ID: uuid.New(), Target: TargetTruthScore}
+	gone := Dispute{ID: uuid.New(), Target: TargetCausalLink, CauseEventID: "merged-event"}
+	kept := Dispute{ID: uuid.New(), Target: TargetCausalLink, CauseEventID: "live-event"}
+	disputeCase := DisputeCase{Disputes: []Dispute{score, gone, kept}, MissingCauses: []string{"merged-event"}}
+
+	assert.Equal(t, []Dispute{score, kept}, disputeCase.ReviewableDisputes())
+
+	// [RO] Contestația spre evenimentul dispărut se închide totuși odată cu nota
+	note := ResolveDisputes(disputeCase, ReanalysisVerdict{RetractedCauses: []string{"merged-event"}}, time.Now())
+	assert.Len(t, note.DisputeIDs, 3)
+}
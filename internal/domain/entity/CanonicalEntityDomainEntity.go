@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Entitatea cerută nu există în registru
+var ErrEntityNotFound = errors.New("[RO] Entitatea nu a fost găsită.")
+
+// [RO] Entitate Canonică (Persoană / Organizație / Loc)
+//
+// "Joe Biden", "Biden" și "President Biden" sunt trei moduri de a numi același om.
+// Entitatea canonică este identitatea unică în spatele tuturor acestor nume (alias-uri),
+// iar ID-ul ei este cel folosit în Graful de Cunoștințe (`entity.id`).
+type CanonicalEntity struct {
+	// [RO] Identificator Canonic
+	ID uuid.UUID `json:"id"`
+
+	// [RO] Numele Preferat (ex: "Joe Biden")
+	Name string `json:"name"`
+
+	// [RO] Tipul Normalizat: Person, Organization, Place
+	Type string `json:"type"`
+
+	// [RO] Toate formele sub care a fost întâlnită entitatea
+	Aliases []string `json:"aliases,omitempty"`
+
+	// [RO] Amprenta Semantică a numelui (pentru potrivire prin similaritate)
+	Embedding []float32 `json:"-"`
+
+	// [RO] Legătura cu Wikidata (goală dacă nu a fost găsită)
+	WikidataQID string `json:"wikidata_qid,omitempty"`
+
+	// [RO] Dacă entitatea a fost unificată cu alta, aici este ținta.
+	MergedInto uuid.UUID `json:"merged_into,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// [RO] Candidat la Rezolvare
+// O entitate existentă care ar putea fi aceeași cu numele căutat.
+type EntityCandidate struct {
+	EntityID   uuid.UUID `json:"entity_id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Similarity float64   `json:"similarity"` // 0.0 - 1.0
+}
+
+// [RO] Acțiuni Administrative asupra Entităților
+const (
+	AuditActionMerge = "merge"
+	AuditActionSplit = "split"
+)
+
+// [RO] Înregistrare de Audit (Merge / Split)
+// Fiecare unificare sau separare lasă o urmă permanentă: cine, când, de ce și ce alias-uri s-au mutat.
+type EntityMergeAuditRecord struct {
+	ID             uuid.UUID `json:"id"`
+	Action         string    `json:"action"`
+	SourceEntityID uuid.UUID `json:"source_entity_id"`
+	TargetEntityID uuid.UUID `json:"target_entity_id"`
+	MovedAliases   []string  `json:"moved_aliases"`
+	Actor          string    `json:"actor"`
+	Reason         string    `json:"reason"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// [RO] Rezultatul unei Separări (Split)
+// Noua entitate și articolele ale căror mențiuni au fost mutate pe ea.
+type EntitySplitResult struct {
+	NewEntity        *CanonicalEntity `json:"new_entity"`
+	MovedArticleURLs []string         `json:"moved_article_urls"`
+}
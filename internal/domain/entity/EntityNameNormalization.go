@@ -0,0 +1,188 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// [RO] Lungimea minimă a unei secvențe de litere/cifre pe care o poate folosi indexul trigram
+const minSuggestionRun = 3
+
+// [RO] Tipuri Canonice
+const (
+	TypePerson       = "Person"
+	TypeOrganization = "Organization"
+	TypePlace        = "Place"
+)
+
+// [RO] Sinonime pentru tipuri (AI-ul nu e mereu consecvent)
+var typeSynonyms = map[string]string{
+	"person":       TypePerson,
+	"per":          TypePerson,
+	"people":       TypePerson,
+	"org":          TypeOrganization,
+	"organization": TypeOrganization,
+	"organisation": TypeOrganization,
+	"company":      TypeOrganization,
+	"location":     TypePlace,
+	"loc":          TypePlace,
+	"place":        TypePlace,
+	"gpe":          TypePlace,
+	"country":      TypePlace,
+	"city":         TypePlace,
+}
+
+// [RO] Titluri Onorifice
+// Eliminate din numele persoanelor: "President Biden" -> "biden".
+var personTitles = map[string]bool{
+	"president": true, "vice": true, "prime": true, "minister": true, "chancellor": true,
+	"senator": true, "sen": true, "rep": true, "governor": true, "gov": true, "mayor": true,
+	"mr": true, "mrs": true, "ms": true, "dr": true, "prof": true, "sir": true, "dame": true,
+	"general": true, "gen": true, "king": true, "queen": true, "prince": true, "princess": true,
+	"pope": true, "ceo": true, "secretary": true,
+}
+
+// [RO] Normalizare Tip
+// Tipurile necunoscute sunt păstrate așa cum au venit (cu majusculă inițială).
+func NormalizeType(rawType string) string {
+	key := strings.ToLower(strings.TrimSpace(rawType))
+	if canonical, ok := typeSynonyms[key]; ok {
+		return canonical
+	}
+	if key == "" {
+		return ""
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+// [RO] Normalizare Nume
+// Litere mici, fără punctuație, spații comprimate; pentru persoane eliminăm și titlurile.
+// Rezultatul este cheia folosită în tabela de alias-uri.
+func NormalizeName(name string, entityType string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case r == '\'' || r == '’':
+			return -1
+		default:
+			return ' '
+		}
+	}, name)
+
+	tokens := strings.Fields(cleaned)
+	if NormalizeType(entityType) == TypePerson {
+		kept := tokens[:0]
+		for _, token := range tokens {
+			if !personTitles[token] {
+				kept = append(kept, token)
+			}
+		}
+		// Dacă numele era doar un titlu ("The President"), păstrăm originalul.
+		if len(kept) > 0 {
+			tokens = kept
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// [RO] Normalizare pentru Autocompletare
+// Ca NormalizeName, dar păstrează cratima și apostroful ("O'Brien", "Jean-Luc"), pentru că
+// prefixul se compară cu numele salvat în graf, nu cu cheia din tabela de alias-uri.
+// Apostroful tipografic (’) devine '.
+func NormalizeSuggestionQuery(query string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case r == '\'' || r == '’':
+			return '\''
+		case r == '-':
+			return '-'
+		default:
+			return ' '
+		}
+	}, query)
+	return strings.Join(strings.Fields(cleaned), " ")
+}
+
+// [RO] Expresia de Autocompletare
+// Începutul oricărui cuvânt din numele salvat (după spațiu sau cratimă). Apostroful acceptă
+// ambele forme, iar spațiul și cratima din prefix se potrivesc între ele ("jean luc" ->
+// "Jean-Luc"). false dacă nicio secvență de litere/cifre nu are 3 caractere: indexul trigram
+// nu ar putea restrânge căutarea.
+func SuggestionPattern(query string) (string, bool) {
+	normalized := NormalizeSuggestionQuery(query)
+
+	var pattern strings.Builder
+	pattern.WriteString("(^|[ -])")
+	longestRun, run := 0, 0
+	for _, r := range normalized {
+		switch r {
+		case '\'':
+			pattern.WriteString("['’]")
+			run = 0
+		case ' ', '-':
+			pattern.WriteString("[ -]")
+			run = 0
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+			run++
+		}
+		if run > longestRun {
+			longestRun = run
+		}
+	}
+	return pattern.String(), longestRun >= minSuggestionRun
+}
+
+// [RO] Similaritate Trigram (Jaccard)
+// Aceeași idee ca indexul trigram din Dgraph: cât de multe bucăți de 3 litere au în comun două nume.
+func TrigramSimilarity(a, b string) float64 {
+	if a == b {
+		return 1.0
+	}
+	gramsA, gramsB := trigrams(a), trigrams(b)
+	if len(gramsA) == 0 || len(gramsB) == 0 {
+		return 0.0
+	}
+
+	shared := 0
+	for gram := range gramsA {
+		if gramsB[gram] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(gramsA)+len(gramsB)-shared)
+}
+
+// [RO] Nume Parțial (Persoane)
+// Adevărat dacă toate cuvintele din `short` apar în `full` ("biden" ⊂ "joe biden").
+func IsTokenSubset(short, full string) bool {
+	fullTokens := make(map[string]bool)
+	for _, token := range strings.Fields(full) {
+		fullTokens[token] = true
+	}
+	shortTokens := strings.Fields(short)
+	if len(shortTokens) == 0 || len(shortTokens) >= len(fullTokens) {
+		return false
+	}
+	for _, token := range shortTokens {
+		if !fullTokens[token] {
+			return false
+		}
+	}
+	return true
+}
+
+func trigrams(s string) map[string]bool {
+	grams := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			grams[string(padded[i:i+3])] = true
+		}
+	}
+	return grams
+}
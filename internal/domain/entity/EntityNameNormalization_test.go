@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// [RO] Teste Unitare pentru Normalizarea Numelor
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		entityType string
+		want       string
+	}{
+		{"[RO] Titlu eliminat la persoane", "President Biden", "Person", "biden"},
+		{"[RO] Punctuație și majuscule", "Dr. Anthony  Fauci", "PER", "anthony fauci"},
+		{"[RO] Titlul rămâne la organizații", "President's Office", "Org", "presidents office"},
+		{"[RO] Doar titlu -> păstrăm originalul", "President", "Person", "president"},
+		{"[RO] Diacritice păstrate", "Nicușor Dan", "Person", "nicușor dan"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeName(tt.raw, tt.entityType))
+		})
+	}
+}
+
+func TestNormalizeType(t *testing.T) {
+	assert.Equal(t, TypePerson, NormalizeType("person"))
+	assert.Equal(t, TypeOrganization, NormalizeType("Org"))
+	assert.Equal(t, TypePlace, NormalizeType("Location"))
+	assert.Equal(t, "Event", NormalizeType("event"))
+	assert.Equal(t, "", NormalizeType("  "))
+}
+
+func TestTrigramSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, TrigramSimilarity("joe biden", "joe biden"))
+	assert.Greater(t, TrigramSimilarity("volodymyr zelensky", "volodymyr zelenskyy"), 0.6)
+	assert.Less(t, TrigramSimilarity("joe biden", "emmanuel macron"), 0.1)
+	assert.Equal(t, 0.0, TrigramSimilarity("", "joe biden"))
+}
+
+func TestIsTokenSubset(t *testing.T) {
+	assert.True(t, IsTokenSubset("biden", "joe biden"))
+	assert.False(t, IsTokenSubset("joe biden", "joe biden"), "[RO] Numele identic nu este parțial")
+	assert.False(t, IsTokenSubset("trump", "joe biden"))
+}
+
+func TestNormalizeSuggestionQuery(t *testing.T) {
+	assert.Equal(t, "o'brien", NormalizeSuggestionQuery("O’Brien"))
+	assert.Equal(t, "jean-luc picard", NormalizeSuggestionQuery("  Jean-Luc,  Picard "))
+}
+
+func TestSuggestionPattern(t *testing.T) {
+	pattern, searchable := SuggestionPattern("Jean lu")
+	assert.True(t, searchable)
+	assert.Equal(t, `(^|[ -])jean[ -]lu`, pattern)
+
+	pattern, searchable = SuggestionPattern("O'Bri")
+	assert.True(t, searchable)
+	assert.Equal(t, `(^|[ -])o['’]bri`, pattern)
+
+	_, searchable = SuggestionPattern("o'b")
+	assert.False(t, searchable)
+}
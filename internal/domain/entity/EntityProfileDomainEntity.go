@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Profilul unei Entități (Pagina de Entitate)
+//
+// Tot ce știm despre o persoană, organizație sau loc: identitatea (cu legătura Wikidata),
+// articolele în care apare de-a lungul timpului, cum a evoluat tonul acoperirii
+// și cu cine apare cel mai des în aceleași știri.
+type EntityProfile struct {
+	Entity        CanonicalEntity        `json:"entity"`
+	KnowledgeBase *KnowledgeBaseEntry    `json:"knowledge_base,omitempty"`
+	Articles      []EntityArticleMention `json:"articles"`
+	Trend         []EntityCoverageBucket `json:"trend"`
+	CoOccurring   []CoOccurringEntity    `json:"co_occurring"`
+}
+
+// [RO] Articol care menționează entitatea
+type EntityArticleMention struct {
+	ArticleID     uuid.UUID `json:"article_id"`
+	Title         string    `json:"title"`
+	URL           string    `json:"url"`
+	TruthScore    float64   `json:"truth_score"`
+	GlobalEmotion string    `json:"global_emotion,omitempty"`
+	PublishedAt   time.Time `json:"published_at"`
+}
+
+// [RO] O zi din evoluția acoperirii (număr de articole, adevăr mediu, emoția dominantă)
+type EntityCoverageBucket struct {
+	Day               time.Time `json:"day"`
+	ArticleCount      int       `json:"article_count"`
+	AverageTruthScore float64   `json:"avg_truth_score"`
+	DominantEmotion   string    `json:"dominant_emotion,omitempty"`
+}
+
+// [RO] Entitate care apare în aceleași articole
+type CoOccurringEntity struct {
+	EntityID       string `json:"entity_id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	SharedArticles int    `json:"shared_articles"`
+}
+
+// [RO] Sugestie de Autocompletare (SearchScreen)
+type EntitySuggestion struct {
+	EntityID     string `json:"entity_id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	ArticleCount int    `json:"article_count"`
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Interfața Registrului de Entități
+//
+// Registrul ține entitățile canonice, tabela de alias-uri, mențiunile din articole
+// și jurnalul de audit al unificărilor/separărilor.
+type EntityRegistryPersistenceInterface interface {
+	// [RO] Caută după Alias (potrivire exactă pe numele normalizat, același tip)
+	FindEntityByAlias(execution_context context.Context, normalizedAlias string, entityType string) (*CanonicalEntity, error)
+
+	// [RO] Caută după Amprentă Semantică (același tip, similaritate >= minSimilarity)
+	FindEntitiesByEmbedding(execution_context context.Context, embedding []float32, entityType string, minSimilarity float64, limit int) ([]EntityCandidate, error)
+
+	// [RO] Citește Entitatea (cu alias-uri)
+	RetrieveEntityByID(execution_context context.Context, id uuid.UUID) (*CanonicalEntity, error)
+
+	// [RO] Creează Entitate Canonică (numele devine primul alias)
+	// Dacă numele normalizat are deja proprietar, nu creează nimic și pune ID-ul acestuia în canonical.ID.
+	CreateEntity(execution_context context.Context, canonical *CanonicalEntity) error
+
+	// [RO] Adaugă Alias
+	AddAlias(execution_context context.Context, entityID uuid.UUID, alias string, normalizedAlias string, entityType string) error
+
+	// [RO] Înregistrează Mențiunile unui Articol (forma exactă din text -> entitate)
+	RecordArticleMentions(execution_context context.Context, articleID uuid.UUID, mentions map[string]uuid.UUID) error
+
+	// [RO] Unificare: toate alias-urile și mențiunile sursei trec la țintă; se scrie auditul.
+	MergeEntities(execution_context context.Context, audit *EntityMergeAuditRecord) error
+
+	// [RO] Separare: alias-urile date pleacă într-o entitate nouă, împreună cu mențiunile lor.
+	SplitEntity(execution_context context.Context, newEntity *CanonicalEntity, audit *EntityMergeAuditRecord) (*EntitySplitResult, error)
+
+	// [RO] Jurnalul de Audit al unei entități (cele mai noi primele)
+	RetrieveMergeAudit(execution_context context.Context, entityID uuid.UUID) ([]EntityMergeAuditRecord, error)
+
+	// [RO] Articolele care menționează entitatea, după `since` (cele mai noi primele)
+	RetrieveEntityArticles(execution_context context.Context, entityID uuid.UUID, since time.Time, limit int) ([]EntityArticleMention, error)
+
+	// [RO] Evoluția zilnică a acoperirii (adevăr mediu, emoție dominantă), după `since`
+	RetrieveEntityCoverageTrend(execution_context context.Context, entityID uuid.UUID, since time.Time) ([]EntityCoverageBucket, error)
+}
+
+// [RO] Interfața Grafului pentru Entități
+//
+// Partea din Graful de Cunoștințe de care are nevoie rezolvarea entităților:
+// căutarea fuzzy (index trigram) și rescrierea muchiilor la merge/split.
+type EntityGraphPersistenceInterface interface {
+	// [RO] Candidați cu nume asemănător (index trigram), același tip
+	FindEntityCandidatesByTrigram(execution_context context.Context, name string, entityType string, limit int) ([]EntityCandidate, error)
+
+	// [RO] Mută muchiile articolelor de pe sursă pe țintă
+	MergeEntityNodes(execution_context context.Context, sourceEntityID string, targetEntityID string) error
+
+	// [RO] Mută muchiile articolelor date pe entitatea nouă (după split)
+	RelinkEntityMentions(execution_context context.Context, fromEntityID string, toEntity CanonicalEntity, articleURLs []string) error
+
+	// [RO] Entitățile care apar în aceleași articole (mentioned_entities / appears_in)
+	RetrieveCoOccurringEntities(execution_context context.Context, entityID string, limit int) ([]CoOccurringEntity, error)
+
+	// [RO] Autocompletare după prefix (index trigram pe `name`)
+	SuggestEntitiesByName(execution_context context.Context, prefix string, limit int) ([]EntitySuggestion, error)
+}
+
+// [RO] Interfața Bazei de Cunoștințe (Wikidata offline)
+type KnowledgeBasePersistenceInterface interface {
+	// [RO] Import în loturi (upsert după QID, alias-urile sunt rescrise)
+	UpsertKnowledgeBaseEntries(execution_context context.Context, entries []KnowledgeBaseEntry) error
+
+	// [RO] Candidați după alias normalizat, același tip
+	FindKnowledgeBaseEntriesByAlias(execution_context context.Context, normalizedAlias string, entityType string, limit int) ([]KnowledgeBaseEntry, error)
+
+	// [RO] Intrarea legată de o entitate canonică (nil dacă nu e legată)
+	RetrieveLinkedKnowledgeBaseEntry(execution_context context.Context, entityID uuid.UUID) (*KnowledgeBaseEntry, error)
+
+	// [RO] Leagă entitatea canonică de QID
+	LinkEntityToKnowledgeBase(execution_context context.Context, entityID uuid.UUID, qid string) error
+}
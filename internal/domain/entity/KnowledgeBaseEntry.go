@@ -0,0 +1,46 @@
+package entity
+
+// [RO] Intrare din Baza de Cunoștințe (Wikidata, import offline)
+//
+// Identitatea "oficială" a unei entități: QID-ul Wikidata (ex: "Q6279" = Joe Biden),
+// descrierea, alias-urile și, pentru locuri, coordonatele.
+type KnowledgeBaseEntry struct {
+	QID         string   `json:"qid"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"` // Person, Organization, Place
+	Aliases     []string `json:"aliases,omitempty"`
+
+	// [RO] Coordonate (doar pentru locuri, proprietatea P625)
+	HasCoordinates bool    `json:"has_coordinates"`
+	Latitude       float64 `json:"lat,omitempty"`
+	Longitude      float64 `json:"lng,omitempty"`
+
+	// [RO] Popularitate (numărul de pagini Wikipedia)
+	// Folosită la dezambiguizare: "Paris" -> Franța, nu Texas.
+	Sitelinks int `json:"sitelinks"`
+}
+
+// [RO] Alegerea Intrării din Baza de Cunoștințe
+// Acceptăm candidatul cel mai popular doar dacă este clar dominant (cel puțin dublu față de al doilea).
+// Altfel nu legăm nimic: o legătură greșită este mai rea decât lipsa ei.
+func PickKnowledgeBaseEntry(candidates []KnowledgeBaseEntry) *KnowledgeBaseEntry {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	best, second := -1, -1
+	for i := range candidates {
+		switch {
+		case best == -1 || candidates[i].Sitelinks > candidates[best].Sitelinks:
+			best, second = i, best
+		case second == -1 || candidates[i].Sitelinks > candidates[second].Sitelinks:
+			second = i
+		}
+	}
+
+	if second != -1 && candidates[best].Sitelinks < 2*candidates[second].Sitelinks {
+		return nil
+	}
+	return &candidates[best]
+}
@@ -0,0 +1,14 @@
+package geo
+
+// [RO] Interfața Gazetteer-ului (Geocodare Offline)
+//
+// Ambele operații sunt în memorie, fără apeluri de rețea, ca să poată rula în fiecare analiză.
+type GazetteerLookupInterface interface {
+	// [RO] Caută un Loc după Nume (nume oficial, alias sau cod ISO)
+	// La nume ambigue ("Georgia") țara are prioritate față de regiune.
+	LookupPlaceByName(name string) (*Place, bool)
+
+	// [RO] Geocodare Inversă: în ce țară (și regiune) se află punctul?
+	// Oricare rezultat poate fi nil (ex: punct în ocean sau regiune necunoscută).
+	ReverseGeocode(latitude float64, longitude float64) (country *Place, region *Place)
+}
@@ -0,0 +1,135 @@
+package geo
+
+import "math"
+
+// [RO] Niveluri Administrative
+const (
+	LevelCountry = "country" // Țară (ISO 3166-1 alpha-2, ex: "RO")
+	LevelRegion  = "region"  // Regiune de nivel 1 (ISO 3166-2, ex: "RO-CJ")
+)
+
+// [RO] Kilometri într-un grad de latitudine (aproximare suficientă pentru geocodare).
+const kmPerDegree = 111.32
+
+// [RO] Loc din Gazetteer (Țară sau Regiune)
+//
+// Gazetteer-ul este "atlasul offline" al sistemului: centroizi și contururi simplificate,
+// folosite când modelul nu știe unde s-a întâmplat știrea sau ca să aflăm țara unui punct.
+type Place struct {
+	// [RO] Codul ISO: "RO" pentru țări, "RO-CJ" pentru regiuni.
+	Code        string   `json:"code"`
+	CountryCode string   `json:"country"`
+	Name        string   `json:"name"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Level       string   `json:"level"`
+
+	// [RO] Centroidul (punctul afișat pe hartă când știm doar numele locului)
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+
+	// [RO] Raza aproximativă (km), folosită doar pentru locurile fără contur.
+	RadiusKm float64 `json:"radius_km,omitempty"`
+
+	// [RO] Contururi simplificate: fiecare poligon este un inel exterior de puncte [lng, lat].
+	// Țările cu insule au mai multe poligoane.
+	Polygons [][][2]float64 `json:"polygons,omitempty"`
+}
+
+// [RO] Are Contur?
+func (place Place) HasPolygons() bool {
+	return len(place.Polygons) > 0
+}
+
+// [RO] Punctul este în interiorul locului?
+// Cu contur: test clasic "ray casting". Fără contur: cercul din jurul centroidului.
+func (place Place) Contains(latitude float64, longitude float64) bool {
+	if !place.HasPolygons() {
+		return place.RadiusKm > 0 && DistanceKm(latitude, longitude, place.Latitude, place.Longitude) <= place.RadiusKm
+	}
+	for _, ring := range place.Polygons {
+		if ringContains(ring, latitude, longitude) {
+			return true
+		}
+	}
+	return false
+}
+
+// [RO] Distanța (km) de la punct până la loc
+// 0 în interior; altfel distanța până la cea mai apropiată margine (sau până la cerc).
+func (place Place) DistanceKm(latitude float64, longitude float64) float64 {
+	if place.Contains(latitude, longitude) {
+		return 0
+	}
+	if !place.HasPolygons() {
+		return math.Max(0, DistanceKm(latitude, longitude, place.Latitude, place.Longitude)-place.RadiusKm)
+	}
+
+	nearest := math.Inf(1)
+	for _, ring := range place.Polygons {
+		for i := range ring {
+			a, b := ring[i], ring[(i+1)%len(ring)]
+			nearest = math.Min(nearest, segmentDistanceKm(latitude, longitude, a, b))
+		}
+	}
+	return nearest
+}
+
+// [RO] Suprafața aproximativă (km²)
+// Folosită doar pentru ordonare: când un punct cade în mai multe locuri, câștigă cel mai mic
+// (enclavele, ex: Vatican în Italia).
+func (place Place) AreaKm2() float64 {
+	if !place.HasPolygons() {
+		return math.Pi * place.RadiusKm * place.RadiusKm
+	}
+
+	total := 0.0
+	for _, ring := range place.Polygons {
+		area := 0.0
+		for i := range ring {
+			a, b := ring[i], ring[(i+1)%len(ring)]
+			area += a[0]*b[1] - b[0]*a[1]
+		}
+		total += math.Abs(area) / 2 * kmPerDegree * kmPerDegree * math.Cos(place.Latitude*math.Pi/180)
+	}
+	return total
+}
+
+// [RO] Punct în Poligon (ray casting pe coordonate [lng, lat])
+func ringContains(ring [][2]float64, latitude float64, longitude float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > latitude) != (yj > latitude) && longitude < (xj-xi)*(latitude-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// [RO] Distanța de la punct la un segment de contur
+// Proiecție echirectangulară locală: suficient de precisă pe distanțele mici care contează (coaste, granițe).
+func segmentDistanceKm(latitude float64, longitude float64, a [2]float64, b [2]float64) float64 {
+	scale := math.Cos(latitude * math.Pi / 180)
+	px, py := longitude*scale, latitude
+	ax, ay := a[0]*scale, a[1]
+	bx, by := b[0]*scale, b[1]
+
+	dx, dy := bx-ax, by-ay
+	t := 0.0
+	if lengthSquared := dx*dx + dy*dy; lengthSquared > 0 {
+		t = math.Max(0, math.Min(1, ((px-ax)*dx+(py-ay)*dy)/lengthSquared))
+	}
+	return math.Hypot(px-(ax+t*dx), py-(ay+t*dy)) * kmPerDegree
+}
+
+// [RO] Distanța pe sferă (km, formula haversine)
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadiusKm = 6371.0
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// [RO] Formate de Export
+const (
+	FormatGraphML = "graphml"
+	FormatGEXF    = "gexf"
+	FormatDOT     = "dot"
+	FormatJSONLD  = "jsonld"
+)
+
+// [RO] Tipuri de Noduri
+const (
+	KindArticle      = "Article"
+	KindEvent        = "Event"
+	KindPerson       = "Person"
+	KindOrganization = "Organization"
+	KindPlace        = "Place"
+	KindEntity       = "Entity" // Entitate cu tip necunoscut
+)
+
+// [RO] Tipuri de Muchii
+const (
+	RelationCausedBy   = "caused_by"   // Event -> Event (cauza)
+	RelationReportedBy = "reported_by" // Event -> Article (sursa)
+	RelationMentions   = "mentions"    // Article -> Entity
+)
+
+// [RO] Limite Export
+const (
+	DefaultExportDepth     = 5
+	MaxExportDepth         = 10
+	DefaultExportMaxEvents = 2000
+	MaxExportMaxEvents     = 20000
+)
+
+// [RO] Nod din Graful Exportat
+// `ID` este UID-ul intern Dgraph (stabil în cadrul unui export); `Key` este identitatea
+// noastră publică: URL-ul articolului, event.id sau entity.id.
+type Node struct {
+	ID          string    `json:"id"`
+	Kind        string    `json:"kind"`
+	Key         string    `json:"key,omitempty"`
+	Label       string    `json:"label"`
+	Timestamp   time.Time `json:"timestamp,omitempty"`
+	Score       float64   `json:"score,omitempty"`
+	WikidataQID string    `json:"wikidata_qid,omitempty"`
+}
+
+// [RO] Muchie Orientată
+type Edge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Relation string `json:"relation"`
+}
+
+// [RO] O pagină din subgraf, așa cum vine din Dgraph.
+// Nodurile unei pagini sunt scrise înaintea muchiilor ei (formatele au nevoie de capete cunoscute).
+type SubgraphPage struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// [RO] Filtrele Exportului
+// Filtrele se combină prin intersecție: (narațiune) ∩ (eveniment rădăcină) ∩ (entitate) ∩ (fereastră de timp).
+type ExportFilter struct {
+	NarrativeID string
+	RootEventID string
+	EntityID    string
+	From        time.Time
+	To          time.Time
+
+	// [RO] Câți pași de cauzalitate urmărim de la rădăcină (în ambele sensuri)
+	Depth int
+
+	// [RO] Plafonul de evenimente exportate
+	MaxEvents int
+}
+
+// [RO] Fereastră de timp inversată (eroare de validare, nu de sursă)
+var ErrInvertedExportWindow = errors.New("[RO] Eroare: Fereastra de timp este inversată (to < from).")
+
+// [RO] Validare și Valori Implicite
+func (filter *ExportFilter) Normalize() error {
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return ErrInvertedExportWindow
+	}
+	if filter.Depth <= 0 {
+		filter.Depth = DefaultExportDepth
+	}
+	if filter.Depth > MaxExportDepth {
+		filter.Depth = MaxExportDepth
+	}
+	if filter.MaxEvents <= 0 {
+		filter.MaxEvents = DefaultExportMaxEvents
+	}
+	if filter.MaxEvents > MaxExportMaxEvents {
+		filter.MaxEvents = MaxExportMaxEvents
+	}
+	return nil
+}
+
+// [RO] Sursa Subgrafului (Dgraph)
+// Livrează subgraful pagină cu pagină, ca exportul să poată fi scris direct în răspuns
+// fără să țină tot graful în memorie.
+type GraphExportSourceInterface interface {
+	StreamExportSubgraph(execution_context context.Context, filter ExportFilter, emit func(page SubgraphPage) error) error
+}
@@ -0,0 +1,443 @@
+package grounding
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Parametrii Ancorării (Grounding)
+const (
+	// [RO] Lungimea maximă a unui pasaj trimis modelului (caractere; pasajele se taie la final de propoziție)
+	MaxPassageRunes = 600
+
+	// [RO] Câte pasaje din același articol intră în context
+	MaxPassagesPerArticle       = 2
+	MaxPassagesForSingleArticle = 8
+
+	// [RO] O propoziție e susținută dacă cel puțin atâtea din cuvintele ei de conținut apar în pasaj
+	SupportThreshold = 0.5
+
+	// [RO] Sub atâtea cuvinte de conținut, propoziția nu e o afirmație ("Da.", "Nu știu.")
+	MinClaimTokens = 3
+
+	// [RO] Cuvintele mai scurte nu contează la verificare (articole, prepoziții)
+	minContentTokenRunes = 3
+)
+
+// [RO] Ce facem cu propozițiile nesusținute
+const (
+	UnsupportedFlag   = "flag"   // Rămân în răspuns, marcate în `sentences`
+	UnsupportedRemove = "remove" // Sunt scoase din răspuns
+)
+
+// [RO] Pasaj-Sursă Numerotat
+// Start/End sunt poziții în caractere (nu octeți) din conținutul articolului: [Start, End).
+type SourcePassage struct {
+	Number    int
+	ArticleID uuid.UUID
+	Title     string
+	Text      string
+	Start     int
+	End       int
+}
+
+// [RO] Citare: fragmentul exact din articol care susține o propoziție a răspunsului
+type Citation struct {
+	Number    int       `json:"number"` // Numărul [n] din răspuns
+	ArticleID uuid.UUID `json:"article_id"`
+	Title     string    `json:"title,omitempty"`
+	Snippet   string    `json:"snippet,omitempty"`
+	Start     int       `json:"start"` // Caractere, în conținutul articolului
+	End       int       `json:"end"`
+}
+
+// [RO] Verificarea unei Propoziții din Răspuns
+type SentenceCheck struct {
+	Text      string  `json:"text"`
+	Sources   []int   `json:"sources"`   // Pasajele care o susțin (sau cele citate, dacă nu e susținută)
+	Claim     bool    `json:"claim"`     // False pentru propoziții fără conținut verificabil
+	Supported bool    `json:"supported"` // Mereu true când Claim e false
+	Support   float64 `json:"support"`   // Fracțiunea de cuvinte regăsite în cel mai bun pasaj
+	Recited   bool    `json:"recited"`   // Marcajele [n] au fost înlocuite cu pasajul care o susține
+}
+
+// [RO] Răspuns Verificat
+type GroundedAnswer struct {
+	Answer           string          `json:"answer"`
+	Citations        []Citation      `json:"citations"`
+	Sentences        []SentenceCheck `json:"sentences"`
+	UnsupportedCount int             `json:"unsupported_count"`
+	RemovedCount     int             `json:"removed_count"`
+}
+
+// [RO] Bucată de Text cu Pozițiile Ei
+type TextSpan struct {
+	Text  string
+	Start int
+	End   int
+}
+
+var citationMarkerPattern = regexp.MustCompile(`\[(\d+(?:\s*,\s*\d+)*)\]`)
+
+// [RO] Validarea Modului pentru Propozițiile Nesusținute (gol = flag)
+func NormalizeUnsupportedMode(mode string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", UnsupportedFlag:
+		return UnsupportedFlag, nil
+	case UnsupportedRemove:
+		return UnsupportedRemove, nil
+	}
+	return "", fmt.Errorf("[RO] Eroare: Modul %q nu există (flag sau remove).", mode)
+}
+
+// [RO] Împarte Textul în Propoziții (cu poziții în caractere)
+// Marcajele de citare lipite după punct ("...a crescut.[2]") rămân la propoziția lor.
+func SplitSentences(text string) []TextSpan {
+	runes := []rune(text)
+	var spans []TextSpan
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		boundary := runes[i] == '\n'
+		if strings.ContainsRune(".!?", runes[i]) {
+			end := i + 1
+			for end < len(runes) && strings.ContainsRune(".!?\"'”»)", runes[end]) {
+				end++
+			}
+			if loc := citationMarkerPattern.FindStringIndex(string(runes[end:])); loc != nil && loc[0] == 0 {
+				for loc != nil && loc[0] == 0 {
+					end += utf8.RuneCountInString(string(runes[end:])[:loc[1]])
+					loc = citationMarkerPattern.FindStringIndex(string(runes[end:]))
+				}
+			}
+			if end == len(runes) || unicode.IsSpace(runes[end]) {
+				i, boundary = end-1, true
+			}
+		}
+		if boundary {
+			spans = appendTrimmedSpan(spans, runes, start, i+1)
+			start = i + 1
+		}
+	}
+	return appendTrimmedSpan(spans, runes, start, len(runes))
+}
+
+func appendTrimmedSpan(spans []TextSpan, runes []rune, start int, end int) []TextSpan {
+	for start < end && unicode.IsSpace(runes[start]) {
+		start++
+	}
+	for end > start && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+	if start == end {
+		return spans
+	}
+	return append(spans, TextSpan{Text: string(runes[start:end]), Start: start, End: end})
+}
+
+// [RO] Împarte Conținutul în Pasaje
+// Propoziții consecutive, până la MaxPassageRunes; o propoziție mai lungă rămâne pasaj întreg.
+func SplitPassages(content string) []TextSpan {
+	runes := []rune(content)
+	var passages []TextSpan
+	var current *TextSpan
+	for _, sentence := range SplitSentences(content) {
+		if current != nil && sentence.End-current.Start <= MaxPassageRunes {
+			current.End = sentence.End
+			continue
+		}
+		if current != nil {
+			current.Text = string(runes[current.Start:current.End])
+			passages = append(passages, *current)
+		}
+		current = &TextSpan{Start: sentence.Start, End: sentence.End}
+	}
+	if current != nil {
+		current.Text = string(runes[current.Start:current.End])
+		passages = append(passages, *current)
+	}
+	return passages
+}
+
+// [RO] Cele Mai Relevante Pasaje pentru Întrebare
+// Ordonate după câte cuvinte din întrebare conțin; la egalitate (sau fără potriviri) câștigă
+// pasajele de la începutul articolului. Rezultatul revine la ordinea din text.
+func SelectPassages(query string, passages []TextSpan, limit int) []TextSpan {
+	if len(passages) <= limit {
+		return passages
+	}
+	queryTokens := contentTokenSet(query)
+	scores := make([]int, len(passages))
+	for i, passage := range passages {
+		for token := range contentTokenSet(passage.Text) {
+			if queryTokens[token] {
+				scores[i]++
+			}
+		}
+	}
+
+	indexes := make([]int, len(passages))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool { return scores[indexes[a]] > scores[indexes[b]] })
+	indexes = indexes[:limit]
+	sort.Ints(indexes)
+
+	selected := make([]TextSpan, 0, limit)
+	for _, index := range indexes {
+		selected = append(selected, passages[index])
+	}
+	return selected
+}
+
+// [RO] Numerotează Pasajele (1, 2, 3... în ordinea primită)
+func NumberPassages(passages []SourcePassage) []SourcePassage {
+	for i := range passages {
+		passages[i].Number = i + 1
+	}
+	return passages
+}
+
+// [RO] Sursele ca Text pentru Model ("[1] Titlu\nPasaj")
+func RenderSources(passages []SourcePassage) string {
+	var builder strings.Builder
+	for _, passage := range passages {
+		builder.WriteString(fmt.Sprintf("[%d] %s\n%s\n---\n", passage.Number, passage.Title, passage.Text))
+	}
+	return builder.String()
+}
+
+// [RO] Verifică Răspunsul față de Pasaje
+//
+// Fiecare propoziție cu conținut (cel puțin MinClaimTokens cuvinte) trebuie să-și regăsească
+// cel puțin SupportThreshold din cuvinte într-un pasaj. Se încearcă întâi pasajele citate [n];
+// dacă nu o susțin, oricare pasaj (citarea e corectată). Pentru fiecare susținere, citarea poartă
+// propoziția din articol cea mai apropiată de afirmație, cu pozițiile ei; când citarea e corectată,
+// și marcajele [n] din textul răspunsului sunt rescrise, ca cititorul să nu vadă sursa greșită.
+// În modul UnsupportedRemove, propozițiile nesusținute sunt scoase din răspuns; restul textului
+// (spațiile și paragrafele dintre propoziții) rămâne cum l-a scris modelul.
+func VerifyAnswer(answer string, passages []SourcePassage, mode string) GroundedAnswer {
+	byNumber := make(map[int]SourcePassage, len(passages))
+	for _, passage := range passages {
+		byNumber[passage.Number] = passage
+	}
+
+	result := GroundedAnswer{Citations: []Citation{}, Sentences: []SentenceCheck{}}
+	cited := map[string]bool{}
+	runes := []rune(answer)
+	var rebuilt strings.Builder
+	var separator string
+	previousEnd, wroteSentence := 0, false
+	for _, sentence := range SplitSentences(answer) {
+		separator = strongerSeparator(separator, string(runes[previousEnd:sentence.Start]))
+		previousEnd = sentence.End
+
+		check := SentenceCheck{Text: sentence.Text, Sources: []int{}}
+		claimTokens := contentTokens(citationMarkerPattern.ReplaceAllString(sentence.Text, " "))
+		if len(claimTokens) < MinClaimTokens {
+			check.Supported = true
+		} else {
+			check.Claim = true
+
+			citedNumbers := citedPassageNumbers(sentence.Text, byNumber)
+			supporting, support := bestSupport(claimTokens, citedNumbers, byNumber)
+			if support < SupportThreshold {
+				supporting, support = bestSupport(claimTokens, nil, byNumber)
+			}
+			check.Support = support
+			check.Supported = support >= SupportThreshold
+
+			if check.Supported {
+				check.Sources = []int{supporting.Number}
+				if citationMarkerPattern.MatchString(sentence.Text) && !containsNumber(citedNumbers, supporting.Number) {
+					check.Text = rewriteCitationMarkers(sentence.Text, supporting.Number)
+					check.Recited = true
+				}
+				citation := citationFor(supporting, claimTokens)
+				key := fmt.Sprintf("%d:%d", citation.Number, citation.Start)
+				if !cited[key] {
+					cited[key] = true
+					result.Citations = append(result.Citations, citation)
+				}
+			} else {
+				check.Sources = append(check.Sources, citedNumbers...)
+				result.UnsupportedCount++
+			}
+		}
+		result.Sentences = append(result.Sentences, check)
+
+		if !check.Supported && mode == UnsupportedRemove {
+			result.RemovedCount++
+			continue
+		}
+		if wroteSentence {
+			rebuilt.WriteString(separator)
+		} else {
+			rebuilt.WriteString(string(runes[:sentence.Start]))
+		}
+		rebuilt.WriteString(check.Text)
+		separator, wroteSentence = "", true
+	}
+	if wroteSentence {
+		rebuilt.WriteString(string(runes[previousEnd:]))
+	}
+
+	result.Answer = rebuilt.String()
+	return result
+}
+
+// [RO] Separatorul care rămâne când o propoziție dispare: cel cu mai multe rânduri noi,
+// ca un paragraf să nu se lipească de cel anterior.
+func strongerSeparator(current string, next string) string {
+	if current == "" || strings.Count(next, "\n") > strings.Count(current, "\n") {
+		return next
+	}
+	return current
+}
+
+// [RO] Rescrie Marcajele unei Propoziții Recitate
+// Primul marcaj devine [number]; celelalte dispar (cu spațiul din fața lor; "[2], [3]" devine un singur marcaj).
+func rewriteCitationMarkers(sentence string, number int) string {
+	var builder strings.Builder
+	previous := 0
+	for i, loc := range citationMarkerPattern.FindAllStringIndex(sentence, -1) {
+		if i == 0 {
+			builder.WriteString(sentence[previous:loc[0]])
+			builder.WriteString(fmt.Sprintf("[%d]", number))
+		} else if gap := sentence[previous:loc[0]]; strings.Trim(gap, " ,;") != "" {
+			builder.WriteString(strings.TrimRightFunc(gap, unicode.IsSpace))
+		}
+		previous = loc[1]
+	}
+	builder.WriteString(sentence[previous:])
+	return builder.String()
+}
+
+func containsNumber(numbers []int, number int) bool {
+	for _, candidate := range numbers {
+		if candidate == number {
+			return true
+		}
+	}
+	return false
+}
+
+// [RO] ID-urile Articolelor Citate (fără repetiții, în ordinea citărilor)
+func CitedArticleIDs(citations []Citation) []string {
+	seen := map[uuid.UUID]bool{}
+	ids := []string{}
+	for _, citation := range citations {
+		if !seen[citation.ArticleID] {
+			seen[citation.ArticleID] = true
+			ids = append(ids, citation.ArticleID.String())
+		}
+	}
+	return ids
+}
+
+// [RO] Numerele [n] din propoziție care există printre pasaje
+func citedPassageNumbers(sentence string, byNumber map[int]SourcePassage) []int {
+	var numbers []int
+	for _, match := range citationMarkerPattern.FindAllStringSubmatch(sentence, -1) {
+		for _, raw := range strings.Split(match[1], ",") {
+			number, err := strconv.Atoi(strings.TrimSpace(raw))
+			if _, known := byNumber[number]; err == nil && known {
+				numbers = append(numbers, number)
+			}
+		}
+	}
+	return numbers
+}
+
+// [RO] Pasajul care acoperă cele mai multe cuvinte ale afirmației (dintre `numbers`; nil = toate)
+func bestSupport(claimTokens []string, numbers []int, byNumber map[int]SourcePassage) (SourcePassage, float64) {
+	if numbers == nil {
+		for number := range byNumber {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+	}
+
+	var best SourcePassage
+	bestSupport := -1.0
+	for _, number := range numbers {
+		support := tokenCoverage(claimTokens, contentTokenSet(byNumber[number].Text))
+		if support > bestSupport {
+			best, bestSupport = byNumber[number], support
+		}
+	}
+	if bestSupport < 0 {
+		return best, 0
+	}
+	return best, bestSupport
+}
+
+// [RO] Citarea: propoziția din pasaj cea mai apropiată de afirmație, cu poziția ei în articol
+func citationFor(passage SourcePassage, claimTokens []string) Citation {
+	citation := Citation{Number: passage.Number, ArticleID: passage.ArticleID, Title: passage.Title}
+	bestSupport := -1.0
+	for _, sentence := range SplitSentences(passage.Text) {
+		support := tokenCoverage(claimTokens, contentTokenSet(sentence.Text))
+		if support > bestSupport {
+			bestSupport = support
+			citation.Snippet = sentence.Text
+			citation.Start = passage.Start + sentence.Start
+			citation.End = passage.Start + sentence.End
+		}
+	}
+	return citation
+}
+
+func tokenCoverage(claimTokens []string, source map[string]bool) float64 {
+	if len(claimTokens) == 0 {
+		return 0
+	}
+	found := 0
+	for _, token := range claimTokens {
+		if source[token] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(claimTokens))
+}
+
+// [RO] Cuvintele de conținut (litere mici, fără repetiții, cel puțin minContentTokenRunes caractere)
+func contentTokens(text string) []string {
+	seen := map[string]bool{}
+	var tokens []string
+	for _, token := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if seen[token] || (utf8.RuneCountInString(token) < minContentTokenRunes && !isNumber(token)) {
+			continue
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+func contentTokenSet(text string) map[string]bool {
+	set := map[string]bool{}
+	for _, token := range contentTokens(text) {
+		set[token] = true
+	}
+	return set
+}
+
+// [RO] Cifrele contează mereu ("3%", "2 morți"): sunt exact ce vrem să verificăm
+func isNumber(token string) bool {
+	for _, r := range token {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package grounding
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleContent = "The central bank raised interest rates by 50 basis points on Tuesday. " +
+	"Officials cited persistent inflation in food and energy prices.\n" +
+	"Markets reacted calmly, with the currency gaining slightly against the euro."
+
+func samplePassages() []SourcePassage {
+	articleID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	var passages []SourcePassage
+	for _, span := range SplitSentences(sampleContent) {
+		passages = append(passages, SourcePassage{ArticleID: articleID, Title: "Rates", Text: span.Text, Start: span.Start, End: span.End})
+	}
+	return NumberPassages(passages)
+}
+
+func TestSplitSentences_KeepsOffsetsAndTrailingMarkers(t *testing.T) {
+	spans := SplitSentences("Rates rose.[1] Prices fell [2]. Ce urmează?")
+	require.Len(t, spans, 3)
+	assert.Equal(t, "Rates rose.[1]", spans[0].Text)
+	assert.Equal(t, "Prices fell [2].", spans[1].Text)
+	assert.Equal(t, "Ce urmează?", spans[2].Text)
+
+	runes := []rune(sampleContent)
+	for _, span := range SplitSentences(sampleContent) {
+		assert.Equal(t, span.Text, string(runes[span.Start:span.End]))
+	}
+}
+
+func TestSplitPassages_GroupsSentencesUpToLimit(t *testing.T) {
+	passages := SplitPassages(sampleContent)
+	require.Len(t, passages, 1)
+	assert.Equal(t, 0, passages[0].Start)
+	assert.Equal(t, len([]rune(sampleContent)), passages[0].End)
+}
+
+func TestSelectPassages_PrefersQueryOverlapAndKeepsTextOrder(t *testing.T) {
+	passages := SplitSentences(sampleContent)
+	selected := SelectPassages("how did the currency and markets react", passages, 1)
+	require.Len(t, selected, 1)
+	assert.Contains(t, selected[0].Text, "Markets reacted")
+}
+
+func TestVerifyAnswer_CitesExactSnippetWithOffsets(t *testing.T) {
+	passages := samplePassages()
+	answer := "The central bank raised rates by 50 basis points [1]. Officials blamed inflation in energy prices [2]."
+
+	result := VerifyAnswer(answer, passages, UnsupportedFlag)
+
+	assert.Equal(t, 0, result.UnsupportedCount)
+	require.Len(t, result.Citations, 2)
+	second := result.Citations[1]
+	assert.Equal(t, 2, second.Number)
+	assert.Equal(t, string([]rune(sampleContent)[second.Start:second.End]), second.Snippet)
+	assert.Equal(t, "Officials cited persistent inflation in food and energy prices.", second.Snippet)
+}
+
+func TestVerifyAnswer_CorrectsWrongCitation(t *testing.T) {
+	result := VerifyAnswer("Markets reacted calmly and the currency gained against the euro [1].", samplePassages(), UnsupportedFlag)
+
+	require.Len(t, result.Sentences, 1)
+	assert.True(t, result.Sentences[0].Supported)
+	assert.Equal(t, []int{3}, result.Sentences[0].Sources)
+	assert.True(t, result.Sentences[0].Recited)
+	assert.Equal(t, "Markets reacted calmly and the currency gained against the euro [3].", result.Answer)
+}
+
+func TestVerifyAnswer_RecitesOnlyWrongMarkers(t *testing.T) {
+	answer := "The central bank raised rates by 50 basis points [2], [3]. Officials blamed inflation in energy prices [2]."
+
+	result := VerifyAnswer(answer, samplePassages(), UnsupportedFlag)
+
+	assert.Equal(t, "The central bank raised rates by 50 basis points [1]. Officials blamed inflation in energy prices [2].", result.Answer)
+	assert.True(t, result.Sentences[0].Recited)
+	assert.False(t, result.Sentences[1].Recited)
+}
+
+func TestVerifyAnswer_FlagsOrRemovesUnsupportedSentences(t *testing.T) {
+	answer := "The central bank raised rates by 50 basis points [1]. The governor resigned after a scandal involving offshore accounts [1]. Yes."
+
+	flagged := VerifyAnswer(answer, samplePassages(), UnsupportedFlag)
+	assert.Equal(t, answer, flagged.Answer)
+	assert.Equal(t, 1, flagged.UnsupportedCount)
+	assert.False(t, flagged.Sentences[1].Supported)
+	assert.Equal(t, []int{1}, flagged.Sentences[1].Sources)
+	assert.False(t, flagged.Sentences[2].Claim)
+
+	removed := VerifyAnswer(answer, samplePassages(), UnsupportedRemove)
+	assert.Equal(t, "The central bank raised rates by 50 basis points [1]. Yes.", removed.Answer)
+	assert.Equal(t, 1, removed.RemovedCount)
+}
+
+func TestNormalizeUnsupportedMode(t *testing.T) {
+	mode, err := NormalizeUnsupportedMode("")
+	assert.NoError(t, err)
+	assert.Equal(t, UnsupportedFlag, mode)
+
+	_, err = NormalizeUnsupportedMode("drop")
+	assert.Error(t, err)
+}
+
+func TestVerifyAnswer_RemoveKeepsParagraphBreaks(t *testing.T) {
+	answer := "The central bank raised rates by 50 basis points [1].\n\nThe governor resigned after a scandal involving offshore accounts [1]. Markets reacted calmly against the euro [3].\n"
+
+	removed := VerifyAnswer(answer, samplePassages(), UnsupportedRemove)
+
+	assert.Equal(t, "The central bank raised rates by 50 basis points [1].\n\nMarkets reacted calmly against the euro [3].\n", removed.Answer)
+	assert.Equal(t, 1, removed.RemovedCount)
+}
@@ -0,0 +1,162 @@
+package perspective
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Limitele Perspectivelor
+const (
+	MinPerspectives          = 2
+	MaxPerspectives          = 4
+	MaxSourcesPerPerspective = 4
+
+	// [RO] O poveste cu un singur articol nu are încă perspective de comparat
+	MinCorpusArticles = 2
+	// [RO] Câte articole din grupul poveștii ajung la model (cele mai noi)
+	MaxCorpusArticles = 12
+
+	DefaultRevisionLimit = 20
+	MaxRevisionLimit     = 100
+)
+
+// [RO] Povestea nu are (încă) perspective
+var ErrPerspectivesNotFound = errors.New("[RO] Povestea nu are încă perspective.")
+
+// [RO] Articol din Corpus (sursa unei perspective)
+type SourceArticle struct {
+	ArticleID   uuid.UUID `json:"article_id"`
+	Title       string    `json:"title"`
+	OriginalURL string    `json:"url"`
+	Summary     string    `json:"summary"`
+	BiasRating  string    `json:"bias_rating,omitempty"`
+}
+
+// [RO] Perspectiva propusă de model
+// Sursele sunt numerele articolelor din corpus ([1], [2]...), nu ID-uri: modelul nu poate inventa o sursă.
+type DraftPerspective struct {
+	Label         string `json:"label"`
+	Summary       string `json:"summary"`
+	SourceNumbers []int  `json:"sources"`
+}
+
+// [RO] Un Punct de Vedere
+type Perspective struct {
+	Label   string          `json:"label"`
+	Summary string          `json:"summary"`
+	Sources []SourceArticle `json:"sources"`
+}
+
+// [RO] Perspectivele unei Povești (o revizie)
+// Fiecare regenerare salvează o revizie nouă; cele vechi rămân pentru istoric.
+type PerspectiveSet struct {
+	StoryClusterID  uuid.UUID     `json:"story_cluster_id"`
+	Revision        int           `json:"revision"`
+	Perspectives    []Perspective `json:"perspectives"`
+	InputArticleIDs []uuid.UUID   `json:"input_article_ids"`
+	GeneratedAt     time.Time     `json:"generated_at"`
+}
+
+// [RO] Rezumatul unei Revizii (pentru istoric)
+type RevisionSummary struct {
+	Revision    int       `json:"revision"`
+	Labels      []string  `json:"labels"`
+	Articles    int       `json:"articles"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// [RO] Construiește Setul de Perspective
+//
+// Păstrează doar perspectivele cu etichetă, rezumat și cel puțin o sursă reală din corpus;
+// etichetele duplicate sunt unite, iar setul e limitat la MaxPerspectives. Sub MinPerspectives
+// nu există o comparație de arătat, deci returnăm eroare (revizia anterioară rămâne).
+func BuildPerspectiveSet(storyClusterID uuid.UUID, revision int, corpus []SourceArticle, drafts []DraftPerspective, generatedAt time.Time) (PerspectiveSet, error) {
+	set := PerspectiveSet{StoryClusterID: storyClusterID, Revision: revision, GeneratedAt: generatedAt, InputArticleIDs: corpusIDs(corpus)}
+
+	seenLabels := map[string]bool{}
+	for _, draft := range drafts {
+		label := strings.TrimSpace(draft.Label)
+		summary := strings.TrimSpace(draft.Summary)
+		if label == "" || summary == "" || seenLabels[strings.ToLower(label)] {
+			continue
+		}
+
+		var sources []SourceArticle
+		seenSources := map[int]bool{}
+		for _, number := range draft.SourceNumbers {
+			if number < 1 || number > len(corpus) || seenSources[number] || len(sources) == MaxSourcesPerPerspective {
+				continue
+			}
+			seenSources[number] = true
+			sources = append(sources, corpus[number-1])
+		}
+		if len(sources) == 0 {
+			continue
+		}
+
+		seenLabels[strings.ToLower(label)] = true
+		set.Perspectives = append(set.Perspectives, Perspective{Label: label, Summary: summary, Sources: sources})
+		if len(set.Perspectives) == MaxPerspectives {
+			break
+		}
+	}
+
+	if len(set.Perspectives) < MinPerspectives {
+		return set, fmt.Errorf("[RO] Eroare: modelul a propus %d perspective cu surse valide (minim %d).", len(set.Perspectives), MinPerspectives)
+	}
+	return set, nil
+}
+
+// [RO] Trebuie Regenerate Perspectivele?
+// Da, dacă povestea are destule articole și setul lor diferă de cel din ultima revizie.
+func NeedsRefresh(latest *PerspectiveSet, corpus []SourceArticle) bool {
+	if len(corpus) < MinCorpusArticles {
+		return false
+	}
+	if latest == nil {
+		return true
+	}
+	current := corpusIDs(corpus)
+	if len(current) != len(latest.InputArticleIDs) {
+		return true
+	}
+	previous := append([]uuid.UUID(nil), latest.InputArticleIDs...)
+	sort.Slice(previous, func(i, j int) bool { return previous[i].String() < previous[j].String() })
+	for index := range current {
+		if current[index] != previous[index] {
+			return true
+		}
+	}
+	return false
+}
+
+// [RO] ID-urile articolelor din corpus, sortate (amprenta intrării)
+func corpusIDs(corpus []SourceArticle) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(corpus))
+	for _, source := range corpus {
+		ids = append(ids, source.ArticleID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
+
+// [RO] Interfața de Persistență a Perspectivelor
+type PerspectivePersistenceInterface interface {
+	// [RO] Cele mai noi articole ale poveștii (corpusul generării)
+	RetrievePerspectiveCorpus(ctx context.Context, storyClusterID uuid.UUID, limit int) ([]SourceArticle, error)
+
+	// [RO] Idempotent: aceeași revizie salvată de două ori rămâne una
+	SavePerspectiveSet(ctx context.Context, set PerspectiveSet) error
+
+	// [RO] Revizia cerută (revision <= 0 = ultima); ErrPerspectivesNotFound dacă lipsește
+	RetrievePerspectiveSet(ctx context.Context, storyClusterID uuid.UUID, revision int) (*PerspectiveSet, error)
+
+	// [RO] Istoricul reviziilor, cele mai noi întâi
+	ListPerspectiveRevisions(ctx context.Context, storyClusterID uuid.UUID, limit int) ([]RevisionSummary, error)
+}
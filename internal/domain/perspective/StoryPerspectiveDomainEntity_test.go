@@ -0,0 +1,51 @@
+package perspective
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPerspectiveSet_KeepsOnlyGroundedDistinctViewpoints(t *testing.T) {
+	clusterID := uuid.New()
+	corpus := []SourceArticle{
+		{ArticleID: uuid.New(), Title: "Unions reject pension reform"},
+		{ArticleID: uuid.New(), Title: "Treasury defends pension reform"},
+		{ArticleID: uuid.New(), Title: "Economists split on reform costs"},
+	}
+	generatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	set, err := BuildPerspectiveSet(clusterID, 2, corpus, []DraftPerspective{
+		{Label: "Workers' representatives", Summary: "The reform shifts costs onto employees.", SourceNumbers: []int{1, 1, 3}},
+		{Label: "Government", Summary: "The reform keeps the system solvent.", SourceNumbers: []int{2}},
+		{Label: "government", Summary: "Duplicate label.", SourceNumbers: []int{2}},
+		{Label: "Invented", Summary: "Cites a source outside the corpus.", SourceNumbers: []int{7}},
+		{Label: "", Summary: "No label.", SourceNumbers: []int{3}},
+	}, generatedAt)
+	require.NoError(t, err)
+
+	require.Len(t, set.Perspectives, 2)
+	assert.Equal(t, "Workers' representatives", set.Perspectives[0].Label)
+	assert.Equal(t, []SourceArticle{corpus[0], corpus[2]}, set.Perspectives[0].Sources)
+	assert.Equal(t, 2, set.Revision)
+	assert.Len(t, set.InputArticleIDs, 3)
+
+	_, err = BuildPerspectiveSet(clusterID, 1, corpus, []DraftPerspective{
+		{Label: "Only one", Summary: "A single viewpoint is not a comparison.", SourceNumbers: []int{1}},
+	}, generatedAt)
+	assert.Error(t, err)
+}
+
+func TestNeedsRefresh_WhenCorpusChanges(t *testing.T) {
+	first, second, third := SourceArticle{ArticleID: uuid.New()}, SourceArticle{ArticleID: uuid.New()}, SourceArticle{ArticleID: uuid.New()}
+
+	assert.False(t, NeedsRefresh(nil, []SourceArticle{first}), "[RO] Un singur articol")
+	assert.True(t, NeedsRefresh(nil, []SourceArticle{first, second}))
+
+	latest := &PerspectiveSet{InputArticleIDs: []uuid.UUID{second.ArticleID, first.ArticleID}}
+	assert.False(t, NeedsRefresh(latest, []SourceArticle{first, second}), "[RO] Aceleași articole, altă ordine")
+	assert.True(t, NeedsRefresh(latest, []SourceArticle{first, second, third}))
+}
@@ -0,0 +1,253 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+// [RO] Semnalul Temporal prin care editorul decide soarta unui articol oprit la verificare
+const EditorialDecisionSignal = "EditorialDecision"
+
+// [RO] Acțiunile Editorului
+const (
+	DecisionApprove = "approve" // Publicăm analiza așa cum e
+	DecisionEdit    = "edit"    // Publicăm cu corecturile editorului
+	DecisionReject  = "reject"  // Nu publicăm
+)
+
+// [RO] Starea unei Verificări
+const (
+	StatusPending          = "pending"
+	StatusApproved         = "approved"
+	StatusEdited           = "edited"
+	StatusRejected         = "rejected"
+	StatusTimeoutPublished = "timeout_published" // Nimeni n-a decis la timp; politica a publicat
+	StatusTimeoutRejected  = "timeout_rejected"  // Nimeni n-a decis la timp; politica a respins
+)
+
+// [RO] Ce se întâmplă când termenul expiră fără decizie
+const (
+	TimeoutPublish = "publish"
+	TimeoutReject  = "reject"
+)
+
+// [RO] Motivele pentru care o analiză e oprită (pe lângă article.ReviewReason*)
+const (
+	ReasonUncertainTruth       = "uncertain_truth_score"   // Scor aproape de 0.5: modelul nu s-a decis
+	ReasonHighEmotion          = "high_emotion_intensity"  // Subiect foarte încărcat emoțional
+	ReasonHighImpactCausalLink = "high_impact_causal_link" // Legătură cauzală puternică (acuzație gravă)
+)
+
+// [RO] Erorile Cozii de Verificare
+var (
+	ErrReviewNotFound        = errors.New("[RO] Articolul nu așteaptă verificare.")
+	ErrReviewAlreadyResolved = errors.New("[RO] Verificarea a fost deja încheiată.")
+)
+
+// [RO] Politica de Verificare Editorială
+// Valorile zero sunt înlocuite cu cele implicite (vezi Normalize).
+type ReviewPolicy struct {
+	UncertaintyMargin         float64       // |scor - 0.5| <= marjă -> verificare
+	EmotionIntensityThreshold float64       // intensitate >= prag -> verificare
+	CausalImpactThreshold     float64       // încrederea unei legături cauzale >= prag -> verificare
+	Timeout                   time.Duration // Cât așteptăm editorul
+	TimeoutAction             string        // TimeoutPublish sau TimeoutReject
+}
+
+// [RO] Politica Implicită
+func DefaultReviewPolicy() ReviewPolicy {
+	return ReviewPolicy{
+		UncertaintyMargin:         0.1,
+		EmotionIntensityThreshold: 0.85,
+		CausalImpactThreshold:     0.8,
+		Timeout:                   48 * time.Hour,
+		TimeoutAction:             TimeoutPublish,
+	}
+}
+
+// [RO] Completează câmpurile lipsă cu valorile implicite
+func (policy ReviewPolicy) Normalize() ReviewPolicy {
+	defaults := DefaultReviewPolicy()
+	if policy.UncertaintyMargin <= 0 {
+		policy.UncertaintyMargin = defaults.UncertaintyMargin
+	}
+	if policy.EmotionIntensityThreshold <= 0 {
+		policy.EmotionIntensityThreshold = defaults.EmotionIntensityThreshold
+	}
+	if policy.CausalImpactThreshold <= 0 {
+		policy.CausalImpactThreshold = defaults.CausalImpactThreshold
+	}
+	if policy.Timeout <= 0 {
+		policy.Timeout = defaults.Timeout
+	}
+	if policy.TimeoutAction != TimeoutReject {
+		policy.TimeoutAction = TimeoutPublish
+	}
+	return policy
+}
+
+// [RO] Evaluarea unei Analize: motivele opririi (nil = publicare directă) și termenul
+type Assessment struct {
+	Reasons       []string
+	Timeout       time.Duration
+	TimeoutAction string
+}
+
+// [RO] Evaluează Analiza
+// Motivele de integritate (article.ReviewAnalysisIntegrity) sunt primele, urmate de cele editoriale.
+func AssessArticle(candidate article.NewsArticleEntity, policy ReviewPolicy) Assessment {
+	policy = policy.Normalize()
+	reasons := append([]string(nil), candidate.ReviewFlags...)
+
+	if math.Abs(candidate.TruthScore-0.5) <= policy.UncertaintyMargin {
+		reasons = append(reasons, ReasonUncertainTruth)
+	}
+	if candidate.Geolocation.Intensity >= policy.EmotionIntensityThreshold {
+		reasons = append(reasons, ReasonHighEmotion)
+	}
+	for _, cause := range candidate.Causes {
+		if cause.Confidence >= policy.CausalImpactThreshold {
+			reasons = append(reasons, ReasonHighImpactCausalLink)
+			break
+		}
+	}
+	return Assessment{Reasons: reasons, Timeout: policy.Timeout, TimeoutAction: policy.TimeoutAction}
+}
+
+// [RO] Corecturile Editorului (doar câmpurile completate se schimbă)
+type EditorialEdits struct {
+	Title           *string  `json:"title,omitempty"`
+	Content         *string  `json:"content,omitempty"`
+	Summary         *string  `json:"summary,omitempty"`
+	TruthScore      *float64 `json:"truth_score,omitempty"`
+	BiasRating      *string  `json:"bias_rating,omitempty"`
+	CounterArgument *string  `json:"counter_argument,omitempty"`
+}
+
+func (edits *EditorialEdits) isEmpty() bool {
+	return edits == nil || (edits.Title == nil && edits.Content == nil && edits.Summary == nil &&
+		edits.TruthScore == nil && edits.BiasRating == nil && edits.CounterArgument == nil)
+}
+
+// [RO] Decizia Editorului (conținutul semnalului EditorialDecisionSignal)
+type EditorialDecision struct {
+	Action    string          `json:"action"`
+	Reviewer  string          `json:"reviewer"`
+	Note      string          `json:"note,omitempty"`
+	Edits     *EditorialEdits `json:"edits,omitempty"`
+	DecidedAt time.Time       `json:"decided_at"`
+}
+
+// [RO] Validarea Deciziei
+func (decision EditorialDecision) Validate() error {
+	if strings.TrimSpace(decision.Reviewer) == "" {
+		return fmt.Errorf("[RO] Eroare: editorul (reviewer) este obligatoriu.")
+	}
+	switch decision.Action {
+	case DecisionApprove, DecisionReject:
+		return nil
+	case DecisionEdit:
+	default:
+		return fmt.Errorf("[RO] Eroare: acțiune necunoscută %q (approve, edit sau reject).", decision.Action)
+	}
+
+	edits := decision.Edits
+	if edits.isEmpty() {
+		return fmt.Errorf("[RO] Eroare: acțiunea edit cere cel puțin o corectură.")
+	}
+	if edits.TruthScore != nil && (*edits.TruthScore < 0 || *edits.TruthScore > 1) {
+		return fmt.Errorf("[RO] Eroare: truth_score trebuie să fie între 0 și 1.")
+	}
+	if edits.Content != nil && strings.TrimSpace(*edits.Content) == "" {
+		return fmt.Errorf("[RO] Eroare: conținutul corectat nu poate fi gol.")
+	}
+	return nil
+}
+
+// [RO] Aplică Decizia asupra Ciornei
+// Returnează articolul de publicat (nil = respins) și starea finală a verificării.
+func ApplyDecision(draft article.NewsArticleEntity, decision EditorialDecision) (*article.NewsArticleEntity, string) {
+	switch decision.Action {
+	case DecisionReject:
+		return nil, StatusRejected
+	case DecisionEdit:
+		edits := decision.Edits
+		if edits.Title != nil {
+			draft.Title = *edits.Title
+		}
+		if edits.Content != nil {
+			draft.Content = *edits.Content
+		}
+		if edits.Summary != nil {
+			draft.Summary = *edits.Summary
+		}
+		if edits.TruthScore != nil {
+			draft.TruthScore = *edits.TruthScore
+		}
+		if edits.BiasRating != nil {
+			draft.BiasRating = *edits.BiasRating
+		}
+		if edits.CounterArgument != nil {
+			draft.CounterArgument = *edits.CounterArgument
+		}
+		return &draft, StatusEdited
+	default:
+		return &draft, StatusApproved
+	}
+}
+
+// [RO] Termen Expirat
+// Articolele cu probleme de integritate (text care a încercat să-și dicteze analiza) nu se
+// publică niciodată fără un om, indiferent de politică.
+func ResolveTimeout(draft article.NewsArticleEntity, timeoutAction string) (*article.NewsArticleEntity, string) {
+	if timeoutAction == TimeoutReject || len(draft.ReviewFlags) > 0 {
+		return nil, StatusTimeoutRejected
+	}
+	return &draft, StatusTimeoutPublished
+}
+
+// [RO] Articol în Coada de Verificare
+// Draft este analiza completă, încă nepublicată; workflow-ul o păstrează în memorie și o
+// publică (sau nu) după decizie.
+type EditorialReview struct {
+	ArticleID  uuid.UUID                 `json:"article_id"`
+	WorkflowID string                    `json:"workflow_id"`
+	RunID      string                    `json:"run_id"`
+	Reasons    []string                  `json:"reasons"`
+	Status     string                    `json:"status"`
+	Draft      article.NewsArticleEntity `json:"draft"`
+	CreatedAt  time.Time                 `json:"created_at"`
+	Deadline   time.Time                 `json:"deadline"`
+	Decision   *EditorialDecision        `json:"decision,omitempty"`
+	ResolvedAt *time.Time                `json:"resolved_at,omitempty"`
+}
+
+// [RO] Încheierea unei Verificări (decizie sau termen expirat)
+type EditorialResolution struct {
+	ArticleID  uuid.UUID
+	WorkflowID string // Cheia închiderii: execuția care a deschis verificarea
+	RunID      string
+	Status     string
+	Decision   *EditorialDecision // nil la termen expirat
+	ResolvedAt time.Time
+}
+
+// [RO] Interfața de Persistență a Cozii de Verificare
+type EditorialReviewPersistenceInterface interface {
+	OpenEditorialReview(ctx context.Context, item EditorialReview) error
+
+	// [RO] Cele mai vechi întâi (termenul lor expiră primul)
+	ListPendingEditorialReviews(ctx context.Context, limit int) ([]EditorialReview, error)
+
+	// [RO] ErrReviewNotFound dacă articolul n-a fost oprit la verificare
+	RetrieveEditorialReview(ctx context.Context, articleID uuid.UUID) (*EditorialReview, error)
+
+	CloseEditorialReview(ctx context.Context, resolution EditorialResolution) error
+}
@@ -0,0 +1,74 @@
+package review
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+func TestAssessArticle_StopsContentiousAnalyses(t *testing.T) {
+	policy := ReviewPolicy{}
+
+	clear := article.NewsArticleEntity{TruthScore: 0.9, Geolocation: article.GaiaPoint{Intensity: 0.3}}
+	assessment := AssessArticle(clear, policy)
+	assert.Empty(t, assessment.Reasons)
+	assert.Equal(t, 48*time.Hour, assessment.Timeout, "[RO] Valorile zero devin cele implicite")
+	assert.Equal(t, TimeoutPublish, assessment.TimeoutAction)
+
+	contentious := article.NewsArticleEntity{
+		TruthScore:  0.55,
+		Geolocation: article.GaiaPoint{Intensity: 0.9},
+		Causes:      []article.CausalEventLink{{Confidence: 0.4}, {Confidence: 0.85}},
+		ReviewFlags: []string{article.ReviewReasonModelFlagged},
+	}
+	assert.Equal(t, []string{
+		article.ReviewReasonModelFlagged,
+		ReasonUncertainTruth,
+		ReasonHighEmotion,
+		ReasonHighImpactCausalLink,
+	}, AssessArticle(contentious, policy).Reasons)
+}
+
+func TestEditorialDecision_Validate(t *testing.T) {
+	score := 1.4
+	empty := "  "
+	summary := "Corrected summary."
+
+	assert.NoError(t, EditorialDecision{Action: DecisionApprove, Reviewer: "ana"}.Validate())
+	assert.Error(t, EditorialDecision{Action: DecisionApprove}.Validate(), "[RO] Fără editor")
+	assert.Error(t, EditorialDecision{Action: "publish", Reviewer: "ana"}.Validate())
+	assert.Error(t, EditorialDecision{Action: DecisionEdit, Reviewer: "ana"}.Validate(), "[RO] Edit fără corecturi")
+	assert.Error(t, EditorialDecision{Action: DecisionEdit, Reviewer: "ana", Edits: &EditorialEdits{TruthScore: &score}}.Validate())
+	assert.Error(t, EditorialDecision{Action: DecisionEdit, Reviewer: "ana", Edits: &EditorialEdits{Content: &empty}}.Validate())
+	assert.NoError(t, EditorialDecision{Action: DecisionEdit, Reviewer: "ana", Edits: &EditorialEdits{Summary: &summary}}.Validate())
+}
+
+func TestApplyDecision_AndTimeoutPolicy(t *testing.T) {
+	draft := article.NewsArticleEntity{Title: "Draft", Summary: "Model summary.", TruthScore: 0.5}
+	score := 0.7
+	title := "Edited"
+
+	published, status := ApplyDecision(draft, EditorialDecision{Action: DecisionEdit, Edits: &EditorialEdits{Title: &title, TruthScore: &score}})
+	require.NotNil(t, published)
+	assert.Equal(t, StatusEdited, status)
+	assert.Equal(t, "Edited", published.Title)
+	assert.Equal(t, 0.7, published.TruthScore)
+	assert.Equal(t, "Model summary.", published.Summary, "[RO] Câmpurile necorectate rămân ale modelului")
+
+	rejected, status := ApplyDecision(draft, EditorialDecision{Action: DecisionReject})
+	assert.Nil(t, rejected)
+	assert.Equal(t, StatusRejected, status)
+
+	timedOut, status := ResolveTimeout(draft, TimeoutPublish)
+	assert.NotNil(t, timedOut)
+	assert.Equal(t, StatusTimeoutPublished, status)
+
+	// [RO] Textul care a încercat să-și dicteze analiza nu se publică fără un om
+	draft.ReviewFlags = []string{article.ReviewReasonInjectionPattern}
+	timedOut, status = ResolveTimeout(draft, TimeoutPublish)
+	assert.Nil(t, timedOut)
+	assert.Equal(t, StatusTimeoutRejected, status)
+}
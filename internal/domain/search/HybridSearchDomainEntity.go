@@ -0,0 +1,241 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+// [RO] Limite Căutare
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 50
+	MaxPage         = 25
+	MinQueryLength  = 2
+	MaxQueryLength  = 256
+
+	// [RO] Constanta k din Reciprocal Rank Fusion (valoarea din articolul original, Cormack 2009)
+	DefaultFusionK = 60
+
+	// [RO] Câți candidați cerem fiecărei liste, peste pagina cerută (rangurile mici contează puțin)
+	candidateHeadroom = 20
+	MaxCandidates     = MaxPage*MaxPageSize + candidateHeadroom
+)
+
+// [RO] Marcajele evidențierii din fragmente (singurul HTML din fragment; restul textului e scăpat)
+const (
+	HighlightStart = "<mark>"
+	HighlightStop  = "</mark>"
+)
+
+// [RO] Listele de rang care pot contribui la un rezultat
+const (
+	MatchFullText = "full_text"
+	MatchSemantic = "semantic"
+)
+
+// [RO] Cererea de Căutare
+// Textul liber plus filtre opționale; valorile zero înseamnă "fără filtru".
+type SearchQuery struct {
+	Text          string
+	From          time.Time
+	To            time.Time
+	Source        string // Domeniul publicației (ex: "reuters.com")
+	MinTruthScore *float64
+	MaxTruthScore *float64
+	Emotion       string
+	Page          int // De la 1
+	PageSize      int
+}
+
+// [RO] Validare și Valori Implicite
+func (query *SearchQuery) Normalize() error {
+	query.Text = strings.Join(strings.Fields(query.Text), " ")
+	length := utf8.RuneCountInString(query.Text)
+	if length < MinQueryLength || length > MaxQueryLength {
+		return fmt.Errorf("[RO] Eroare: Textul căutării trebuie să aibă între %d și %d caractere.", MinQueryLength, MaxQueryLength)
+	}
+
+	if !query.From.IsZero() && !query.To.IsZero() && query.From.After(query.To) {
+		return fmt.Errorf("[RO] Eroare: Data de început este după data de sfârșit.")
+	}
+
+	for _, bound := range []*float64{query.MinTruthScore, query.MaxTruthScore} {
+		if bound != nil && (*bound < 0 || *bound > 1) {
+			return fmt.Errorf("[RO] Eroare: Scorul de adevăr trebuie să fie între 0 și 1.")
+		}
+	}
+	if query.MinTruthScore != nil && query.MaxTruthScore != nil && *query.MinTruthScore > *query.MaxTruthScore {
+		return fmt.Errorf("[RO] Eroare: Scorul minim este peste scorul maxim.")
+	}
+
+	query.Source = NormalizeSource(query.Source)
+	query.Emotion = strings.TrimSpace(query.Emotion)
+
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.Page > MaxPage {
+		return fmt.Errorf("[RO] Eroare: Se pot răsfoi cel mult %d pagini.", MaxPage)
+	}
+	if query.PageSize <= 0 || query.PageSize > MaxPageSize {
+		query.PageSize = DefaultPageSize
+	}
+	return nil
+}
+
+// [RO] Poziția primului rezultat al paginii
+func (query SearchQuery) Offset() int {
+	return (query.Page - 1) * query.PageSize
+}
+
+// [RO] Câți candidați trebuie să aducă fiecare listă pentru pagina cerută
+// Pagina următoare trebuie să existe deja în fuziune, ca `has_more` să fie corect.
+func (query SearchQuery) CandidateLimit() int {
+	limit := query.Offset() + query.PageSize + candidateHeadroom
+	if limit > MaxCandidates {
+		return MaxCandidates
+	}
+	return limit
+}
+
+// [RO] Domeniul Sursei
+// "https://www.Reuters.com/world" și "reuters.com" devin "reuters.com".
+func NormalizeSource(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if _, rest, found := strings.Cut(value, "://"); found {
+		value = rest
+	}
+	if end := strings.IndexAny(value, "/:?#"); end >= 0 {
+		value = value[:end]
+	}
+	return strings.TrimPrefix(value, "www.")
+}
+
+// [RO] Candidat dintr-o Listă de Rang
+// Rangul e poziția în lista ei (de la 1); fragmentul vine de la baza de date, deja evidențiat.
+type SearchCandidate struct {
+	Article article.NewsArticleEntity
+	Snippet string
+	Rank    int
+}
+
+// [RO] Rezultat de Căutare
+type SearchHit struct {
+	ArticleID     uuid.UUID `json:"article_id"`
+	Title         string    `json:"title"`
+	URL           string    `json:"url"`
+	Source        string    `json:"source"`
+	Snippet       string    `json:"snippet"`
+	TruthScore    float64   `json:"truth_score"`
+	GlobalEmotion string    `json:"emotion"`
+	PublishedAt   time.Time `json:"published_at"`
+	Score         float64   `json:"score"`
+	FullTextRank  int       `json:"full_text_rank,omitempty"`
+	SemanticRank  int       `json:"semantic_rank,omitempty"`
+	MatchedBy     []string  `json:"matched_by"`
+}
+
+// [RO] Pagina de Rezultate
+type SearchResultPage struct {
+	Query    string      `json:"query"`
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+	HasMore  bool        `json:"has_more"`
+	Semantic bool        `json:"semantic"` // false = vectorul întrebării nu a putut fi calculat
+	Results  []SearchHit `json:"results"`
+}
+
+// [RO] Reciprocal Rank Fusion
+//
+// Fiecare listă contribuie cu 1/(k + rang) pentru fiecare articol din ea; scorurile se adună.
+// Nu depinde de scara scorurilor (ts_rank vs. distanța cosine), doar de ordine.
+// La egalitate câștigă articolul mai nou, apoi ID-ul (ordinea trebuie să fie stabilă între pagini).
+func FuseReciprocalRank(fullText []SearchCandidate, semantic []SearchCandidate, k int) []SearchHit {
+	if k <= 0 {
+		k = DefaultFusionK
+	}
+
+	hits := map[uuid.UUID]*SearchHit{}
+	var order []uuid.UUID
+	accumulate := func(candidates []SearchCandidate, matchedBy string) {
+		for _, candidate := range candidates {
+			hit, seen := hits[candidate.Article.ID]
+			if !seen {
+				hit = newSearchHit(candidate)
+				hits[candidate.Article.ID] = hit
+				order = append(order, candidate.Article.ID)
+			}
+			// [RO] Fragmentul cu evidențieri bate fragmentul simplu al căutării semantice.
+			if !strings.Contains(hit.Snippet, HighlightStart) && strings.Contains(candidate.Snippet, HighlightStart) {
+				hit.Snippet = candidate.Snippet
+			}
+			hit.Score += 1.0 / float64(k+candidate.Rank)
+			hit.MatchedBy = append(hit.MatchedBy, matchedBy)
+			if matchedBy == MatchFullText {
+				hit.FullTextRank = candidate.Rank
+			} else {
+				hit.SemanticRank = candidate.Rank
+			}
+		}
+	}
+	accumulate(fullText, MatchFullText)
+	accumulate(semantic, MatchSemantic)
+
+	fused := make([]SearchHit, 0, len(order))
+	for _, id := range order {
+		fused = append(fused, *hits[id])
+	}
+	sort.SliceStable(fused, func(i, j int) bool {
+		if fused[i].Score != fused[j].Score {
+			return fused[i].Score > fused[j].Score
+		}
+		if !fused[i].PublishedAt.Equal(fused[j].PublishedAt) {
+			return fused[i].PublishedAt.After(fused[j].PublishedAt)
+		}
+		return fused[i].ArticleID.String() < fused[j].ArticleID.String()
+	})
+	return fused
+}
+
+func newSearchHit(candidate SearchCandidate) *SearchHit {
+	return &SearchHit{
+		ArticleID:     candidate.Article.ID,
+		Title:         candidate.Article.Title,
+		URL:           candidate.Article.OriginalURL,
+		Source:        NormalizeSource(candidate.Article.OriginalURL),
+		Snippet:       candidate.Snippet,
+		TruthScore:    candidate.Article.TruthScore,
+		GlobalEmotion: candidate.Article.GlobalEmotion,
+		PublishedAt:   candidate.Article.PublishedAt,
+	}
+}
+
+// [RO] Pagina cerută din lista fuzionată
+func Paginate(fused []SearchHit, query SearchQuery) ([]SearchHit, bool) {
+	start := query.Offset()
+	if start >= len(fused) {
+		return []SearchHit{}, false
+	}
+	end := start + query.PageSize
+	if end > len(fused) {
+		end = len(fused)
+	}
+	return fused[start:end], end < len(fused)
+}
+
+// [RO] Interfața de Persistență a Căutării
+// Fiecare metodă întoarce cel mult `limit` candidați, deja filtrați și ordonați după relevanța proprie.
+type SearchPersistenceInterface interface {
+	// [RO] Căutare Full-Text (tsvector peste titlu / rezumat / conținut)
+	SearchArticlesFullText(ctx context.Context, query SearchQuery, limit int) ([]SearchCandidate, error)
+
+	// [RO] Căutare Semantică (pgvector, distanța cosine); `query.Text` servește doar la fragmente
+	SearchArticlesSemantic(ctx context.Context, embedding []float32, query SearchQuery, limit int) ([]SearchCandidate, error)
+}
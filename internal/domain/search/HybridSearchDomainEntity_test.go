@@ -0,0 +1,76 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+func candidate(id uuid.UUID, rank int, snippet string) SearchCandidate {
+	return SearchCandidate{
+		Article: article.NewsArticleEntity{ID: id, OriginalURL: "https://www.reuters.com/world/" + id.String(), PublishedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		Snippet: snippet,
+		Rank:    rank,
+	}
+}
+
+func TestSearchQueryNormalize(t *testing.T) {
+	query := SearchQuery{Text: "  inflație   în  zona euro ", Source: "https://www.Reuters.com/markets", PageSize: 500}
+	require.NoError(t, query.Normalize())
+	assert.Equal(t, "inflație în zona euro", query.Text)
+	assert.Equal(t, "reuters.com", query.Source)
+	assert.Equal(t, 1, query.Page)
+	assert.Equal(t, DefaultPageSize, query.PageSize)
+	assert.Equal(t, DefaultPageSize+candidateHeadroom, query.CandidateLimit())
+
+	short := SearchQuery{Text: "a"}
+	assert.Error(t, short.Normalize())
+
+	low, high := 0.8, 0.2
+	inverted := SearchQuery{Text: "euro", MinTruthScore: &low, MaxTruthScore: &high}
+	assert.Error(t, inverted.Normalize())
+
+	outOfRange := 1.5
+	assert.Error(t, (&SearchQuery{Text: "euro", MinTruthScore: &outOfRange}).Normalize())
+}
+
+func TestFuseReciprocalRank_RewardsAgreementBetweenLists(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	// [RO] "b" e al doilea în ambele liste; "a" și "c" sunt primii, dar fiecare într-o singură listă.
+	fullText := []SearchCandidate{candidate(a, 1, "<mark>euro</mark> zone"), candidate(b, 2, "the <mark>euro</mark>")}
+	semantic := []SearchCandidate{candidate(c, 1, "currency union"), candidate(b, 2, "single currency")}
+
+	fused := FuseReciprocalRank(fullText, semantic, DefaultFusionK)
+	require.Len(t, fused, 3)
+	assert.Equal(t, b, fused[0].ArticleID)
+	assert.InDelta(t, 2.0/62.0, fused[0].Score, 1e-9)
+	assert.Equal(t, []string{MatchFullText, MatchSemantic}, fused[0].MatchedBy)
+	assert.Equal(t, 2, fused[0].FullTextRank)
+	assert.Equal(t, 2, fused[0].SemanticRank)
+	assert.Equal(t, "the <mark>euro</mark>", fused[0].Snippet, "[RO] Fragmentul evidențiat are prioritate")
+	assert.Equal(t, "reuters.com", fused[0].Source)
+}
+
+func TestPaginate(t *testing.T) {
+	var fused []SearchHit
+	for i := 0; i < 5; i++ {
+		fused = append(fused, SearchHit{ArticleID: uuid.New()})
+	}
+
+	page, hasMore := Paginate(fused, SearchQuery{Page: 2, PageSize: 2})
+	assert.Len(t, page, 2)
+	assert.True(t, hasMore)
+
+	page, hasMore = Paginate(fused, SearchQuery{Page: 3, PageSize: 2})
+	assert.Len(t, page, 1)
+	assert.False(t, hasMore)
+
+	page, _ = Paginate(fused, SearchQuery{Page: 4, PageSize: 2})
+	assert.NotNil(t, page)
+	assert.Empty(t, page)
+}
@@ -0,0 +1,150 @@
+package trend
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Tipuri de Serii Urmărite
+const (
+	KindEntity       = "entity"        // Mențiuni ale unei entități canonice
+	KindStoryCluster = "story_cluster" // Articole dintr-un grup de poveste
+)
+
+// [RO] Parametrii Detectorului (EWMA + z-score)
+const (
+	DefaultSmoothing    = 0.1 // Ponderea orei celei mai recente în medie (α)
+	DefaultZThreshold   = 3.0 // De câte deviații standard peste medie e un "breaking"
+	DefaultMinCount     = 5   // Sub atâtea articole pe oră nu există vârf, oricât de mic e istoricul
+	DefaultHistoryHours = 7 * 24
+
+	// [RO] Deviația minimă: o serie aproape nulă nu transformă 2 articole într-un z de 50.
+	minStdDev = 1.0
+)
+
+// [RO] Fereastra curentă și pauza dintre semnale pentru aceeași serie
+const (
+	DetectionWindow = time.Hour
+	SignalCooldown  = 6 * time.Hour
+)
+
+// [RO] Seria de Volum a unei Chei
+// Numărul de articole pe oră: istoricul (cel mai vechi primul, cu zerouri) și ora curentă.
+type VolumeSeries struct {
+	Kind    string
+	Key     string // ID-ul entității sau al grupului
+	Label   string // Numele canonic sau titlul cel mai recent
+	History []int
+	Current int
+}
+
+// [RO] Semnal de Trend ("Breaking")
+type TrendSignal struct {
+	ID          uuid.UUID `json:"id"`
+	Kind        string    `json:"kind"`
+	Key         string    `json:"key"`
+	Label       string    `json:"label"`
+	Count       int       `json:"count"`
+	Baseline    float64   `json:"baseline"`
+	StdDev      float64   `json:"std_dev"`
+	ZScore      float64   `json:"z_score"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+	DetectedAt  time.Time `json:"detected_at"`
+}
+
+// [RO] Detector de Vârfuri
+type SpikeDetector struct {
+	Smoothing  float64
+	ZThreshold float64
+	MinCount   int
+}
+
+// [RO] Detector cu valorile implicite
+func NewSpikeDetector() SpikeDetector {
+	return SpikeDetector{Smoothing: DefaultSmoothing, ZThreshold: DefaultZThreshold, MinCount: DefaultMinCount}
+}
+
+// [RO] Linia de Bază (EWMA)
+// Media și deviația standard ponderate exponențial peste istoric (orele recente contează mai mult).
+func (detector SpikeDetector) Baseline(history []int) (float64, float64) {
+	if len(history) == 0 {
+		return 0, minStdDev
+	}
+	mean, variance := float64(history[0]), 0.0
+	for _, value := range history[1:] {
+		diff := float64(value) - mean
+		increment := detector.Smoothing * diff
+		mean += increment
+		variance = (1 - detector.Smoothing) * (variance + diff*increment)
+	}
+	return mean, math.Max(math.Sqrt(variance), minStdDev)
+}
+
+// [RO] Evaluează o Serie
+// Returnează semnalul dacă ora curentă depășește pragul absolut și pragul z.
+func (detector SpikeDetector) Evaluate(series VolumeSeries, windowEnd time.Time) (TrendSignal, bool) {
+	if series.Current < detector.MinCount {
+		return TrendSignal{}, false
+	}
+	mean, stdDev := detector.Baseline(series.History)
+	zScore := (float64(series.Current) - mean) / stdDev
+	if zScore < detector.ZThreshold {
+		return TrendSignal{}, false
+	}
+	return TrendSignal{
+		ID:          uuid.New(),
+		Kind:        series.Kind,
+		Key:         series.Key,
+		Label:       series.Label,
+		Count:       series.Current,
+		Baseline:    mean,
+		StdDev:      stdDev,
+		ZScore:      zScore,
+		WindowStart: windowEnd.Add(-DetectionWindow),
+		WindowEnd:   windowEnd,
+		DetectedAt:  windowEnd,
+	}, true
+}
+
+// [RO] Detectează Vârfurile
+// Seriile aflate încă în pauză (`recent`: semnale emise în ultimele SignalCooldown) sunt sărite.
+// Rezultatul este ordonat descrescător după z-score.
+func (detector SpikeDetector) Detect(series []VolumeSeries, recent []TrendSignal, windowEnd time.Time) []TrendSignal {
+	cooling := map[string]bool{}
+	for _, signal := range recent {
+		cooling[signal.Kind+"/"+signal.Key] = true
+	}
+
+	var signals []TrendSignal
+	for _, candidate := range series {
+		if cooling[candidate.Kind+"/"+candidate.Key] {
+			continue
+		}
+		if signal, ok := detector.Evaluate(candidate, windowEnd); ok {
+			signals = append(signals, signal)
+		}
+	}
+	sort.SliceStable(signals, func(i, j int) bool { return signals[i].ZScore > signals[j].ZScore })
+	return signals
+}
+
+// [RO] Interfața Semnalelor de Trend (Postgres)
+type TrendPersistenceInterface interface {
+	// [RO] Seriile cheilor cu cel puțin `minCount` articole în ora încheiată la `windowEnd`
+	RetrieveVolumeSeries(ctx context.Context, kind string, windowEnd time.Time, historyHours int, minCount int) ([]VolumeSeries, error)
+
+	SaveTrendSignals(ctx context.Context, signals []TrendSignal) error
+
+	// [RO] Semnalele detectate după `since`, cele mai noi primele
+	RetrieveRecentTrendSignals(ctx context.Context, since time.Time, limit int) ([]TrendSignal, error)
+}
+
+// [RO] Publicarea Semnalelor (NATS `trend.detected`)
+type TrendPublisherInterface interface {
+	PublishTrendDetected(ctx context.Context, signal TrendSignal) error
+}
@@ -0,0 +1,58 @@
+package trend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testWindowEnd = time.Date(2026, 3, 21, 10, 0, 0, 0, time.UTC)
+
+func steadyHistory(value int) []int {
+	history := make([]int, DefaultHistoryHours)
+	for i := range history {
+		history[i] = value + i%3 // [RO] Zgomot mic: 4, 5, 6, 4, 5, 6...
+	}
+	return history
+}
+
+func TestSpikeDetector_Evaluate(t *testing.T) {
+	detector := NewSpikeDetector()
+
+	// [RO] Volum constant în jur de 5/oră: 6 nu e un vârf, 30 este.
+	_, spiking := detector.Evaluate(VolumeSeries{Kind: KindEntity, Key: "e1", History: steadyHistory(4), Current: 6}, testWindowEnd)
+	assert.False(t, spiking)
+
+	signal, spiking := detector.Evaluate(VolumeSeries{Kind: KindEntity, Key: "e1", Label: "NATO", History: steadyHistory(4), Current: 30}, testWindowEnd)
+	require.True(t, spiking)
+	assert.Equal(t, "NATO", signal.Label)
+	assert.InDelta(t, 5, signal.Baseline, 0.5)
+	assert.Greater(t, signal.ZScore, DefaultZThreshold)
+	assert.Equal(t, testWindowEnd.Add(-time.Hour), signal.WindowStart)
+
+	// [RO] Sub pragul absolut nu există vârf, chiar dacă istoricul e zero.
+	_, spiking = detector.Evaluate(VolumeSeries{History: make([]int, 24), Current: 4}, testWindowEnd)
+	assert.False(t, spiking)
+
+	// [RO] Poveste nouă cu 8 articole în prima oră: deviația minimă ține z-ul finit.
+	signal, spiking = detector.Evaluate(VolumeSeries{History: make([]int, 24), Current: 8}, testWindowEnd)
+	require.True(t, spiking)
+	assert.Equal(t, 8.0, signal.ZScore)
+}
+
+func TestSpikeDetector_DetectSkipsCoolingSeriesAndSortsByZ(t *testing.T) {
+	detector := NewSpikeDetector()
+	series := []VolumeSeries{
+		{Kind: KindEntity, Key: "small", History: steadyHistory(4), Current: 20},
+		{Kind: KindEntity, Key: "big", History: steadyHistory(4), Current: 60},
+		{Kind: KindStoryCluster, Key: "cooling", History: steadyHistory(4), Current: 60},
+	}
+	recent := []TrendSignal{{Kind: KindStoryCluster, Key: "cooling"}}
+
+	signals := detector.Detect(series, recent, testWindowEnd)
+	require.Len(t, signals, 2)
+	assert.Equal(t, "big", signals[0].Key)
+	assert.Equal(t, "small", signals[1].Key)
+}
@@ -0,0 +1,215 @@
+package dgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourorg/truthweave/internal/domain/graph"
+)
+
+// [RO] Câte evenimente cerem într-o pagină de export.
+const exportPageSize = 200
+
+// [RO] Forma unui eveniment în răspunsul de export
+type exportEventDTO struct {
+	Uid        string    `json:"uid"`
+	EventID    string    `json:"event.id"`
+	Summary    string    `json:"event.summary"`
+	Timestamp  time.Time `json:"event.timestamp"`
+	TrustScore float64   `json:"event.trust_score"`
+	CausedBy   []struct {
+		Uid       string    `json:"uid"`
+		EventID   string    `json:"event.id"`
+		Summary   string    `json:"event.summary"`
+		Timestamp time.Time `json:"event.timestamp"`
+	} `json:"event.caused_by"`
+	ReportedBy []struct {
+		Uid         string    `json:"uid"`
+		URL         string    `json:"url"`
+		Title       string    `json:"title"`
+		PublishedAt time.Time `json:"published_at"`
+		TruthScore  float64   `json:"truth_score"`
+		Mentions    []struct {
+			Uid         string `json:"uid"`
+			EntityID    string `json:"entity.id"`
+			Name        string `json:"name"`
+			Type        string `json:"type"`
+			WikidataQID string `json:"entity.wikidata_qid"`
+		} `json:"mentioned_entities"`
+	} `json:"event.reported_by"`
+}
+
+// [RO] Export Subgraf (Implementare)
+// Pagină cu pagină (offset), ordonat cronologic, până la plafonul de evenimente.
+// Fiecare pagină conține evenimentele, cauzele lor directe, articolele sursă și entitățile menționate.
+func (repo *DgraphKnowledgeGraphRepository) StreamExportSubgraph(ctx context.Context, filter graph.ExportFilter, emit func(page graph.SubgraphPage) error) error {
+	query, vars := buildExportQuery(filter)
+
+	for offset := 0; offset < filter.MaxEvents; offset += exportPageSize {
+		pageSize := exportPageSize
+		if remaining := filter.MaxEvents - offset; remaining < pageSize {
+			pageSize = remaining
+		}
+		vars["$first"] = strconv.Itoa(pageSize)
+		vars["$offset"] = strconv.Itoa(offset)
+
+		resp, err := repo.graphClient.NewReadOnlyTxn().QueryWithVars(ctx, query, vars)
+		if err != nil {
+			return fmt.Errorf("failed to query export page: %w", err)
+		}
+
+		var root struct {
+			Events []exportEventDTO `json:"events"`
+		}
+		if err := json.Unmarshal(resp.Json, &root); err != nil {
+			return err
+		}
+
+		if err := emit(exportPageFromEvents(root.Events)); err != nil {
+			return err
+		}
+		if len(root.Events) < pageSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// [RO] Construiește interogarea de export
+// Datele externe (ID-uri, date) intră doar ca variabile. Adâncimea lanțului cauzal este un întreg
+// validat, "desfăcut" în blocuri var (câte unul pe nivel, în sus pe cauze și în jos pe consecințe).
+func buildExportQuery(filter graph.ExportFilter) (string, map[string]string) {
+	params := []string{"$first: int", "$offset: int"}
+	vars := map[string]string{}
+	var blocks, seeds, conditions []string
+
+	chain := func(prefix string, seedBlock string) {
+		blocks = append(blocks, seedBlock,
+			fmt.Sprintf("var(func: uid(%sRoot)) { %sUp1 as event.caused_by %sDown1 as ~event.caused_by }", prefix, prefix, prefix))
+		members := []string{prefix + "Root"}
+		for level := 1; level <= filter.Depth; level++ {
+			up, down := fmt.Sprintf("%sUp%d", prefix, level), fmt.Sprintf("%sDown%d", prefix, level)
+			members = append(members, up, down)
+			if level < filter.Depth {
+				blocks = append(blocks,
+					fmt.Sprintf("var(func: uid(%s)) { %sUp%d as event.caused_by }", up, prefix, level+1),
+					fmt.Sprintf("var(func: uid(%s)) { %sDown%d as ~event.caused_by }", down, prefix, level+1),
+				)
+			}
+		}
+		seeds = append(seeds, "uid("+strings.Join(members, ", ")+")")
+	}
+
+	if filter.RootEventID != "" {
+		params = append(params, "$root: string")
+		vars["$root"] = filter.RootEventID
+		chain("root", "var(func: eq(event.id, $root)) { rootRoot as uid }")
+	}
+	if filter.NarrativeID != "" {
+		params = append(params, "$narrative: string")
+		vars["$narrative"] = filter.NarrativeID
+		chain("narrative", "var(func: eq(narrative.id, $narrative)) { narrativeRoot as narrative.root_event }")
+	}
+	if filter.EntityID != "" {
+		params = append(params, "$entity: string")
+		vars["$entity"] = filter.EntityID
+		blocks = append(blocks, "var(func: eq(entity.id, $entity)) { ~mentioned_entities { entityEvents as ~event.reported_by } }")
+		seeds = append(seeds, "uid(entityEvents)")
+	}
+	if !filter.From.IsZero() {
+		params = append(params, "$from: string")
+		vars["$from"] = filter.From.UTC().Format(time.RFC3339)
+		conditions = append(conditions, "ge(event.timestamp, $from)")
+	}
+	if !filter.To.IsZero() {
+		params = append(params, "$to: string")
+		vars["$to"] = filter.To.UTC().Format(time.RFC3339)
+		conditions = append(conditions, "le(event.timestamp, $to)")
+	}
+
+	rootFunc := "has(event.id)"
+	if len(seeds) > 0 {
+		rootFunc, seeds = seeds[0], seeds[1:]
+	}
+	conditions = append(seeds, conditions...)
+
+	filterClause := ""
+	if len(conditions) > 0 {
+		filterClause = " @filter(" + strings.Join(conditions, " AND ") + ")"
+	}
+
+	var query strings.Builder
+	query.WriteString("query q(" + strings.Join(params, ", ") + ") {\n")
+	for _, block := range blocks {
+		query.WriteString("\t" + block + "\n")
+	}
+	query.WriteString(fmt.Sprintf(`	events(func: %s, orderasc: event.timestamp, first: $first, offset: $offset)%s {
+		uid
+		event.id
+		event.summary
+		event.timestamp
+		event.trust_score
+		event.caused_by { uid event.id event.summary event.timestamp }
+		event.reported_by {
+			uid
+			url
+			title
+			published_at
+			truth_score
+			mentioned_entities @filter(NOT has(entity.merged_into)) { uid entity.id name type entity.wikidata_qid }
+		}
+	}
+}`, rootFunc, filterClause))
+
+	return query.String(), vars
+}
+
+// [RO] Conversie pagină Dgraph -> noduri și muchii
+func exportPageFromEvents(events []exportEventDTO) graph.SubgraphPage {
+	var page graph.SubgraphPage
+
+	for _, ev := range events {
+		page.Nodes = append(page.Nodes, graph.Node{
+			ID: ev.Uid, Kind: graph.KindEvent, Key: ev.EventID, Label: ev.Summary, Timestamp: ev.Timestamp, Score: ev.TrustScore,
+		})
+
+		for _, cause := range ev.CausedBy {
+			page.Nodes = append(page.Nodes, graph.Node{
+				ID: cause.Uid, Kind: graph.KindEvent, Key: cause.EventID, Label: cause.Summary, Timestamp: cause.Timestamp,
+			})
+			page.Edges = append(page.Edges, graph.Edge{Source: ev.Uid, Target: cause.Uid, Relation: graph.RelationCausedBy})
+		}
+
+		for _, art := range ev.ReportedBy {
+			page.Nodes = append(page.Nodes, graph.Node{
+				ID: art.Uid, Kind: graph.KindArticle, Key: art.URL, Label: art.Title, Timestamp: art.PublishedAt, Score: art.TruthScore,
+			})
+			page.Edges = append(page.Edges, graph.Edge{Source: ev.Uid, Target: art.Uid, Relation: graph.RelationReportedBy})
+
+			for _, ent := range art.Mentions {
+				page.Nodes = append(page.Nodes, graph.Node{
+					ID: ent.Uid, Kind: exportEntityKind(ent.Type), Key: ent.EntityID, Label: ent.Name, WikidataQID: ent.WikidataQID,
+				})
+				page.Edges = append(page.Edges, graph.Edge{Source: art.Uid, Target: ent.Uid, Relation: graph.RelationMentions})
+			}
+		}
+	}
+	return page
+}
+
+func exportEntityKind(entityType string) string {
+	switch entityType {
+	case "Person":
+		return graph.KindPerson
+	case "Organization":
+		return graph.KindOrganization
+	case "Place", "Location":
+		return graph.KindPlace
+	default:
+		return graph.KindEntity
+	}
+}
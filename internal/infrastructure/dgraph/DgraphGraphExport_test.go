@@ -0,0 +1,39 @@
+package dgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yourorg/truthweave/internal/domain/graph"
+)
+
+func TestBuildExportQuery_IntersectsFiltersThroughVariables(t *testing.T) {
+	query, vars := buildExportQuery(graph.ExportFilter{
+		RootEventID: hostileName,
+		EntityID:    "ent-1",
+		From:        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Depth:       2,
+	})
+
+	assert.NotContains(t, query, "Evil")
+	assert.Equal(t, hostileName, vars["$root"])
+	assert.Equal(t, "2026-01-01T00:00:00Z", vars["$from"])
+
+	// [RO] Lanțul cauzal e desfăcut pe 2 niveluri, în ambele sensuri.
+	assert.Contains(t, query, "rootUp2 as event.caused_by")
+	assert.Contains(t, query, "rootDown2 as ~event.caused_by")
+	assert.NotContains(t, query, "rootUp3")
+
+	// [RO] Prima sursă este rădăcina; restul devin condiții de intersecție.
+	assert.Contains(t, query, "events(func: uid(rootRoot, rootUp1, rootDown1, rootUp2, rootDown2)")
+	assert.Contains(t, query, "@filter(uid(entityEvents) AND ge(event.timestamp, $from))")
+}
+
+func TestBuildExportQuery_WholeGraphWithoutFilters(t *testing.T) {
+	query, vars := buildExportQuery(graph.ExportFilter{Depth: 1})
+
+	assert.Contains(t, query, "events(func: has(event.id), orderasc: event.timestamp, first: $first, offset: $offset) {")
+	assert.Empty(t, vars)
+}
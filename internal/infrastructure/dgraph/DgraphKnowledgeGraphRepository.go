@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt" // "fmt" was used in original
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgo/v240"
-	"github.com/dgraph-io/dgo/v240/protos/api"
-	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/causality"
+	"github.com/yourorg/truthweave/internal/domain/entity"
 )
 
 // [RO] Depozit Graf de Cunoștințe (Dgraph)
@@ -16,293 +18,413 @@ import (
 // Această componentă gestionează "Creierul Asociativ" al aplicației.
 // Spre deosebire de Postgres (care e ca un tabel Excel), Dgraph funcționează ca o rețea neuronală,
 // legând concepte între ele (ex: "Articolul A" -> menționează "Persoana X" -> care apare și în "Articolul B").
+//
+// Toate scrierile trec prin blocuri upsert (interogare + mutație condiționată într-o singură cerere),
+// vezi DgraphUpsertMutations.go.
 type DgraphKnowledgeGraphRepository struct {
 	graphClient *dgo.Dgraph
+
+	// [RO] Executorul cererilor upsert (înlocuibil în teste)
+	upserts upsertExecutor
 }
 
 // [RO] Constructor Graf
 func NewDgraphKnowledgeGraphRepository(client *dgo.Dgraph) *DgraphKnowledgeGraphRepository {
-	return &DgraphKnowledgeGraphRepository{graphClient: client}
+	return &DgraphKnowledgeGraphRepository{
+		graphClient: client,
+		upserts:     dgraphTransactionExecutor{graphClient: client},
+	}
 }
 
-// [RO] Salvează Știrea în Graf (Implementare)
-//
-// Această metodă nu doar salvează textul, ci creează "noduri" și "muchii" în graf.
-// Dacă articolul menționează "București", sistemul va crea (sau refolosi) nodul "București"
-// și va trage o linie între Articol și Oraș.
-func (repo *DgraphKnowledgeGraphRepository) SaveNewsArticleToGraph(executionContext context.Context, newsArticle *article.NewsArticleEntity) error {
-	transaction := repo.graphClient.NewTxn()
-	defer transaction.Discard(executionContext)
-
-	// [RO] Pasul 1: Gestionarea Entităților Menționate
-	// Înainte să salvăm articolul, ne asigurăm că toate persoanele/locurile menționate
-	// au un ID în graf. Dacă nu există, le creăm.
-	entityUIDs := make([]map[string]string, 0)
-
-	for _, ent := range newsArticle.Mentions {
-		// Încercăm să găsim entitatea existentă
-		query := `query q($name: string) {
-			ents(func: eq(name, $name)) {
-				uid
-			}
-		}`
-
-		response, err := transaction.QueryWithVars(executionContext, query, map[string]string{"$name": ent.Name})
-		if err != nil {
-			return fmt.Errorf("failed to query entity %s: %w", ent.Name, err)
+// [RO] Verifică Existența (Pentru Graf)
+// Deși verificăm în Postgres, uneori e util să verificăm direct în graf.
+func (repo *DgraphKnowledgeGraphRepository) CheckIfArticleExistsInGraph(executionContext context.Context, url string) (bool, error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query val($url: string) {
+		cnt(func: eq(url, $url)) {
+			count(uid)
 		}
+	}`
 
-		var root struct {
-			Ents []struct {
-				Uid string `json:"uid"`
-			} `json:"ents"`
-		}
-		if err := json.Unmarshal(response.Json, &root); err != nil {
-			return err
-		}
+	resp, err := transaction.QueryWithVars(executionContext, query, map[string]string{"$url": url})
+	if err != nil {
+		return false, err
+	}
+
+	var root struct {
+		Cnt []struct {
+			Count int `json:"count"`
+		} `json:"cnt"`
+	}
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return false, err
+	}
+	return len(root.Cnt) > 0 && root.Cnt[0].Count > 0, nil
+}
 
-		var uid string
-		if len(root.Ents) > 0 {
-			uid = root.Ents[0].Uid
-		} else {
-			// [RO] Creare Nod Nou
-			// Dacă entitatea nu există, o creăm acum.
-			nquads := fmt.Sprintf(`_:new <name> "%s" . _:new <type> "%s" .`, ent.Name, ent.Type)
-			mutation := &api.Mutation{SetNquads: []byte(nquads)}
-			assigned, err := transaction.Mutate(executionContext, mutation)
-			if err != nil {
-				return err
+// [RO] Evenimentele Raportate de un Articol (event.reported_by)
+// Mai multe ID-uri înseamnă noduri vechi (ID aleator) rămase lângă evenimentul poveștii.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveArticleEventIDs(ctx context.Context, articleURL string) ([]string, error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($url: string) {
+		art(func: eq(url, $url), first: 1) {
+			~event.reported_by {
+				event.id
 			}
-			uid = assigned.Uids["new"]
 		}
+	}`
 
-		entityUIDs = append(entityUIDs, map[string]string{"uid": uid})
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$url": articleURL})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query article events: %w", err)
 	}
 
-	// [RO] Pasul 2: Salvarea Articolului și a Legăturilor
+	var root struct {
+		Art []struct {
+			Events []struct {
+				EventID string `json:"event.id"`
+			} `json:"~event.reported_by"`
+		} `json:"art"`
+	}
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return nil, err
+	}
 
-	// Definim structura pentru serializare JSON (DTO) compatibilă cu Dgraph
-	type ArticleGraphDTO struct {
-		Uid         string              `json:"uid,omitempty"`
-		URL         string              `json:"url"`
-		Title       string              `json:"title"`
-		Score       float64             `json:"truth_score"`
-		Mentions    []map[string]string `json:"mentioned_entities"`
-		ArweaveID   string              `json:"arweave_tx_id"`
-		SolanaSig   string              `json:"solana_signature"`
-		PublishedAt string              `json:"published_at"`
-		DType       []string            `json:"dgraph.type,omitempty"`
+	var eventIDs []string
+	for _, art := range root.Art {
+		for _, ev := range art.Events {
+			if ev.EventID != "" {
+				eventIDs = append(eventIDs, ev.EventID)
+			}
+		}
 	}
+	return eventIDs, nil
+}
 
-	// Căutăm dacă articolul există deja în graf (pentru update)
-	qArticle := `query q($url: string) {
-		arts(func: eq(url, $url)) {
-			uid
+// [RO] Evenimente Fără Sursă (Pentru Migrare)
+// Evenimentele vechi, create înainte de event.reported_by, care nu sunt legate de niciun articol.
+// Evenimentele poveștilor au mereu event.story_cluster (iar cele fără potrivire primesc "orphan"),
+// deci rămân doar nodurile cu ID aleator, încă nemigrate.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveUnlinkedCausalEvents(ctx context.Context, limit int) ([]causality.CausalEvent[string], error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($limit: int) {
+		events(func: has(event.id), first: $limit) @filter(NOT has(event.reported_by) AND NOT has(event.story_cluster)) {
+			event.id
+			event.summary
+			event.timestamp
 		}
 	}`
-	res, err := transaction.QueryWithVars(executionContext, qArticle, map[string]string{"$url": newsArticle.OriginalURL})
+
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$limit": strconv.Itoa(limit)})
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to query unlinked events: %w", err)
 	}
 
-	var artRoot struct {
-		Arts []struct {
-			Uid string `json:"uid"`
-		} `json:"arts"`
+	var root struct {
+		Events []struct {
+			EventID   string    `json:"event.id"`
+			Summary   string    `json:"event.summary"`
+			Timestamp time.Time `json:"event.timestamp"`
+		} `json:"events"`
 	}
-	json.Unmarshal(res.Json, &artRoot)
-
-	var articleUID string
-	if len(artRoot.Arts) > 0 {
-		articleUID = artRoot.Arts[0].Uid
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return nil, err
 	}
 
-	dto := ArticleGraphDTO{
-		Uid:         articleUID,
-		URL:         newsArticle.OriginalURL,
-		Title:       newsArticle.Title,
-		Score:       newsArticle.TruthScore,
-		Mentions:    entityUIDs, // Aici facem legătura fizică în graf!
-		ArweaveID:   newsArticle.ArweaveTransactionID,
-		SolanaSig:   newsArticle.SolanaSignature,
-		PublishedAt: newsArticle.PublishedAt.Format(time.RFC3339),
-		DType:       []string{"Article"},
+	events := make([]causality.CausalEvent[string], 0, len(root.Events))
+	for _, ev := range root.Events {
+		events = append(events, causality.CausalEvent[string]{
+			ID:        causality.EventID(ev.EventID),
+			Timestamp: ev.Timestamp,
+			Summary:   ev.Summary,
+		})
 	}
+	return events, nil
+}
 
-	jsonData, err := json.Marshal(dto)
+// [RO] Citește Rezumatul unui Eveniment
+// Folosit de rebalansarea retroactivă pentru a trimite AI-ului textul real al evenimentului țintă.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveCausalEventSummary(ctx context.Context, eventID string) (string, error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($id: string) {
+		ev(func: eq(event.id, $id)) {
+			event.summary
+		}
+	}`
+
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$id": eventID})
 	if err != nil {
-		return err
+		return "", fmt.Errorf("failed to query event summary: %w", err)
 	}
 
-	mutation := &api.Mutation{
-		SetJson:   jsonData,
-		CommitNow: true, // Salvăm totul atomic (totul sau nimic)
+	var root struct {
+		Ev []struct {
+			Summary string `json:"event.summary"`
+		} `json:"ev"`
+	}
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return "", err
+	}
+	if len(root.Ev) == 0 {
+		return "", fmt.Errorf("event %s: %w", eventID, causality.ErrEventNotFound)
 	}
+	return root.Ev[0].Summary, nil
+}
+
+// [RO] Verifică o Legătură Cauzală
+// true dacă evenimentul copil are muchia `event.caused_by` spre părinte.
+func (repo *DgraphKnowledgeGraphRepository) HasCausalEdge(ctx context.Context, parentID string, childID string) (bool, error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($pid: string, $cid: string) {
+		child(func: eq(event.id, $cid), first: 1) {
+			event.caused_by @filter(eq(event.id, $pid)) {
+				event.id
+			}
+		}
+	}`
 
-	_, err = transaction.Mutate(executionContext, mutation)
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$pid": parentID, "$cid": childID})
 	if err != nil {
-		return fmt.Errorf("failed to save article graph: %w", err)
+		return false, fmt.Errorf("failed to query causal edge: %w", err)
 	}
 
-	return nil
+	var root struct {
+		Child []struct {
+			CausedBy []struct {
+				EventID string `json:"event.id"`
+			} `json:"event.caused_by"`
+		} `json:"child"`
+	}
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return false, err
+	}
+	return len(root.Child) > 0 && len(root.Child[0].CausedBy) > 0, nil
 }
 
-// [RO] Verifică Existența (Pentru Graf)
-// Deși verificăm în Postgres, uneori e util să verificăm direct în graf.
-func (repo *DgraphKnowledgeGraphRepository) CheckIfArticleExistsInGraph(executionContext context.Context, url string) (bool, error) {
+// [RO] Data unui Eveniment
+// causality.ErrEventNotFound dacă evenimentul nu este în graf.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveCausalEventTimestamp(ctx context.Context, eventID string) (time.Time, error) {
 	transaction := repo.graphClient.NewReadOnlyTxn()
-	const query = `query val($url: string) {
-		cnt(func: eq(url, $url)) {
-			count(uid)
+	const query = `query q($id: string) {
+		ev(func: eq(event.id, $id)) {
+			event.timestamp
 		}
 	}`
 
-	resp, err := transaction.QueryWithVars(executionContext, query, map[string]string{"$url": url})
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$id": eventID})
 	if err != nil {
-		return false, err
+		return time.Time{}, fmt.Errorf("failed to query event timestamp: %w", err)
 	}
 
 	var root struct {
-		Cnt []struct {
-			Count int `json:"count"`
-		} `json:"cnt"`
+		Ev []struct {
+			Timestamp time.Time `json:"event.timestamp"`
+		} `json:"ev"`
 	}
 	if err := json.Unmarshal(resp.Json, &root); err != nil {
-		return false, err
+		return time.Time{}, err
 	}
-	return len(root.Cnt) > 0 && root.Cnt[0].Count > 0, nil
+	if len(root.Ev) == 0 {
+		return time.Time{}, fmt.Errorf("event %s: %w", eventID, causality.ErrEventNotFound)
+	}
+	return root.Ev[0].Timestamp, nil
 }
 
-// [RO] Creează Muchie Cauzală
-// Stabilește o legătură de tip "caused_by" între două evenimente (articole).
-func (repo *DgraphKnowledgeGraphRepository) CreateCausalEdge(executionContext context.Context, parentID string, childID string, relationType string) error {
-	// Presupunem că ID-urile sunt UUID-uri pe care le mapăm la UIDs interne.
-	// În Dgraph schema avem 'event.id' exact index.
-
-	// 1. Găsim UIDs interne pentru Parent și Child
-	query := `query q($pid: string, $cid: string) {
-		parent(func: eq(event.id, $pid)) {
-			uid
-		}
-		child(func: eq(event.id, $cid)) {
-			uid
+// [RO] Evenimente Recente (Candidați pentru Cauzalitate)
+// Returnează evenimentele din intervalul [since, until], cele mai noi primele, excluzând
+// evenimentul țintă. `until` este data țintei: o cauză nu poate apărea după efect.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveRecentCausalEvents(ctx context.Context, since time.Time, until time.Time, excludeEventID string, limit int) ([]causality.CausalEvent[string], error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($since: string, $until: string, $exclude: string, $limit: int) {
+		events(func: ge(event.timestamp, $since), orderdesc: event.timestamp, first: $limit) @filter(le(event.timestamp, $until) AND NOT eq(event.id, $exclude)) {
+			event.id
+			event.summary
+			event.timestamp
 		}
 	}`
 
-	transaction := repo.graphClient.NewTxn()
-	// Nota: Nu facem defer Discard imediat pentru că vrem CommitNow sau manual commit.
-	// Dar e safe să facem defer Discard, commit va face discard no-op.
-	defer transaction.Discard(executionContext)
-
-	resp, err := transaction.QueryWithVars(executionContext, query, map[string]string{
-		"$pid": parentID,
-		"$cid": childID,
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{
+		"$since":   since.Format(time.RFC3339),
+		"$until":   until.Format(time.RFC3339),
+		"$exclude": excludeEventID,
+		"$limit":   strconv.Itoa(limit),
 	})
 	if err != nil {
-		return fmt.Errorf("failed to query UIDs for causal edge: %w", err)
+		return nil, fmt.Errorf("failed to query recent events: %w", err)
 	}
 
 	var root struct {
-		Parent []struct {
-			Uid string `json:"uid"`
-		} `json:"parent"`
-		Child []struct {
-			Uid string `json:"uid"`
-		} `json:"child"`
+		Events []struct {
+			EventID   string    `json:"event.id"`
+			Summary   string    `json:"event.summary"`
+			Timestamp time.Time `json:"event.timestamp"`
+		} `json:"events"`
 	}
 	if err := json.Unmarshal(resp.Json, &root); err != nil {
-		return err
+		return nil, err
 	}
 
-	if len(root.Parent) == 0 || len(root.Child) == 0 {
-		return fmt.Errorf("parent or child event not found in graph")
+	events := make([]causality.CausalEvent[string], 0, len(root.Events))
+	for _, ev := range root.Events {
+		events = append(events, causality.CausalEvent[string]{
+			ID:        causality.EventID(ev.EventID),
+			Timestamp: ev.Timestamp,
+			Summary:   ev.Summary,
+			Payload:   ev.Summary,
+		})
 	}
+	return events, nil
+}
 
-	parentUid := root.Parent[0].Uid
-	childUid := root.Child[0].Uid
-
-	// 2. Creăm muchia (Child --[caused_by]--> Parent)
-	// Adăugăm și fațete pe muchie (relația, greutatea) dacă e suportat,
-	// momentan simplu link.
-	nquad := fmt.Sprintf(`<%s> <event.caused_by> <%s> .`, childUid, parentUid)
+// [RO] Candidați prin Trigram (Rezolvare Entități)
+// Folosește indexul trigram de pe `name` (potrivire fuzzy) pentru a găsi entitățile canonice
+// de același tip cu nume asemănător. Scorul final este calculat de serviciul de rezolvare.
+func (repo *DgraphKnowledgeGraphRepository) FindEntityCandidatesByTrigram(ctx context.Context, name string, entityType string, limit int) ([]entity.EntityCandidate, error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($name: string, $type: string, $limit: int) {
+		ents(func: match(name, $name, 8), first: $limit) @filter(eq(type, $type) AND has(entity.id) AND NOT has(entity.merged_into)) {
+			entity.id
+			name
+			type
+		}
+	}`
 
-	mutation := &api.Mutation{
-		SetNquads: []byte(nquad),
-		CommitNow: true,
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{
+		"$name":  name,
+		"$type":  entityType,
+		"$limit": strconv.Itoa(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trigram candidates: %w", err)
 	}
 
-	if _, err := transaction.Mutate(executionContext, mutation); err != nil {
-		return fmt.Errorf("failed to create causal edge: %w", err)
+	var root struct {
+		Ents []struct {
+			EntityID string `json:"entity.id"`
+			Name     string `json:"name"`
+			Type     string `json:"type"`
+		} `json:"ents"`
+	}
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return nil, err
 	}
 
-	return nil
-}
-
-// [RO] Upsert Causal Event (Part 2 Refactoring)
-// Salvează rezultatul analizei cauzale (nodul + scorurile).
-func (repo *DgraphKnowledgeGraphRepository) UpsertCausalEvent(ctx context.Context, eventID string, timestamp time.Time, summary string, score float64) error {
-	type EventDTO struct {
-		Uid        string   `json:"uid"`
-		DType      []string `json:"dgraph.type,omitempty"`
-		EventID    string   `json:"event.id"`
-		Summary    string   `json:"event.summary"`
-		Timestamp  string   `json:"event.timestamp"`
-		TrustScore float64  `json:"event.trust_score"`
+	candidates := make([]entity.EntityCandidate, 0, len(root.Ents))
+	for _, ent := range root.Ents {
+		entityID, err := uuid.Parse(ent.EntityID)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, entity.EntityCandidate{EntityID: entityID, Name: ent.Name, Type: ent.Type})
 	}
+	return candidates, nil
+}
 
-	// 1. Căutăm nodul existent
-	query := `query q($id: string) {
-		ev(func: eq(event.id, $id)) {
-			uid
+// [RO] Entități Co-Apărute (Pagina de Entitate)
+// Articolele entității (~mentioned_entities și appears_in) -> celelalte entități menționate în ele,
+// ordonate după numărul de articole comune. Numărarea se face direct în Dgraph.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveCoOccurringEntities(ctx context.Context, entityID string, limit int) ([]entity.CoOccurringEntity, error) {
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	const query = `query q($id: string, $limit: int) {
+		var(func: eq(entity.id, $id)) {
+			self as uid
+			reported as ~mentioned_entities
+			appeared as appears_in
+		}
+		var(func: uid(reported, appeared)) {
+			others as mentioned_entities @filter(NOT uid(self) AND has(entity.id) AND NOT has(entity.merged_into))
+		}
+		var(func: uid(others)) {
+			shared as count(~mentioned_entities @filter(uid(reported, appeared)))
+		}
+		co(func: uid(others), orderdesc: val(shared), first: $limit) {
+			entity.id
+			name
+			type
+			shared: val(shared)
 		}
 	}`
 
-	transaction := repo.graphClient.NewTxn()
-	defer transaction.Discard(ctx)
-
-	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$id": eventID})
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{
+		"$id":    entityID,
+		"$limit": strconv.Itoa(limit),
+	})
 	if err != nil {
-		return fmt.Errorf("failed to query event: %w", err)
+		return nil, fmt.Errorf("failed to query co-occurring entities: %w", err)
 	}
 
 	var root struct {
-		Ev []struct {
-			Uid string `json:"uid"`
-		} `json:"ev"`
+		Co []struct {
+			EntityID string `json:"entity.id"`
+			Name     string `json:"name"`
+			Type     string `json:"type"`
+			Shared   int    `json:"shared"`
+		} `json:"co"`
 	}
 	if err := json.Unmarshal(resp.Json, &root); err != nil {
-		return err
+		return nil, err
 	}
 
-	uid := "_:new"
-	if len(root.Ev) > 0 {
-		uid = root.Ev[0].Uid
+	coOccurring := make([]entity.CoOccurringEntity, 0, len(root.Co))
+	for _, co := range root.Co {
+		coOccurring = append(coOccurring, entity.CoOccurringEntity{
+			EntityID:       co.EntityID,
+			Name:           co.Name,
+			Type:           co.Type,
+			SharedArticles: co.Shared,
+		})
 	}
+	return coOccurring, nil
+}
 
-	// 2. Facem Upsert
-	dto := EventDTO{
-		Uid:        uid,
-		DType:      []string{"Event"},
-		EventID:    eventID,
-		Summary:    summary,
-		Timestamp:  timestamp.Format(time.RFC3339),
-		TrustScore: score,
+// [RO] Autocompletare Entități (SearchScreen)
+// Căutăm începutul oricărui cuvânt din nume cu `regexp`, care folosește indexul trigram de pe `name`.
+// Expresia vine din entity.SuggestionPattern: litere și cifre citate, cratimă și apostrof ca
+// clase fixe, deci prefixul nu poate schimba expresia. Rezultatele sunt ordonate după numărul de articole.
+func (repo *DgraphKnowledgeGraphRepository) SuggestEntitiesByName(ctx context.Context, prefix string, limit int) ([]entity.EntitySuggestion, error) {
+	pattern, searchable := entity.SuggestionPattern(prefix)
+	if !searchable {
+		return []entity.EntitySuggestion{}, nil
 	}
 
-	jsonData, err := json.Marshal(dto)
+	transaction := repo.graphClient.NewReadOnlyTxn()
+	query := fmt.Sprintf(`query q($limit: int) {
+		var(func: regexp(name, /%s/i)) @filter(has(entity.id) AND NOT has(entity.merged_into)) {
+			mentions as count(~mentioned_entities)
+		}
+		ents(func: uid(mentions), orderdesc: val(mentions), first: $limit) {
+			entity.id
+			name
+			type
+			article_count: val(mentions)
+		}
+	}`, pattern)
+
+	resp, err := transaction.QueryWithVars(ctx, query, map[string]string{"$limit": strconv.Itoa(limit)})
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to query entity suggestions: %w", err)
 	}
 
-	mutation := &api.Mutation{
-		SetJson:   jsonData,
-		CommitNow: true,
+	var root struct {
+		Ents []struct {
+			EntityID     string `json:"entity.id"`
+			Name         string `json:"name"`
+			Type         string `json:"type"`
+			ArticleCount int    `json:"article_count"`
+		} `json:"ents"`
 	}
-
-	_, err = transaction.Mutate(ctx, mutation)
-	if err != nil {
-		return fmt.Errorf("failed to upsert causal event: %w", err)
+	if err := json.Unmarshal(resp.Json, &root); err != nil {
+		return nil, err
 	}
 
-	return nil
+	suggestions := make([]entity.EntitySuggestion, 0, len(root.Ents))
+	for _, ent := range root.Ents {
+		suggestions = append(suggestions, entity.EntitySuggestion{
+			EntityID:     ent.EntityID,
+			Name:         ent.Name,
+			Type:         ent.Type,
+			ArticleCount: ent.ArticleCount,
+		})
+	}
+	return suggestions, nil
 }
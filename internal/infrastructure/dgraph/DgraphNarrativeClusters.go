@@ -0,0 +1,90 @@
+package dgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// [RO] Cât de departe urmărim consecințele evenimentului rădăcină (același plafon ca exportul)
+const narrativeMaxDepth = 10
+
+type narrativeEventDTO struct {
+	StoryCluster string              `json:"event.story_cluster"`
+	Consequences []narrativeEventDTO `json:"~event.caused_by"`
+}
+
+// [RO] Grupurile de Poveste ale Narațiunilor
+// Pentru fiecare narațiune: grupul evenimentului rădăcină și al tuturor consecințelor lui
+// (~event.caused_by, până la narrativeMaxDepth pași). Grupurile care nu sunt UUID ("orphan") sunt ignorate.
+// Implementează analytics.NarrativeSourceInterface.
+func (repo *DgraphKnowledgeGraphRepository) RetrieveNarrativeStoryClusters(ctx context.Context) (map[string][]string, error) {
+	const listQuery = `{
+		narratives(func: has(narrative.id)) {
+			narrative.id
+			narrative.root_event { uid }
+		}
+	}`
+	resp, err := repo.graphClient.NewReadOnlyTxn().Query(ctx, listQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query narratives: %w", err)
+	}
+
+	var list struct {
+		Narratives []struct {
+			ID        string `json:"narrative.id"`
+			RootEvent []struct {
+				UID string `json:"uid"`
+			} `json:"narrative.root_event"`
+		} `json:"narratives"`
+	}
+	if err := json.Unmarshal(resp.Json, &list); err != nil {
+		return nil, err
+	}
+
+	// [RO] @recurse funcționează doar la rădăcina interogării: o cerere per narațiune.
+	treeQuery := fmt.Sprintf(`query q($root: string) {
+		events(func: uid($root)) @recurse(depth: %d, loop: false) {
+			event.story_cluster
+			~event.caused_by
+		}
+	}`, narrativeMaxDepth)
+
+	narratives := make(map[string][]string, len(list.Narratives))
+	for _, narrative := range list.Narratives {
+		if narrative.ID == "" {
+			continue
+		}
+		clusters := map[string]bool{}
+		for _, root := range narrative.RootEvent {
+			resp, err := repo.graphClient.NewReadOnlyTxn().QueryWithVars(ctx, treeQuery, map[string]string{"$root": root.UID})
+			if err != nil {
+				return nil, fmt.Errorf("failed to query narrative %s: %w", narrative.ID, err)
+			}
+			var tree struct {
+				Events []narrativeEventDTO `json:"events"`
+			}
+			if err := json.Unmarshal(resp.Json, &tree); err != nil {
+				return nil, err
+			}
+			collectNarrativeClusters(tree.Events, clusters)
+		}
+
+		narratives[narrative.ID] = make([]string, 0, len(clusters))
+		for cluster := range clusters {
+			narratives[narrative.ID] = append(narratives[narrative.ID], cluster)
+		}
+	}
+	return narratives, nil
+}
+
+func collectNarrativeClusters(events []narrativeEventDTO, clusters map[string]bool) {
+	for _, event := range events {
+		if _, err := uuid.Parse(event.StoryCluster); err == nil {
+			clusters[event.StoryCluster] = true
+		}
+		collectNarrativeClusters(event.Consequences, clusters)
+	}
+}
@@ -0,0 +1,535 @@
+package dgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgo/v240"
+	"github.com/dgraph-io/dgo/v240/protos/api"
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// [RO] Scrieri Sigure în Graf (Upsert Blocks)
+//
+// Reguli pentru toate scrierile din acest depozit:
+// 1. Datele venite din exterior (nume extrase de AI, URL-uri, rezumate) intră doar ca variabile
+//    de interogare ($var) sau în mutații JSON serializate cu encoding/json — niciodată prin fmt.Sprintf.
+// 2. Căutarea nodului și scrierea se fac într-o singură cerere (upsert block): `uid(v)` indică nodul
+//    găsit sau, dacă variabila e goală, un nod nou. Două worker-e nu mai pot crea același nod de două ori:
+//    predicatele cheie au `@upsert`, iar Dgraph anulează (ErrAborted) tranzacția care pierde cursa.
+// 3. O tranzacție anulată este reluată: la a doua încercare interogarea găsește nodul creat de celălalt.
+
+// [RO] Numărul maxim de reîncercări după un conflict de tranzacție.
+const maxUpsertAttempts = 5
+
+// [RO] Pauza de bază între reîncercări (crește liniar).
+const upsertRetryBackoff = 25 * time.Millisecond
+
+// [RO] Executor de Cereri Upsert
+type upsertExecutor interface {
+	Do(ctx context.Context, request *api.Request) (*api.Response, error)
+}
+
+// [RO] Executorul real: o tranzacție nouă per încercare, confirmată imediat (CommitNow).
+type dgraphTransactionExecutor struct {
+	graphClient *dgo.Dgraph
+}
+
+func (executor dgraphTransactionExecutor) Do(ctx context.Context, request *api.Request) (*api.Response, error) {
+	transaction := executor.graphClient.NewTxn()
+	defer transaction.Discard(ctx)
+	return transaction.Do(ctx, request)
+}
+
+// [RO] Rulează un Upsert cu Reîncercare la Conflict
+func (repo *DgraphKnowledgeGraphRepository) runUpsert(ctx context.Context, request *api.Request) (*api.Response, error) {
+	request.CommitNow = true
+
+	for attempt := 1; ; attempt++ {
+		resp, err := repo.upserts.Do(ctx, request)
+		if err == nil {
+			return resp, nil
+		}
+		if !errors.Is(err, dgo.ErrAborted) || attempt >= maxUpsertAttempts {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt) * upsertRetryBackoff):
+		}
+	}
+}
+
+// [RO] Mutație JSON (setare), opțional condiționată (@if(...))
+func jsonSetMutation(condition string, payload interface{}) (*api.Mutation, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Mutation{Cond: condition, SetJson: data}, nil
+}
+
+// [RO] Mutație JSON cu ștergere și setare în același pas
+func jsonMoveMutation(condition string, deletePayload interface{}, setPayload interface{}) (*api.Mutation, error) {
+	deleteData, err := json.Marshal(deletePayload)
+	if err != nil {
+		return nil, err
+	}
+	setData, err := json.Marshal(setPayload)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Mutation{Cond: condition, DeleteJson: deleteData, SetJson: setData}, nil
+}
+
+// [RO] Câte noduri a găsit un bloc numit din interogarea upsert (ex: `ev(func: ...) { ev as uid }`)
+func countMatchedNodes(resp *api.Response, block string) (int, error) {
+	var root map[string][]json.RawMessage
+	if len(resp.GetJson()) == 0 {
+		return 0, nil
+	}
+	if err := json.Unmarshal(resp.GetJson(), &root); err != nil {
+		return 0, err
+	}
+	return len(root[block]), nil
+}
+
+// [RO] Salvează Știrea în Graf (Implementare)
+//
+// Această metodă nu doar salvează textul, ci creează "noduri" și "muchii" în graf.
+// Dacă articolul menționează "București", sistemul va crea (sau refolosi) nodul "București"
+// și va trage o linie între Articol și Oraș. Totul într-un singur upsert block.
+func (repo *DgraphKnowledgeGraphRepository) SaveNewsArticleToGraph(executionContext context.Context, newsArticle *article.NewsArticleEntity) error {
+	request, err := buildArticleUpsertRequest(newsArticle)
+	if err != nil {
+		return err
+	}
+	if _, err := repo.runUpsert(executionContext, request); err != nil {
+		return fmt.Errorf("failed to save article graph: %w", err)
+	}
+	return nil
+}
+
+// [RO] Construiește cererea upsert pentru un articol
+//
+// Interogarea găsește articolul (după URL) și fiecare entitate (după ID-ul canonic sau, pentru
+// compatibilitate, după nume). Entitățile lipsă sunt create de mutațiile condiționate; mutația finală
+// scrie articolul și muchiile mentioned_entities către `uid(eN)` — nodul găsit sau cel tocmai creat.
+func buildArticleUpsertRequest(newsArticle *article.NewsArticleEntity) (*api.Request, error) {
+	params := []string{"$url: string"}
+	vars := map[string]string{"$url": newsArticle.OriginalURL}
+	blocks := []string{"art as var(func: eq(url, $url), first: 1)"}
+
+	var mutations []*api.Mutation
+	mentions := make([]map[string]string, 0, len(newsArticle.Mentions))
+	seen := make(map[string]bool)
+
+	for _, ent := range newsArticle.Mentions {
+		lookupKey := "name|" + ent.Name
+		if ent.CanonicalID != "" {
+			lookupKey = "id|" + ent.CanonicalID
+		}
+		// Aceeași entitate menționată de două ori ar crea două noduri noi.
+		if ent.Name == "" || seen[lookupKey] {
+			continue
+		}
+		seen[lookupKey] = true
+
+		variable := fmt.Sprintf("e%d", len(mentions))
+		params = append(params, "$"+variable+": string")
+		if ent.CanonicalID != "" {
+			vars["$"+variable] = ent.CanonicalID
+			blocks = append(blocks, fmt.Sprintf("%s as var(func: eq(entity.id, $%s), first: 1)", variable, variable))
+		} else {
+			vars["$"+variable] = ent.Name
+			blocks = append(blocks, fmt.Sprintf("%s as var(func: eq(name, $%s), first: 1)", variable, variable))
+		}
+
+		// Câmpurile de identitate se scriu doar la creare (nu suprascriem numele canonic cu o variantă din text).
+		created := map[string]interface{}{
+			"uid":         "uid(" + variable + ")",
+			"name":        ent.Name,
+			"type":        ent.Type,
+			"dgraph.type": "Entity",
+		}
+		if ent.CanonicalID != "" {
+			created["entity.id"] = ent.CanonicalID
+		}
+		createMutation, err := jsonSetMutation(fmt.Sprintf("@if(eq(len(%s), 0))", variable), created)
+		if err != nil {
+			return nil, err
+		}
+		mutations = append(mutations, createMutation)
+
+		// [RO] Legătura Wikidata călătorește cu mutația articolului (actualizează și nodurile existente).
+		mention := map[string]string{"uid": "uid(" + variable + ")"}
+		if ent.WikidataQID != "" {
+			mention["entity.wikidata_qid"] = ent.WikidataQID
+			mention["entity.description"] = ent.Description
+		}
+		mentions = append(mentions, mention)
+	}
+
+	articleMutation, err := jsonSetMutation("", map[string]interface{}{
+		"uid":                "uid(art)",
+		"url":                newsArticle.OriginalURL,
+		"title":              newsArticle.Title,
+		"truth_score":        newsArticle.TruthScore,
+		"mentioned_entities": mentions, // Aici facem legătura fizică în graf!
+		"arweave_tx_id":      newsArticle.ArweaveTransactionID,
+		"solana_signature":   newsArticle.SolanaSignature,
+		"published_at":       newsArticle.PublishedAt.Format(time.RFC3339),
+		"dgraph.type":        "Article",
+	})
+	if err != nil {
+		return nil, err
+	}
+	mutations = append(mutations, articleMutation)
+
+	return &api.Request{
+		Query:     fmt.Sprintf("query q(%s) {\n\t%s\n}", strings.Join(params, ", "), strings.Join(blocks, "\n\t")),
+		Vars:      vars,
+		Mutations: mutations,
+	}, nil
+}
+
+// [RO] Creează Muchie Cauzală
+// Stabilește o legătură de tip "caused_by" între două evenimente (Child --[caused_by]--> Parent).
+func (repo *DgraphKnowledgeGraphRepository) CreateCausalEdge(executionContext context.Context, parentID string, childID string, relationType string) error {
+	mutation, err := jsonSetMutation("@if(eq(len(parent), 1) AND eq(len(child), 1))", map[string]interface{}{
+		"uid":             "uid(child)",
+		"event.caused_by": []map[string]string{{"uid": "uid(parent)"}},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := repo.runUpsert(executionContext, &api.Request{
+		Query: `query q($pid: string, $cid: string) {
+			parent(func: eq(event.id, $pid), first: 1) { parent as uid }
+			child(func: eq(event.id, $cid), first: 1) { child as uid }
+		}`,
+		Vars:      map[string]string{"$pid": parentID, "$cid": childID},
+		Mutations: []*api.Mutation{mutation},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create causal edge: %w", err)
+	}
+
+	parents, err := countMatchedNodes(resp, "parent")
+	if err != nil {
+		return err
+	}
+	children, err := countMatchedNodes(resp, "child")
+	if err != nil {
+		return err
+	}
+	if parents == 0 || children == 0 {
+		return fmt.Errorf("parent or child event not found in graph")
+	}
+	return nil
+}
+
+// [RO] Retrage o Legătură Cauzală
+// Șterge muchia `event.caused_by` de la copil spre părinte (ex: după o contestație acceptată).
+// Idempotent: dacă legătura sau evenimentele nu mai există, nu se întâmplă nimic.
+func (repo *DgraphKnowledgeGraphRepository) RemoveCausalEdge(executionContext context.Context, parentID string, childID string) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"uid":             "uid(child)",
+		"event.caused_by": []map[string]string{{"uid": "uid(parent)"}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runUpsert(executionContext, &api.Request{
+		Query: `query q($pid: string, $cid: string) {
+			parent(func: eq(event.id, $pid), first: 1) { parent as uid }
+			child(func: eq(event.id, $cid), first: 1) { child as uid }
+		}`,
+		Vars:      map[string]string{"$pid": parentID, "$cid": childID},
+		Mutations: []*api.Mutation{{Cond: "@if(eq(len(parent), 1) AND eq(len(child), 1))", DeleteJson: data}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove causal edge: %w", err)
+	}
+	return nil
+}
+
+// [RO] Upsert Causal Event (Part 2 Refactoring)
+// Salvează rezultatul analizei cauzale (nodul + scorurile).
+// ID-ul evenimentului este derivat din grupul poveștii (causality.StoryEventID), deci un
+// eveniment existent este actualizat, nu duplicat. Data primei apariții nu se suprascrie.
+func (repo *DgraphKnowledgeGraphRepository) UpsertCausalEvent(ctx context.Context, eventID string, storyCluster string, timestamp time.Time, summary string, score float64) error {
+	firstSeen, err := jsonSetMutation("@if(eq(len(ev), 0))", map[string]string{
+		"uid":             "uid(ev)",
+		"event.timestamp": timestamp.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]interface{}{
+		"uid":               "uid(ev)",
+		"dgraph.type":       "Event",
+		"event.id":          eventID,
+		"event.summary":     summary,
+		"event.trust_score": score,
+	}
+	if storyCluster != "" {
+		fields["event.story_cluster"] = storyCluster
+	}
+	upsert, err := jsonSetMutation("", fields)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runUpsert(ctx, &api.Request{
+		Query:     `query q($id: string) { ev as var(func: eq(event.id, $id), first: 1) }`,
+		Vars:      map[string]string{"$id": eventID},
+		Mutations: []*api.Mutation{firstSeen, upsert},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert causal event: %w", err)
+	}
+	return nil
+}
+
+// [RO] Leagă Evenimentul de Sursă (event.reported_by)
+// Trage muchia Eveniment -> Articol, ca analiza cauzală să poată fi urmărită până la surse.
+// Dacă articolul nu a ajuns încă în graf, creăm un nod minimal (doar URL), completat ulterior
+// de SaveNewsArticleToGraph (care caută tot după URL).
+func (repo *DgraphKnowledgeGraphRepository) LinkEventToArticle(ctx context.Context, eventID string, articleURL string) error {
+	mutation, err := jsonSetMutation("@if(eq(len(ev), 1))", map[string]interface{}{
+		"uid": "uid(ev)",
+		"event.reported_by": []map[string]interface{}{{
+			"uid":         "uid(art)",
+			"url":         articleURL,
+			"dgraph.type": "Article",
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := repo.runUpsert(ctx, &api.Request{
+		Query: `query q($id: string, $url: string) {
+			ev(func: eq(event.id, $id), first: 1) { ev as uid }
+			art as var(func: eq(url, $url), first: 1)
+		}`,
+		Vars:      map[string]string{"$id": eventID, "$url": articleURL},
+		Mutations: []*api.Mutation{mutation},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to link event to article: %w", err)
+	}
+
+	if found, err := countMatchedNodes(resp, "ev"); err != nil || found == 0 {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("event %s not found in graph", eventID)
+	}
+	return nil
+}
+
+// [RO] Marchează Evenimentul ca Orfan
+// Evenimentele pentru care migrarea nu găsește nicio sursă primesc event.story_cluster = "orphan",
+// ca să nu fie reluate la nesfârșit.
+func (repo *DgraphKnowledgeGraphRepository) SetCausalEventStoryCluster(ctx context.Context, eventID string, storyCluster string) error {
+	mutation, err := jsonSetMutation("@if(eq(len(ev), 1))", map[string]string{
+		"uid":                 "uid(ev)",
+		"event.story_cluster": storyCluster,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := repo.runUpsert(ctx, &api.Request{
+		Query:     `query q($id: string) { ev(func: eq(event.id, $id), first: 1) { ev as uid } }`,
+		Vars:      map[string]string{"$id": eventID},
+		Mutations: []*api.Mutation{mutation},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set story cluster: %w", err)
+	}
+
+	if found, err := countMatchedNodes(resp, "ev"); err != nil || found == 0 {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("event %s not found in graph", eventID)
+	}
+	return nil
+}
+
+// [RO] Unificare Noduri Eveniment (Merge)
+// Un eveniment vechi (ID aleator) este contopit în evenimentul poveștii (causality.StoryEventID):
+// cauzele, efectele și sursele lui trec pe țintă, apoi nodul vechi este șters. Dacă ținta nu are
+// încă nod în graf, nodul vechi este doar redenumit. Fără nodul vechi, nicio mutație nu se aplică.
+func (repo *DgraphKnowledgeGraphRepository) MergeCausalEventNodes(ctx context.Context, legacyEventID string, targetEventID string, storyCluster string) error {
+	const merging = "eq(len(src), 1) AND eq(len(tgt), 1)"
+
+	rename, err := jsonSetMutation("@if(eq(len(src), 1) AND eq(len(tgt), 0))", map[string]string{
+		"uid":                 "uid(src)",
+		"event.id":            targetEventID,
+		"event.story_cluster": storyCluster,
+	})
+	if err != nil {
+		return err
+	}
+	moveEffects, err := jsonMoveMutation("@if("+merging+" AND gt(len(effects), 0))",
+		map[string]interface{}{"uid": "uid(effects)", "event.caused_by": []map[string]string{{"uid": "uid(src)"}}},
+		map[string]interface{}{"uid": "uid(effects)", "event.caused_by": []map[string]string{{"uid": "uid(tgt)"}}},
+	)
+	if err != nil {
+		return err
+	}
+	copyCauses, err := jsonSetMutation("@if("+merging+" AND gt(len(causes), 0))", map[string]interface{}{
+		"uid":             "uid(tgt)",
+		"event.caused_by": []map[string]string{{"uid": "uid(causes)"}},
+	})
+	if err != nil {
+		return err
+	}
+	copySources, err := jsonSetMutation("@if("+merging+" AND gt(len(sources), 0))", map[string]interface{}{
+		"uid":               "uid(tgt)",
+		"event.reported_by": []map[string]string{{"uid": "uid(sources)"}},
+	})
+	if err != nil {
+		return err
+	}
+	// Ștergerea cu null elimină toate valorile predicatului; muchia țintă -> sursă (dacă exista) dispare și ea.
+	deleteLegacy, err := json.Marshal([]map[string]interface{}{
+		{
+			"uid": "uid(src)", "dgraph.type": nil, "event.id": nil, "event.title": nil, "event.summary": nil,
+			"event.timestamp": nil, "event.caused_by": nil, "event.triggered": nil, "event.reported_by": nil,
+			"event.story_cluster": nil, "event.trust_score": nil, "event.topic_cluster": nil, "event.chain_depth": nil,
+		},
+		{"uid": "uid(tgt)", "event.caused_by": []map[string]string{{"uid": "uid(src)"}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runUpsert(ctx, &api.Request{
+		Query: `query q($src: string, $tgt: string) {
+			tgt as var(func: eq(event.id, $tgt), first: 1)
+			src as var(func: eq(event.id, $src), first: 1) {
+				causes as event.caused_by @filter(NOT uid(tgt))
+				sources as event.reported_by
+				effects as ~event.caused_by @filter(NOT uid(tgt))
+			}
+		}`,
+		Vars: map[string]string{"$src": legacyEventID, "$tgt": targetEventID},
+		Mutations: []*api.Mutation{
+			rename, moveEffects, copyCauses, copySources,
+			{Cond: "@if(" + merging + ")", DeleteJson: deleteLegacy},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge event nodes: %w", err)
+	}
+	return nil
+}
+
+// [RO] Unificare Noduri Entitate (Merge)
+// Toate articolele care menționau sursa sunt legate de țintă, iar sursa primește
+// `entity.merged_into`. Dacă ținta nu are încă nod în graf, nodul sursă este redenumit.
+// Dacă sursa nu a ajuns niciodată în graf, nicio mutație nu se aplică.
+func (repo *DgraphKnowledgeGraphRepository) MergeEntityNodes(ctx context.Context, sourceEntityID string, targetEntityID string) error {
+	rename, err := jsonSetMutation("@if(eq(len(src), 1) AND eq(len(tgt), 0))", map[string]string{
+		"uid":       "uid(src)",
+		"entity.id": targetEntityID,
+	})
+	if err != nil {
+		return err
+	}
+	moveArticles, err := jsonMoveMutation("@if(eq(len(src), 1) AND eq(len(tgt), 1) AND gt(len(arts), 0))",
+		map[string]interface{}{"uid": "uid(arts)", "mentioned_entities": []map[string]string{{"uid": "uid(src)"}}},
+		map[string]interface{}{"uid": "uid(arts)", "mentioned_entities": []map[string]string{{"uid": "uid(tgt)"}}},
+	)
+	if err != nil {
+		return err
+	}
+	markMerged, err := jsonSetMutation("@if(eq(len(src), 1) AND eq(len(tgt), 1))", map[string]interface{}{
+		"uid":                "uid(src)",
+		"entity.merged_into": map[string]string{"uid": "uid(tgt)"},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runUpsert(ctx, &api.Request{
+		Query: `query q($src: string, $tgt: string) {
+			src as var(func: eq(entity.id, $src), first: 1) {
+				arts as ~mentioned_entities
+			}
+			tgt as var(func: eq(entity.id, $tgt), first: 1)
+		}`,
+		Vars:      map[string]string{"$src": sourceEntityID, "$tgt": targetEntityID},
+		Mutations: []*api.Mutation{rename, moveArticles, markMerged},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge entity nodes: %w", err)
+	}
+	return nil
+}
+
+// [RO] Mutare Mențiuni (Split)
+// Articolele date nu mai menționează entitatea veche, ci pe cea nouă (creată dacă lipsește).
+func (repo *DgraphKnowledgeGraphRepository) RelinkEntityMentions(ctx context.Context, fromEntityID string, toEntity entity.CanonicalEntity, articleURLs []string) error {
+	if len(articleURLs) == 0 {
+		return nil
+	}
+
+	params := []string{"$from: string", "$to: string"}
+	vars := map[string]string{"$from": fromEntityID, "$to": toEntity.ID.String()}
+	urlFilters := make([]string, 0, len(articleURLs))
+	for i, articleURL := range articleURLs {
+		variable := fmt.Sprintf("$u%d", i)
+		params = append(params, variable+": string")
+		vars[variable] = articleURL
+		urlFilters = append(urlFilters, fmt.Sprintf("eq(url, %s)", variable))
+	}
+
+	createTarget, err := jsonSetMutation("@if(gt(len(moved), 0) AND eq(len(to), 0))", map[string]string{
+		"uid":         "uid(to)",
+		"entity.id":   toEntity.ID.String(),
+		"name":        toEntity.Name,
+		"type":        toEntity.Type,
+		"dgraph.type": "Entity",
+	})
+	if err != nil {
+		return err
+	}
+	moveArticles, err := jsonMoveMutation("@if(gt(len(moved), 0))",
+		map[string]interface{}{"uid": "uid(moved)", "mentioned_entities": []map[string]string{{"uid": "uid(from)"}}},
+		map[string]interface{}{"uid": "uid(moved)", "mentioned_entities": []map[string]string{{"uid": "uid(to)"}}},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runUpsert(ctx, &api.Request{
+		Query: fmt.Sprintf(`query q(%s) {
+			from as var(func: eq(entity.id, $from), first: 1) {
+				moved as ~mentioned_entities @filter(%s)
+			}
+			to as var(func: eq(entity.id, $to), first: 1)
+		}`, strings.Join(params, ", "), strings.Join(urlFilters, " OR ")),
+		Vars:      vars,
+		Mutations: []*api.Mutation{createTarget, moveArticles},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to relink entity mentions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package dgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v240"
+	"github.com/dgraph-io/dgo/v240/protos/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+// [RO] Un nume "otrăvit": ghilimele, linie nouă și un triplet N-quad care ar fi fost injectat de fmt.Sprintf.
+const hostileName = "Evil\" . _:x <dgraph.type> \"Admin\" .\n_:x <name> \"pwned"
+
+func TestBuildArticleUpsertRequest_KeepsHostileNamesOutOfQuery(t *testing.T) {
+	canonicalID := uuid.New().String()
+	newsArticle := &article.NewsArticleEntity{
+		OriginalURL: "https://example.com/a?b=\"c\"",
+		Title:       "Title with \"quotes\"",
+		PublishedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Mentions: []article.NamedEntity{
+			{Name: hostileName, Type: "Person"},
+			{Name: "Joe Biden", Type: "Person", CanonicalID: canonicalID},
+			{Name: "Biden", Type: "Person", CanonicalID: canonicalID}, // [RO] Același ID -> o singură variabilă
+		},
+	}
+
+	request, err := buildArticleUpsertRequest(newsArticle)
+	require.NoError(t, err)
+
+	// [RO] Datele externe stau doar în variabile, niciodată în textul interogării.
+	assert.NotContains(t, request.Query, "Evil")
+	assert.NotContains(t, request.Query, "example.com")
+	assert.Equal(t, hostileName, request.Vars["$e0"])
+	assert.Equal(t, canonicalID, request.Vars["$e1"])
+	assert.NotContains(t, request.Vars, "$e2")
+
+	// [RO] 2 mutații de creare (condiționate) + mutația articolului; toate JSON.
+	require.Len(t, request.Mutations, 3)
+	for _, mutation := range request.Mutations {
+		assert.Empty(t, mutation.SetNquads)
+		assert.NotEmpty(t, mutation.SetJson)
+	}
+	assert.Equal(t, "@if(eq(len(e0), 0))", request.Mutations[0].Cond)
+	assert.Equal(t, "@if(eq(len(e1), 0))", request.Mutations[1].Cond)
+	assert.Empty(t, request.Mutations[2].Cond)
+
+	var created map[string]string
+	require.NoError(t, json.Unmarshal(request.Mutations[0].SetJson, &created))
+	assert.Equal(t, hostileName, created["name"], "[RO] Numele ajunge în graf exact cum a fost scris")
+	assert.Equal(t, "uid(e0)", created["uid"])
+
+	var saved struct {
+		UID      string              `json:"uid"`
+		Mentions []map[string]string `json:"mentioned_entities"`
+	}
+	require.NoError(t, json.Unmarshal(request.Mutations[2].SetJson, &saved))
+	assert.Equal(t, "uid(art)", saved.UID)
+	assert.Equal(t, []map[string]string{{"uid": "uid(e0)"}, {"uid": "uid(e1)"}}, saved.Mentions)
+}
+
+// [RO] Graf fals cu concurență optimistă, ca Dgraph:
+// o tranzacție care a citit o stare între timp modificată de alta este anulată (ErrAborted).
+type optimisticGraphFake struct {
+	mu       sync.Mutex
+	nodes    map[string]int
+	versions map[string]int
+	attempts int
+}
+
+func (fake *optimisticGraphFake) Do(ctx context.Context, request *api.Request) (*api.Response, error) {
+	key := request.Vars["$e0"]
+
+	fake.mu.Lock()
+	readVersion := fake.versions[key]
+	exists := fake.nodes[key] > 0
+	fake.attempts++
+	fake.mu.Unlock()
+
+	// Fereastra în care alt worker poate confirma înaintea noastră.
+	time.Sleep(time.Millisecond)
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.versions[key] != readVersion {
+		return nil, dgo.ErrAborted
+	}
+	for _, mutation := range request.Mutations {
+		if mutation.Cond == "@if(eq(len(e0), 0))" && !exists {
+			fake.nodes[key]++
+			fake.versions[key]++
+		}
+	}
+	return &api.Response{}, nil
+}
+
+func TestSaveNewsArticleToGraph_ConcurrentWorkersCreateEntityOnce(t *testing.T) {
+	fake := &optimisticGraphFake{nodes: map[string]int{}, versions: map[string]int{}}
+	repo := &DgraphKnowledgeGraphRepository{upserts: fake}
+	canonicalID := uuid.New().String()
+
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repo.SaveNewsArticleToGraph(context.Background(), &article.NewsArticleEntity{
+				OriginalURL: "https://example.com/" + uuid.NewString(),
+				Mentions:    []article.NamedEntity{{Name: "Joe Biden", Type: "Person", CanonicalID: canonicalID}},
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, fake.nodes[canonicalID], "[RO] Un singur nod, oricâți worker-i concurenți")
+	assert.Greater(t, fake.attempts, workers, "[RO] Cei care au pierdut cursa au fost reluați")
+}
+
+type scriptedExecutor struct {
+	errs  []error
+	calls int
+}
+
+func (executor *scriptedExecutor) Do(ctx context.Context, request *api.Request) (*api.Response, error) {
+	err := executor.errs[executor.calls]
+	executor.calls++
+	if err != nil {
+		return nil, err
+	}
+	return &api.Response{Json: []byte(`{"ev":[{"uid":"0x1"}]}`)}, nil
+}
+
+func TestRunUpsert_RetriesOnlyTransactionConflicts(t *testing.T) {
+	// [RO] Conflict, apoi succes -> reluat.
+	executor := &scriptedExecutor{errs: []error{dgo.ErrAborted, nil}}
+	repo := &DgraphKnowledgeGraphRepository{upserts: executor}
+	assert.NoError(t, repo.SetCausalEventStoryCluster(context.Background(), "evt-1", "orphan"))
+	assert.Equal(t, 2, executor.calls)
+
+	// [RO] Altă eroare -> returnată imediat.
+	broken := errors.New("connection refused")
+	executor = &scriptedExecutor{errs: []error{broken}}
+	repo = &DgraphKnowledgeGraphRepository{upserts: executor}
+	assert.ErrorIs(t, repo.SetCausalEventStoryCluster(context.Background(), "evt-1", "orphan"), broken)
+	assert.Equal(t, 1, executor.calls)
+
+	// [RO] Conflict permanent -> renunțăm după maxUpsertAttempts.
+	persistent := make([]error, maxUpsertAttempts)
+	for i := range persistent {
+		persistent[i] = dgo.ErrAborted
+	}
+	executor = &scriptedExecutor{errs: persistent}
+	repo = &DgraphKnowledgeGraphRepository{upserts: executor}
+	assert.ErrorIs(t, repo.SetCausalEventStoryCluster(context.Background(), "evt-1", "orphan"), dgo.ErrAborted)
+	assert.Equal(t, maxUpsertAttempts, executor.calls)
+}
+
+// [RO] Executor care reține cererea trimisă
+type capturingExecutor struct {
+	request *api.Request
+}
+
+func (executor *capturingExecutor) Do(ctx context.Context, request *api.Request) (*api.Response, error) {
+	executor.request = request
+	return &api.Response{}, nil
+}
+
+func TestMergeCausalEventNodes_RenamesOrFoldsInOneUpsert(t *testing.T) {
+	executor := &capturingExecutor{}
+	repo := &DgraphKnowledgeGraphRepository{upserts: executor}
+	legacyID, storyEventID := uuid.NewString(), uuid.NewSHA1(uuid.NameSpaceURL, []byte("story")).String()
+
+	require.NoError(t, repo.MergeCausalEventNodes(context.Background(), legacyID, storyEventID, "cluster-1"))
+
+	request := executor.request
+	require.NotNil(t, request)
+	assert.True(t, request.CommitNow)
+	assert.Equal(t, map[string]string{"$src": legacyID, "$tgt": storyEventID}, request.Vars)
+	assert.NotContains(t, request.Query, legacyID, "[RO] ID-urile trec prin variabile")
+
+	var conditions []string
+	for _, mutation := range request.Mutations {
+		conditions = append(conditions, mutation.Cond)
+	}
+	assert.Contains(t, conditions, "@if(eq(len(src), 1) AND eq(len(tgt), 0))", "[RO] Fără țintă: redenumire")
+	assert.Contains(t, conditions, "@if(eq(len(src), 1) AND eq(len(tgt), 1))", "[RO] Cu țintă: nodul vechi se șterge")
+
+	var rename map[string]string
+	require.NoError(t, json.Unmarshal(request.Mutations[0].SetJson, &rename))
+	assert.Equal(t, storyEventID, rename["event.id"])
+	assert.Contains(t, string(request.Mutations[1].SetJson), "uid(tgt)", "[RO] Efectele trec pe țintă")
+}
+
+// [RO] Test de Integrare (opțional)
+// Rulează doar cu un Dgraph real cu schema din configs/schema.dgraph încărcată:
+//
+//	DGRAPH_TEST_ADDR=localhost:9080 go test ./internal/infrastructure/dgraph/
+func TestSaveNewsArticleToGraph_LiveConcurrentUpserts(t *testing.T) {
+	address := os.Getenv("DGRAPH_TEST_ADDR")
+	if address == "" {
+		t.Skip("DGRAPH_TEST_ADDR nu este setat")
+	}
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := dgo.NewDgraphClient(api.NewDgraphClient(conn))
+	repo := NewDgraphKnowledgeGraphRepository(client)
+
+	canonicalID := uuid.New().String()
+	sharedURL := "https://example.com/live/" + canonicalID
+
+	const workers = 10
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, repo.SaveNewsArticleToGraph(context.Background(), &article.NewsArticleEntity{
+				OriginalURL: sharedURL,
+				Title:       hostileName,
+				Mentions:    []article.NamedEntity{{Name: hostileName, Type: "Person", CanonicalID: canonicalID}},
+			}))
+		}()
+	}
+	wg.Wait()
+
+	resp, err := client.NewReadOnlyTxn().QueryWithVars(context.Background(), `query q($id: string, $url: string) {
+		ents(func: eq(entity.id, $id)) { uid name }
+		arts(func: eq(url, $url)) { uid title }
+	}`, map[string]string{"$id": canonicalID, "$url": sharedURL})
+	require.NoError(t, err)
+
+	var root struct {
+		Ents []struct{ Name string }  `json:"ents"`
+		Arts []struct{ Title string } `json:"arts"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Json, &root))
+	require.Len(t, root.Ents, 1)
+	require.Len(t, root.Arts, 1)
+	assert.Equal(t, hostileName, root.Ents[0].Name)
+	assert.True(t, strings.HasPrefix(root.Arts[0].Title, "Evil\""))
+}
@@ -0,0 +1,232 @@
+package gazetteer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/yourorg/truthweave/internal/domain/geo"
+)
+
+// [RO] Conversie Natural Earth -> Gazetteer
+//
+// Natural Earth (naturalearthdata.com, domeniu public) publică granițele țărilor (admin-0)
+// și ale regiunilor (admin-1). Le transformăm în formatul nostru: centroid (punctul de etichetă),
+// nume + alias-uri și contururi simplificate (Douglas-Peucker), ca fișierul să rămână mic.
+
+type naturalEarthCollection struct {
+	Features []naturalEarthFeature `json:"features"`
+}
+
+type naturalEarthFeature struct {
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+// [RO] Convertor (toleranța de simplificare în grade; 0.05° ≈ 5 km)
+type NaturalEarthConverter struct {
+	SimplifyTolerance float64
+}
+
+// [RO] Țări (ne_10m_admin_0_countries.geojson)
+// Teritoriile fără cod ISO ("-99") sunt ignorate.
+func (converter NaturalEarthConverter) ConvertCountries(reader io.Reader) ([]geo.Place, error) {
+	features, err := decodeNaturalEarth(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var places []geo.Place
+	for _, feature := range features {
+		code := firstISOCode(feature.Properties, "ISO_A2", "ISO_A2_EH")
+		if code == "" {
+			continue
+		}
+		name := stringProperty(feature.Properties, "NAME")
+		place := geo.Place{
+			Code:        code,
+			CountryCode: code,
+			Name:        name,
+			Aliases: distinctAliases(name,
+				stringProperty(feature.Properties, "NAME_LONG"),
+				stringProperty(feature.Properties, "FORMAL_EN"),
+				stringProperty(feature.Properties, "ADMIN")),
+			Level:     geo.LevelCountry,
+			Latitude:  numberProperty(feature.Properties, "LABEL_Y"),
+			Longitude: numberProperty(feature.Properties, "LABEL_X"),
+		}
+		if place.Polygons, err = converter.polygons(feature); err != nil {
+			return nil, fmt.Errorf("[RO] Eroare Natural Earth: %s: %w", code, err)
+		}
+		places = append(places, place)
+	}
+	return places, nil
+}
+
+// [RO] Regiuni (ne_10m_admin_1_states_provinces.geojson)
+func (converter NaturalEarthConverter) ConvertRegions(reader io.Reader) ([]geo.Place, error) {
+	features, err := decodeNaturalEarth(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var places []geo.Place
+	for _, feature := range features {
+		code := stringProperty(feature.Properties, "iso_3166_2")
+		country := firstISOCode(feature.Properties, "iso_a2")
+		if code == "" || country == "" || strings.HasSuffix(code, "~") {
+			continue
+		}
+		name := stringProperty(feature.Properties, "name")
+		place := geo.Place{
+			Code:        code,
+			CountryCode: country,
+			Name:        name,
+			Aliases:     distinctAliases(name, strings.Split(stringProperty(feature.Properties, "name_alt"), "|")...),
+			Level:       geo.LevelRegion,
+			Latitude:    numberProperty(feature.Properties, "latitude"),
+			Longitude:   numberProperty(feature.Properties, "longitude"),
+		}
+		if place.Polygons, err = converter.polygons(feature); err != nil {
+			return nil, fmt.Errorf("[RO] Eroare Natural Earth: %s: %w", code, err)
+		}
+		places = append(places, place)
+	}
+	return places, nil
+}
+
+// [RO] Scrie fișierul în formatul încărcat de NewOfflineGazetteer
+func WriteGazetteer(writer io.Writer, source string, places []geo.Place) error {
+	encoder := json.NewEncoder(writer)
+	return encoder.Encode(gazetteerFile{Source: source, Places: places})
+}
+
+func decodeNaturalEarth(reader io.Reader) ([]naturalEarthFeature, error) {
+	var collection naturalEarthCollection
+	if err := json.NewDecoder(reader).Decode(&collection); err != nil {
+		return nil, fmt.Errorf("[RO] Eroare Natural Earth: GeoJSON invalid: %w", err)
+	}
+	return collection.Features, nil
+}
+
+// [RO] Contururi: doar inelul exterior al fiecărui poligon (găurile nu contează la această rezoluție).
+// Inelele care dispar la simplificare (insulițe) sunt eliminate.
+func (converter NaturalEarthConverter) polygons(feature naturalEarthFeature) ([][][2]float64, error) {
+	var outerRings [][][2]float64
+	switch feature.Geometry.Type {
+	case "Polygon":
+		var polygon [][][2]float64
+		if err := json.Unmarshal(feature.Geometry.Coordinates, &polygon); err != nil {
+			return nil, err
+		}
+		if len(polygon) > 0 {
+			outerRings = append(outerRings, polygon[0])
+		}
+	case "MultiPolygon":
+		var multiPolygon [][][][2]float64
+		if err := json.Unmarshal(feature.Geometry.Coordinates, &multiPolygon); err != nil {
+			return nil, err
+		}
+		for _, polygon := range multiPolygon {
+			if len(polygon) > 0 {
+				outerRings = append(outerRings, polygon[0])
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	var simplified [][][2]float64
+	for _, ring := range outerRings {
+		if reduced := SimplifyRing(ring, converter.SimplifyTolerance); len(reduced) >= 3 {
+			simplified = append(simplified, reduced)
+		}
+	}
+	return simplified, nil
+}
+
+// [RO] Simplificare Douglas-Peucker
+// Păstrează punctele care deviază de la linia dreaptă cu mai mult de `tolerance` grade.
+// Inelul închis (primul punct repetat la final) este returnat deschis.
+func SimplifyRing(ring [][2]float64, tolerance float64) [][2]float64 {
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		ring = ring[:len(ring)-1]
+	}
+	if tolerance <= 0 || len(ring) < 4 {
+		return ring
+	}
+
+	keep := make([]bool, len(ring))
+	keep[0], keep[len(ring)-1] = true, true
+	var simplify func(first, last int)
+	simplify = func(first, last int) {
+		maxDistance, index := 0.0, -1
+		for i := first + 1; i < last; i++ {
+			if distance := perpendicularDistance(ring[i], ring[first], ring[last]); distance > maxDistance {
+				maxDistance, index = distance, i
+			}
+		}
+		if index != -1 && maxDistance > tolerance {
+			keep[index] = true
+			simplify(first, index)
+			simplify(index, last)
+		}
+	}
+	simplify(0, len(ring)-1)
+
+	var reduced [][2]float64
+	for i, point := range ring {
+		if keep[i] {
+			reduced = append(reduced, point)
+		}
+	}
+	return reduced
+}
+
+func perpendicularDistance(point, lineStart, lineEnd [2]float64) float64 {
+	dx, dy := lineEnd[0]-lineStart[0], lineEnd[1]-lineStart[1]
+	if dx == 0 && dy == 0 {
+		return math.Hypot(point[0]-lineStart[0], point[1]-lineStart[1])
+	}
+	return math.Abs(dy*point[0]-dx*point[1]+lineEnd[0]*lineStart[1]-lineEnd[1]*lineStart[0]) / math.Hypot(dx, dy)
+}
+
+func stringProperty(properties map[string]interface{}, key string) string {
+	value, _ := properties[key].(string)
+	return strings.TrimSpace(value)
+}
+
+func numberProperty(properties map[string]interface{}, key string) float64 {
+	value, _ := properties[key].(float64)
+	return value
+}
+
+// [RO] Primul cod ISO valid (Natural Earth folosește "-99" pentru "fără cod")
+func firstISOCode(properties map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if code := strings.ToUpper(stringProperty(properties, key)); len(code) == 2 {
+			return code
+		}
+	}
+	return ""
+}
+
+// [RO] Alias-uri distincte, fără numele principal
+func distinctAliases(name string, candidates ...string) []string {
+	seen := map[string]bool{strings.ToLower(name): true}
+	var aliases []string
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" || seen[strings.ToLower(candidate)] {
+			continue
+		}
+		seen[strings.ToLower(candidate)] = true
+		aliases = append(aliases, candidate)
+	}
+	return aliases
+}
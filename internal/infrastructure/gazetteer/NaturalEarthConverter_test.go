@@ -0,0 +1,48 @@
+package gazetteer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const naturalEarthCountriesSample = `{"type": "FeatureCollection", "features": [
+  {"type": "Feature",
+   "properties": {"ISO_A2": "-99", "ISO_A2_EH": "XK", "NAME": "Kosovo", "ADMIN": "Kosovo", "LABEL_Y": 42.57, "LABEL_X": 20.86},
+   "geometry": {"type": "Polygon", "coordinates": [[[20.0, 42.0], [21.8, 42.0], [21.8, 43.2], [20.0, 43.2], [20.0, 42.0]]]}},
+  {"type": "Feature",
+   "properties": {"ISO_A2": "-99", "ISO_A2_EH": "-99", "NAME": "N. Cyprus"},
+   "geometry": {"type": "Polygon", "coordinates": [[[33.0, 35.0], [34.0, 35.0], [34.0, 35.6], [33.0, 35.0]]]}}
+]}`
+
+func TestNaturalEarthConverter_ConvertCountries(t *testing.T) {
+	places, err := NaturalEarthConverter{SimplifyTolerance: 0.05}.ConvertCountries(strings.NewReader(naturalEarthCountriesSample))
+	require.NoError(t, err)
+
+	// [RO] Teritoriile fără cod ISO sunt ignorate; codul alternativ (ISO_A2_EH) este folosit.
+	require.Len(t, places, 1)
+	assert.Equal(t, "XK", places[0].Code)
+	assert.Equal(t, 42.57, places[0].Latitude)
+	assert.Empty(t, places[0].Aliases)
+	require.Len(t, places[0].Polygons, 1)
+	assert.Len(t, places[0].Polygons[0], 4, "inelul închis este returnat deschis")
+
+	// [RO] Fișierul scris se încarcă înapoi ca gazetteer funcțional.
+	var buffer bytes.Buffer
+	require.NoError(t, WriteGazetteer(&buffer, "test", places))
+	gazetteer, err := LoadGazetteer(&buffer)
+	require.NoError(t, err)
+	country, _ := gazetteer.ReverseGeocode(42.66, 21.16) // Pristina
+	require.NotNil(t, country)
+	assert.Equal(t, "XK", country.Code)
+}
+
+func TestSimplifyRing_DropsCollinearPoints(t *testing.T) {
+	ring := [][2]float64{{0, 0}, {1, 0.001}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}
+
+	assert.Equal(t, [][2]float64{{0, 0}, {2, 0}, {2, 2}, {0, 2}}, SimplifyRing(ring, 0.01))
+	assert.Len(t, SimplifyRing(ring, 0), 5, "fără toleranță păstrăm tot")
+}
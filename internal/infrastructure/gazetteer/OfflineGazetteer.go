@@ -0,0 +1,168 @@
+package gazetteer
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/yourorg/truthweave/internal/domain/entity"
+	"github.com/yourorg/truthweave/internal/domain/geo"
+)
+
+// [RO] Gazetteer-ul inclus în binar
+// Un set de bază: toate țările (centroid + rază), județele României și statele SUA,
+// cu contururi simplificate pentru câteva țări. În producție se recomandă fișierul
+// generat din Natural Earth (vezi cmd/gazetteerbuild), încărcat prin GAZETTEER_PATH.
+//
+//go:embed data/gazetteer.json
+var embeddedGazetteer []byte
+
+const (
+	// [RO] Toleranța de coastă: un punct aflat la mai puțin de atât în afara conturului
+	// (port, larg, contur simplificat) aparține încă țării.
+	coastalToleranceKm = 50.0
+
+	// [RO] Pentru locurile fără contur acceptăm puncte până la atâtea raze de centroid.
+	// Țările alungite (Chile, Rusia) nu sunt cercuri; fără contur nu putem fi mai stricți.
+	maxRadiusMultiple = 2.0
+)
+
+// [RO] Formatul fișierului (același pentru seed și pentru exportul din Natural Earth)
+type gazetteerFile struct {
+	Source string      `json:"source,omitempty"`
+	Places []geo.Place `json:"places"`
+}
+
+// [RO] Gazetteer Offline (în memorie)
+// Implementează geo.GazetteerLookupInterface. Sigur pentru citiri concurente după construcție.
+type OfflineGazetteer struct {
+	countries []geo.Place
+	regions   map[string][]geo.Place // Cheie: codul țării
+	byName    map[string]*geo.Place  // Cheie: nume normalizat (entity.NormalizeName)
+}
+
+// [RO] Constructor
+// Fără cale: setul inclus în binar. Cu cale: fișierul JSON indicat (ex: exportul Natural Earth).
+func NewOfflineGazetteer(overridePath string) (*OfflineGazetteer, error) {
+	if overridePath == "" {
+		return LoadGazetteer(bytes.NewReader(embeddedGazetteer))
+	}
+
+	file, err := os.Open(overridePath)
+	if err != nil {
+		return nil, fmt.Errorf("[RO] Eroare Gazetteer: Nu am putut deschide %s: %w", overridePath, err)
+	}
+	defer file.Close()
+	return LoadGazetteer(file)
+}
+
+// [RO] Încărcare din JSON
+func LoadGazetteer(reader io.Reader) (*OfflineGazetteer, error) {
+	var payload gazetteerFile
+	if err := json.NewDecoder(reader).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("[RO] Eroare Gazetteer: JSON invalid: %w", err)
+	}
+
+	gazetteer := &OfflineGazetteer{
+		regions: map[string][]geo.Place{},
+		byName:  map[string]*geo.Place{},
+	}
+	for _, place := range payload.Places {
+		switch place.Level {
+		case geo.LevelCountry:
+			gazetteer.countries = append(gazetteer.countries, place)
+		case geo.LevelRegion:
+			gazetteer.regions[place.CountryCode] = append(gazetteer.regions[place.CountryCode], place)
+		}
+	}
+	if len(gazetteer.countries) == 0 {
+		return nil, errors.New("[RO] Eroare Gazetteer: Fișierul nu conține nicio țară.")
+	}
+
+	// [RO] Indexul de nume: întâi țările, apoi regiunile (care nu pot suprascrie o țară).
+	for i := range gazetteer.countries {
+		gazetteer.indexPlace(&gazetteer.countries[i])
+	}
+	countryCodes := make([]string, 0, len(gazetteer.regions))
+	for code := range gazetteer.regions {
+		countryCodes = append(countryCodes, code)
+	}
+	sort.Strings(countryCodes)
+	for _, code := range countryCodes {
+		regions := gazetteer.regions[code]
+		for i := range regions {
+			gazetteer.indexPlace(&regions[i])
+		}
+	}
+	return gazetteer, nil
+}
+
+// [RO] Indexare după nume, alias-uri și cod ISO (primul venit câștigă)
+func (gazetteer *OfflineGazetteer) indexPlace(place *geo.Place) {
+	names := append([]string{place.Name, place.Code}, place.Aliases...)
+	for _, name := range names {
+		key := entity.NormalizeName(name, entity.TypePlace)
+		if key == "" {
+			continue
+		}
+		if _, taken := gazetteer.byName[key]; !taken {
+			gazetteer.byName[key] = place
+		}
+	}
+}
+
+// [RO] Caută un Loc după Nume
+func (gazetteer *OfflineGazetteer) LookupPlaceByName(name string) (*geo.Place, bool) {
+	place, ok := gazetteer.byName[entity.NormalizeName(name, entity.TypePlace)]
+	return place, ok
+}
+
+// [RO] Geocodare Inversă
+// Țara se caută pe tot globul; regiunea doar printre regiunile țării găsite.
+func (gazetteer *OfflineGazetteer) ReverseGeocode(latitude float64, longitude float64) (*geo.Place, *geo.Place) {
+	country := nearestPlace(gazetteer.countries, latitude, longitude)
+	if country == nil {
+		return nil, nil
+	}
+	return country, nearestPlace(gazetteer.regions[country.Code], latitude, longitude)
+}
+
+// [RO] Alegerea Locului pentru un Punct
+//  1. Locurile care conțin punctul: câștigă cel mai mic (enclave, capitale în județe).
+//  2. Altfel, cel mai apropiat: conturul în limita toleranței de coastă, sau cercul
+//     în limita a maxRadiusMultiple raze (distanța relativă la rază decide).
+func nearestPlace(places []geo.Place, latitude float64, longitude float64) *geo.Place {
+	var containing *geo.Place
+	for i := range places {
+		if places[i].Contains(latitude, longitude) && (containing == nil || places[i].AreaKm2() < containing.AreaKm2()) {
+			containing = &places[i]
+		}
+	}
+	if containing != nil {
+		return containing
+	}
+
+	var nearest *geo.Place
+	bestScore := math.Inf(1)
+	for i := range places {
+		place := &places[i]
+		var score float64
+		if place.HasPolygons() {
+			score = place.DistanceKm(latitude, longitude) / coastalToleranceKm
+		} else if place.RadiusKm > 0 {
+			score = geo.DistanceKm(latitude, longitude, place.Latitude, place.Longitude) / place.RadiusKm / maxRadiusMultiple
+		} else {
+			continue
+		}
+		if score <= 1 && score < bestScore {
+			nearest, bestScore = place, score
+		}
+	}
+	return nearest
+}
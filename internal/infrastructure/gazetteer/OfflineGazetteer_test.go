@@ -0,0 +1,84 @@
+package gazetteer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineGazetteer_LookupPlaceByName(t *testing.T) {
+	gazetteer, err := NewOfflineGazetteer("")
+	require.NoError(t, err)
+
+	// [RO] Nume oficial, alias fără diacritice, cod ISO
+	romania, ok := gazetteer.LookupPlaceByName("România")
+	require.True(t, ok)
+	assert.Equal(t, "RO", romania.Code)
+
+	cluj, ok := gazetteer.LookupPlaceByName("Cluj-Napoca")
+	require.True(t, ok)
+	assert.Equal(t, "RO-CJ", cluj.Code)
+	assert.Equal(t, "RO", cluj.CountryCode)
+
+	usa, ok := gazetteer.LookupPlaceByName("United States of America")
+	require.True(t, ok)
+	assert.Equal(t, "US", usa.Code)
+
+	// [RO] Ambiguu: țara bate statul american
+	georgia, ok := gazetteer.LookupPlaceByName("Georgia")
+	require.True(t, ok)
+	assert.Equal(t, "GE", georgia.Code)
+
+	_, ok = gazetteer.LookupPlaceByName("Atlantis")
+	assert.False(t, ok)
+}
+
+func TestOfflineGazetteer_ReverseGeocode(t *testing.T) {
+	gazetteer, err := NewOfflineGazetteer("")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name            string
+		lat, lng        float64
+		country, region string
+	}{
+		{"Bucharest (capitala bate Ilfov)", 44.43, 26.10, "RO", "RO-B"},
+		{"Iasi (lângă Prut)", 47.16, 27.59, "RO", "RO-IS"},
+		{"Chisinau (contur, nu cercul Ucrainei)", 47.01, 28.86, "MD", ""},
+		{"Constanta in larg (toleranța de coastă)", 44.17, 28.85, "RO", "RO-CT"},
+		{"Albany", 42.65, -73.75, "US", "US-NY"},
+		{"Toronto (conturul Canadei)", 43.65, -79.38, "CA", ""},
+		{"Victoria (insula Vancouver)", 48.43, -123.37, "CA", ""},
+		{"Monterrey", 25.67, -100.31, "MX", ""},
+		{"Anchorage", 61.22, -149.9, "US", "US-AK"},
+		{"Paris", 48.86, 2.35, "FR", ""},
+		{"Vienna", 48.21, 16.37, "AT", ""},
+	}
+	for _, tc := range cases {
+		country, region := gazetteer.ReverseGeocode(tc.lat, tc.lng)
+		require.NotNil(t, country, tc.name)
+		assert.Equal(t, tc.country, country.Code, tc.name)
+		if tc.region == "" {
+			assert.Nil(t, region, tc.name)
+		} else if assert.NotNil(t, region, tc.name) {
+			assert.Equal(t, tc.region, region.Code, tc.name)
+		}
+	}
+
+	// [RO] Null Island și mijlocul Pacificului: niciun loc
+	country, region := gazetteer.ReverseGeocode(0, 0)
+	assert.Nil(t, country)
+	assert.Nil(t, region)
+	country, _ = gazetteer.ReverseGeocode(-30, -140)
+	assert.Nil(t, country)
+}
+
+func TestLoadGazetteer_RejectsEmptyFile(t *testing.T) {
+	_, err := LoadGazetteer(strings.NewReader(`{"places": []}`))
+	assert.Error(t, err)
+
+	_, err = LoadGazetteer(strings.NewReader(`{"places": [`))
+	assert.Error(t, err)
+}
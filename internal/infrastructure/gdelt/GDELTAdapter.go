@@ -25,10 +25,39 @@ func NewGDELTAdapter() *GDELTAdapter {
 	}
 }
 
+// PrioritizedEvents splits the latest GDELT update into two lists of source URLs.
+// Trending holds events whose actors or location match a surging topic (any tone);
+// HighImpact holds the remaining events with |tone| > 5.
+type PrioritizedEvents struct {
+	Trending   []string
+	HighImpact []string
+}
+
+// GDELT 2.0 event columns used for classification.
+const (
+	columnActor1Name     = 6
+	columnActor2Name     = 16
+	columnAvgTone        = 34
+	columnActionGeoName  = 52
+	minEventColumns      = 58
+	highImpactToneCutoff = 5.0
+	minTrendingTermRunes = 3
+)
+
 // FetchHighImpactEvents downloads the latest GDELT Events CSV updates,
 // filters by Tone (Impact > 5.0), and returns the Source URLs.
-// Uses Go Iterators pattern (streaming processing) to minimize RAM usage.
 func (adapter *GDELTAdapter) FetchHighImpactEvents(ctx context.Context) ([]string, error) {
+	events, err := adapter.FetchPrioritizedEvents(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return events.HighImpact, nil
+}
+
+// FetchPrioritizedEvents downloads the latest GDELT Events CSV update and classifies
+// every event against the trending terms (entity names from trend signals).
+// Uses Go Iterators pattern (streaming processing) to minimize RAM usage.
+func (adapter *GDELTAdapter) FetchPrioritizedEvents(ctx context.Context, trendingTerms []string) (*PrioritizedEvents, error) {
 	// 1. Get the URL of the latest update zip
 	fileURL, err := adapter.getLastUpdateURL(ctx)
 	if err != nil {
@@ -51,11 +80,7 @@ func (adapter *GDELTAdapter) FetchHighImpactEvents(ctx context.Context) ([]strin
 		return nil, fmt.Errorf("GDELT download failed: %d", resp.StatusCode)
 	}
 
-	// Read the whole zip into memory? GDELT files are ~10MB compressed, okay for RAM.
-	// For true streaming of ZIPs without reading full body, we need standard zip not supporting seek?
-	// Go's zip.NewReader requires ReaderAt (seekable).
-	// To strictly follow "Stream CSV" without loading ZIP to RAM, we'd need a custom unzip stream.
-	// However, 5-10MB is negligible. We'll read the body buffer.
+	// GDELT files are ~10MB compressed; zip.NewReader needs a ReaderAt, so we buffer the body.
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
@@ -67,11 +92,12 @@ func (adapter *GDELTAdapter) FetchHighImpactEvents(ctx context.Context) ([]strin
 	}
 
 	// 3. Process CSV inside ZIP
-	var highImpactURLs []string
+	terms := normalizeTrendingTerms(trendingTerms)
+	events := &PrioritizedEvents{}
 
 	// Usually there is only one .export.CSV file in the zip
 	for _, zipFile := range zipReader.File {
-		if !strings.HasSuffix(zipFile.Name, ".csv") {
+		if !strings.HasSuffix(strings.ToLower(zipFile.Name), ".csv") {
 			continue
 		}
 
@@ -95,37 +121,68 @@ func (adapter *GDELTAdapter) FetchHighImpactEvents(ctx context.Context) ([]strin
 				continue // Skip malformed lines
 			}
 
-			// GDELT 2.0 Columns of Interest (Approximate indices):
-			// 34: AvgTone (float)
-			// 60: SourceURL (Last One) - Let's assume last one for safety or index 57/60 depending on version.
-			// Actually GDELT 2.0 has 58 columns usually. SourceURL is last.
-
-			if len(record) < 58 {
-				continue
+			url, trending, highImpact := classifyEventRecord(record, terms)
+			switch {
+			case trending:
+				events.Trending = append(events.Trending, url)
+			case highImpact:
+				events.HighImpact = append(events.HighImpact, url)
 			}
+		}
+	}
 
-			// Parse Tone (Index 34 in GDELT 2.0)
-			toneStr := record[34]
-			sourceURL := record[len(record)-1] // URL is always last
+	// Deduplicate locally; a URL that is trending is not repeated in the high-impact list.
+	events.Trending = uniqueStrings(events.Trending)
+	trendingSet := make(map[string]bool, len(events.Trending))
+	for _, url := range events.Trending {
+		trendingSet[url] = true
+	}
+	var highImpact []string
+	for _, url := range uniqueStrings(events.HighImpact) {
+		if !trendingSet[url] {
+			highImpact = append(highImpact, url)
+		}
+	}
+	events.HighImpact = highImpact
+	return events, nil
+}
 
-			tone, err := strconv.ParseFloat(toneStr, 64)
-			if err != nil {
-				continue
-			}
+// classifyEventRecord returns the event's source URL (always the last column) and whether it
+// matches a trending term (actor names or action location) or passes the |tone| > 5 filter.
+func classifyEventRecord(record []string, upperTerms []string) (string, bool, bool) {
+	if len(record) < minEventColumns {
+		return "", false, false
+	}
+	url := strings.TrimSpace(record[len(record)-1])
+	if url == "" || !strings.HasPrefix(url, "http") {
+		return "", false, false
+	}
 
-			// FILTER: |Tone| > 5.0 (High Emotional Impact)
-			if math.Abs(tone) > 5.0 {
-				// Cleanup URL
-				url := strings.TrimSpace(sourceURL)
-				if url != "" && strings.HasPrefix(url, "http") {
-					highImpactURLs = append(highImpactURLs, url)
-				}
-			}
+	haystack := strings.ToUpper(record[columnActor1Name] + " | " + record[columnActor2Name] + " | " + record[columnActionGeoName])
+	for _, term := range upperTerms {
+		if strings.Contains(haystack, term) {
+			return url, true, false
 		}
 	}
 
-	// Deduplicate locally
-	return uniqueStrings(highImpactURLs), nil
+	tone, err := strconv.ParseFloat(record[columnAvgTone], 64)
+	if err != nil {
+		return "", false, false
+	}
+	return url, false, math.Abs(tone) > highImpactToneCutoff
+}
+
+// normalizeTrendingTerms upper-cases the terms (GDELT actor names are upper case)
+// and drops the very short ones, which would match almost any event.
+func normalizeTrendingTerms(terms []string) []string {
+	var normalized []string
+	for _, term := range terms {
+		term = strings.ToUpper(strings.TrimSpace(term))
+		if len([]rune(term)) >= minTrendingTermRunes {
+			normalized = append(normalized, term)
+		}
+	}
+	return uniqueStrings(normalized)
 }
 
 func (adapter *GDELTAdapter) getLastUpdateURL(ctx context.Context) (string, error) {
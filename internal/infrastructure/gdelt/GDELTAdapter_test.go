@@ -0,0 +1,42 @@
+package gdelt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func eventRecord(actor1 string, actor2 string, geo string, tone string, url string) []string {
+	record := make([]string, 61)
+	record[columnActor1Name] = actor1
+	record[columnActor2Name] = actor2
+	record[columnAvgTone] = tone
+	record[columnActionGeoName] = geo
+	record[len(record)-1] = url
+	return record
+}
+
+func TestClassifyEventRecord(t *testing.T) {
+	terms := normalizeTrendingTerms([]string{"Volodymyr Zelensky", "nato", "EU", " "})
+	assert.Equal(t, []string{"VOLODYMYR ZELENSKY", "NATO"}, terms) // [RO] "EU" e prea scurt
+
+	// [RO] Trend: orice ton, potrivire pe actor
+	url, trending, highImpact := classifyEventRecord(eventRecord("NATO", "", "Brussels, Belgium", "-1.2", "https://a.example/1"), terms)
+	assert.Equal(t, "https://a.example/1", url)
+	assert.True(t, trending)
+	assert.False(t, highImpact)
+
+	// [RO] Fără trend: doar filtrul |ton| > 5
+	_, trending, highImpact = classifyEventRecord(eventRecord("FARMER", "POLICE", "Paris, France", "-7.5", "https://a.example/2"), terms)
+	assert.False(t, trending)
+	assert.True(t, highImpact)
+
+	_, trending, highImpact = classifyEventRecord(eventRecord("FARMER", "POLICE", "Paris, France", "2.0", "https://a.example/3"), terms)
+	assert.False(t, trending || highImpact)
+
+	// [RO] Rând trunchiat sau fără URL
+	_, trending, highImpact = classifyEventRecord([]string{"NATO"}, terms)
+	assert.False(t, trending || highImpact)
+	_, trending, _ = classifyEventRecord(eventRecord("NATO", "", "", "0", "not-a-url"), terms)
+	assert.False(t, trending)
+}
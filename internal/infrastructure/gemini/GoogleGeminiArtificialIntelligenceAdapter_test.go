@@ -0,0 +1,35 @@
+package gemini
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitForEmbedding_CoversLongTextWithoutBreakingWords(t *testing.T) {
+	text := strings.TrimSpace(strings.Repeat("cuvânt ", 2500)) // ~17.500 caractere
+
+	windows := splitForEmbedding(text, maxEmbeddingInputRunes)
+
+	require.Len(t, windows, 3)
+	total := 0
+	for _, window := range windows {
+		assert.LessOrEqual(t, utf8.RuneCountInString(window), maxEmbeddingInputRunes)
+		assert.NotContains(t, " "+window+" ", " uvânt ", "[RO] Niciun cuvânt tăiat")
+		total += len(strings.Fields(window))
+	}
+	assert.Equal(t, 2500, total, "[RO] Tot textul ajunge la model")
+
+	assert.Equal(t, []string{"scurt"}, splitForEmbedding("scurt", maxEmbeddingInputRunes))
+}
+
+func TestMeanPooledVector_IsUnitLength(t *testing.T) {
+	pooled := meanPooledVector([][]float32{{1, 0}, {0, 1}})
+
+	assert.InDelta(t, math.Sqrt(0.5), pooled[0], 1e-6)
+	assert.InDelta(t, math.Sqrt(0.5), pooled[1], 1e-6)
+}
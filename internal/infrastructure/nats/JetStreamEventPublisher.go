@@ -3,9 +3,11 @@ package nats
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/nats-io/nats.go"
+	"github.com/yourorg/truthweave/internal/domain/trend"
 )
 
 // [RO] Publicator de Evenimente (NATS JetStream)
@@ -33,12 +35,16 @@ func NewJetStreamEventPublisher(url string) (*JetStreamEventPublisher, error) {
 
 	// [RO] 3. Asigurare Stream (Canal de Comunicare)
 	// Creăm canalul "TRUTHWEAVE_EVENTS" dacă nu există.
-	_, err = js.AddStream(&nats.StreamConfig{
+	streamConfig := &nats.StreamConfig{
 		Name:     "TRUTHWEAVE_EVENTS",
-		Subjects: []string{"article.>"}, // Ascultă orice subiect care începe cu "article."
-	})
+		Subjects: []string{"article.>", "trend.>"}, // Articole analizate și semnale de trend
+	}
+	if _, err = js.AddStream(streamConfig); errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		// Stream-ul există deja (eventual doar cu "article.>"): îi actualizăm subiectele.
+		_, err = js.UpdateStream(streamConfig)
+	}
 	if err != nil {
-		// Ignorăm eroarea dacă stream-ul există deja.
+		return nil, fmt.Errorf("[RO] Eroare: Stream-ul TRUTHWEAVE_EVENTS nu a putut fi creat sau actualizat: %w", err)
 	}
 
 	return &JetStreamEventPublisher{jetStreamContext: js}, nil
@@ -64,3 +70,17 @@ func (publisher *JetStreamEventPublisher) PublishEvent(executionContext context.
 
 	return nil
 }
+
+// [RO] Publică un Semnal de Trend ("breaking")
+// Subiect: "trend.detected". Abonații (notificări push, panouri) primesc semnalul complet.
+func (publisher *JetStreamEventPublisher) PublishTrendDetected(executionContext context.Context, signal trend.TrendSignal) error {
+	data, err := json.Marshal(signal)
+	if err != nil {
+		return fmt.Errorf("[RO] Eroare: Nu am putut serializa semnalul: %w", err)
+	}
+
+	if _, err := publisher.jetStreamContext.Publish("trend.detected", data, nats.Context(executionContext)); err != nil {
+		return fmt.Errorf("[RO] Eroare: Nu am putut publica în NATS: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/analytics"
+)
+
+// [RO] Depozit Agregate Analitice (PostgreSQL)
+//
+// Serii de timp pre-calculate în tabelul `analytics_rollups`, reîmprospătate periodic de
+// AnalyticsRollupWorkflow. API-ul citește doar agregatele, niciodată tabelul `articles`.
+// Implementează interfața `analytics.AnalyticsRollupPersistenceInterface`.
+type PostgresAnalyticsRollupRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Agregate
+func NewPostgresAnalyticsRollupRepository(db *sql.DB) *PostgresAnalyticsRollupRepository {
+	return &PostgresAnalyticsRollupRepository{databaseConnection: db}
+}
+
+// [RO] Emoția normalizată ("fear " -> "Fear"; lipsă -> "Neutral")
+const rollupEmotionExpression = `COALESCE(NULLIF(initcap(lower(trim(global_emotion))), ''), 'Neutral')`
+
+// [RO] Agregarea finală: o linie per (dimensiune, valoare, interval), cu numărul pe emoții
+const rollupInsertFromExploded = `
+	, per_emotion AS (
+		SELECT bucket_start, dimension, dimension_value, emotion, count(*) AS articles, sum(truth_score) AS score_sum
+		FROM exploded
+		GROUP BY bucket_start, dimension, dimension_value, emotion
+	)
+	INSERT INTO analytics_rollups (granularity, dimension, dimension_value, bucket_start, article_count, truth_score_sum, emotion_counts, refreshed_at)
+	SELECT $1, dimension, dimension_value, bucket_start, sum(articles), sum(score_sum), jsonb_object_agg(emotion, articles), now()
+	FROM per_emotion
+	GROUP BY dimension, dimension_value, bucket_start
+`
+
+// [RO] Recalculare Global / Țară / Sector (Implementare)
+// Într-o singură tranzacție: ștergem intervalele din fereastră și le reconstruim din `articles`,
+// ca intervalele rămase fără articole (ex: țară corectată) să dispară.
+func (repo *PostgresAnalyticsRollupRepository) RefreshRollups(executionContext context.Context, from time.Time, to time.Time) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	for _, granularity := range []string{analytics.GranularityHour, analytics.GranularityDay} {
+		if _, err := transaction.ExecContext(executionContext, `
+			DELETE FROM analytics_rollups
+			WHERE granularity = $1 AND dimension <> $2 AND bucket_start >= $3 AND bucket_start < $4
+		`, granularity, analytics.DimensionNarrative, from, to); err != nil {
+			return err
+		}
+
+		if _, err := transaction.ExecContext(executionContext, `
+			WITH scoped AS (
+				SELECT date_trunc($1, published_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' AS bucket_start,
+				       country_code, sector, truth_score, `+rollupEmotionExpression+` AS emotion
+				FROM articles
+				WHERE published_at >= $2 AND published_at < $3
+			), exploded AS (
+				SELECT bucket_start, 'global' AS dimension, '*' AS dimension_value, truth_score, emotion FROM scoped
+				UNION ALL
+				SELECT bucket_start, 'country', country_code, truth_score, emotion FROM scoped WHERE country_code IS NOT NULL
+				UNION ALL
+				SELECT bucket_start, 'sector', sector, truth_score, emotion FROM scoped WHERE sector IS NOT NULL
+			)`+rollupInsertFromExploded, granularity, from, to); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+// [RO] Recalculare pe Narațiuni (Implementare)
+// Apartenența vine din graf (narațiune -> grupuri de poveste); o trimitem ca perechi de tablouri.
+func (repo *PostgresAnalyticsRollupRepository) RefreshNarrativeRollups(executionContext context.Context, narratives map[string][]string, from time.Time, to time.Time) error {
+	var narrativeIDs, clusterIDs []string
+	for narrativeID, clusters := range narratives {
+		for _, cluster := range clusters {
+			narrativeIDs = append(narrativeIDs, narrativeID)
+			clusterIDs = append(clusterIDs, cluster)
+		}
+	}
+
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	for _, granularity := range []string{analytics.GranularityHour, analytics.GranularityDay} {
+		if _, err := transaction.ExecContext(executionContext, `
+			DELETE FROM analytics_rollups
+			WHERE granularity = $1 AND dimension = $2 AND bucket_start >= $3 AND bucket_start < $4
+		`, granularity, analytics.DimensionNarrative, from, to); err != nil {
+			return err
+		}
+		if len(narrativeIDs) == 0 {
+			continue
+		}
+
+		if _, err := transaction.ExecContext(executionContext, `
+			WITH membership AS (
+				SELECT narrative_id, story_cluster_id
+				FROM unnest($4::text[], $5::uuid[]) AS m(narrative_id, story_cluster_id)
+			), exploded AS (
+				SELECT date_trunc($1, a.published_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' AS bucket_start,
+				       'narrative' AS dimension, m.narrative_id AS dimension_value, a.truth_score,
+				       `+rollupEmotionExpression+` AS emotion
+				FROM articles a
+				JOIN membership m ON m.story_cluster_id = a.story_cluster_id
+				WHERE a.published_at >= $2 AND a.published_at < $3
+			)`+rollupInsertFromExploded, granularity, from, to, pq.Array(narrativeIDs), pq.Array(clusterIDs)); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+// [RO] Citește Seria de Timp (Implementare)
+// Lovește doar cheia primară (granularitate, dimensiune, valoare, interval).
+func (repo *PostgresAnalyticsRollupRepository) RetrieveTimeSeries(executionContext context.Context, query analytics.TimeSeriesQuery) ([]analytics.TimeSeriesBucket, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT bucket_start, article_count, truth_score_sum, emotion_counts
+		FROM analytics_rollups
+		WHERE granularity = $1 AND dimension = $2 AND dimension_value = $3
+		  AND bucket_start >= $4 AND bucket_start <= $5
+		ORDER BY bucket_start
+	`, query.Granularity, query.Dimension, query.Value, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []analytics.TimeSeriesBucket
+	for rows.Next() {
+		var bucket analytics.TimeSeriesBucket
+		var scoreSum float64
+		var emotionCounts []byte
+		if err := rows.Scan(&bucket.BucketStart, &bucket.ArticleCount, &scoreSum, &emotionCounts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(emotionCounts, &bucket.EmotionCounts); err != nil {
+			return nil, err
+		}
+		if bucket.ArticleCount > 0 {
+			bucket.AverageTruthScore = scoreSum / float64(bucket.ArticleCount)
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, rows.Err()
+}
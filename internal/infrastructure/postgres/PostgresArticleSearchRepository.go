@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/yourorg/truthweave/internal/domain/search"
+)
+
+// [RO] Depozit Căutare Hibridă (PostgreSQL)
+//
+// Două liste de rang peste aceleași filtre: full-text (`search_document`, tsvector) și
+// semantică (`embedding`, pgvector). Fuziunea lor se face în `search.FuseReciprocalRank`.
+// Implementează interfața `search.SearchPersistenceInterface`.
+type PostgresArticleSearchRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Căutare
+func NewPostgresArticleSearchRepository(db *sql.DB) *PostgresArticleSearchRepository {
+	return &PostgresArticleSearchRepository{databaseConnection: db}
+}
+
+// [RO] Filtrul Comun al Căutării ($2..$7)
+// Fereastra de timp, domeniul sursei, intervalul scorului de adevăr și emoția.
+const searchFilterClause = `
+	($2::timestamptz IS NULL OR a.published_at >= $2)
+	AND ($3::timestamptz IS NULL OR a.published_at <= $3)
+	AND ($4 = '' OR a.source_domain = $4)
+	AND ($5::double precision IS NULL OR a.truth_score >= $5)
+	AND ($6::double precision IS NULL OR a.truth_score <= $6)
+	AND ($7 = '' OR lower(a.global_emotion) = lower($7))
+`
+
+// [RO] Fragmentul afișat: cel mult două bucăți din conținut, cu termenii căutați marcați.
+// Se calculează doar pentru rândurile deja selectate (ts_headline e scump pe texte lungi).
+// Conținutul vine de pe web: `&`, `<`, `>` și `"` sunt scăpate înainte de ts_headline, deci singurul
+// HTML din fragment sunt marcajele HighlightStart/HighlightStop.
+const searchSnippetSelect = `
+	SELECT a.id, a.original_url, a.title, a.truth_score, COALESCE(a.global_emotion, ''), a.published_at,
+	       ts_headline('simple',
+	                   replace(replace(replace(replace(COALESCE(NULLIF(a.content, ''), a.summary), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+	                   websearch_to_tsquery('simple', $1),
+	                   'StartSel=` + search.HighlightStart + `, StopSel=` + search.HighlightStop + `, MinWords=15, MaxWords=35, MaxFragments=2, FragmentDelimiter=" … "')
+	FROM ranked r
+	JOIN articles a ON a.id = r.id
+	ORDER BY r.position
+`
+
+func searchFilterArgs(query search.SearchQuery) []interface{} {
+	nullableScore := func(bound *float64) sql.NullFloat64 {
+		if bound == nil {
+			return sql.NullFloat64{}
+		}
+		return sql.NullFloat64{Float64: *bound, Valid: true}
+	}
+	return []interface{}{
+		query.Text,
+		sql.NullTime{Time: query.From, Valid: !query.From.IsZero()},
+		sql.NullTime{Time: query.To, Valid: !query.To.IsZero()},
+		query.Source,
+		nullableScore(query.MinTruthScore),
+		nullableScore(query.MaxTruthScore),
+		query.Emotion,
+	}
+}
+
+// [RO] Căutare Full-Text (Implementare)
+// `websearch_to_tsquery` acceptă sintaxa obișnuită: "expresie exactă", OR, -exclus.
+// Relevanța (ts_rank_cd) ține cont de ponderi: titlul bate rezumatul, rezumatul bate conținutul.
+func (repo *PostgresArticleSearchRepository) SearchArticlesFullText(executionContext context.Context, query search.SearchQuery, limit int) ([]search.SearchCandidate, error) {
+	sqlQuery := `
+		WITH ranked AS (
+			SELECT a.id, row_number() OVER (
+			           ORDER BY ts_rank_cd(a.search_document, websearch_to_tsquery('simple', $1)) DESC, a.published_at DESC
+			       ) AS position
+			FROM articles a
+			WHERE a.search_document @@ websearch_to_tsquery('simple', $1)
+			  AND ` + searchFilterClause + `
+			ORDER BY position
+			LIMIT $8
+		)` + searchSnippetSelect
+
+	args := append(searchFilterArgs(query), limit)
+	return repo.queryCandidates(executionContext, sqlQuery, args...)
+}
+
+// [RO] Căutare Semantică (Implementare)
+// Cei mai apropiați vecini după distanța cosine; articolele fără vector nu participă.
+func (repo *PostgresArticleSearchRepository) SearchArticlesSemantic(executionContext context.Context, embedding []float32, query search.SearchQuery, limit int) ([]search.SearchCandidate, error) {
+	sqlQuery := `
+		WITH ranked AS (
+			SELECT a.id, row_number() OVER (ORDER BY a.embedding <=> $8) AS position
+			FROM articles a
+			WHERE a.embedding IS NOT NULL
+			  AND ` + searchFilterClause + `
+			ORDER BY a.embedding <=> $8
+			LIMIT $9
+		)` + searchSnippetSelect
+
+	args := append(searchFilterArgs(query), pgvector.NewVector(embedding), limit)
+	return repo.queryCandidates(executionContext, sqlQuery, args...)
+}
+
+func (repo *PostgresArticleSearchRepository) queryCandidates(executionContext context.Context, sqlQuery string, args ...interface{}) ([]search.SearchCandidate, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []search.SearchCandidate
+	for rows.Next() {
+		var candidate search.SearchCandidate
+		if err := rows.Scan(&candidate.Article.ID, &candidate.Article.OriginalURL, &candidate.Article.Title,
+			&candidate.Article.TruthScore, &candidate.Article.GlobalEmotion, &candidate.Article.PublishedAt,
+			&candidate.Snippet); err != nil {
+			return nil, err
+		}
+		candidate.Rank = len(candidates) + 1
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
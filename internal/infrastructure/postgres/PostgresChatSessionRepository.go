@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/chat"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
+)
+
+// [RO] Depozit Conversații Oracle (PostgreSQL)
+//
+// Sesiunile și replicile lor, plus rezumatul replicilor vechi.
+// Implementează interfața `chat.ChatSessionPersistenceInterface`.
+type PostgresChatSessionRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Conversații
+func NewPostgresChatSessionRepository(db *sql.DB) *PostgresChatSessionRepository {
+	return &PostgresChatSessionRepository{databaseConnection: db}
+}
+
+// [RO] Creează Sesiunea
+func (repo *PostgresChatSessionRepository) CreateChatSession(executionContext context.Context, session chat.ChatSession) error {
+	sqlQuery := `
+		INSERT INTO chat_sessions (id, article_id, summary, summarized_through, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := repo.databaseConnection.ExecContext(executionContext, sqlQuery,
+		session.ID, nullableUUID(session.ArticleID), session.Summary, session.SummarizedThrough, session.CreatedAt, session.UpdatedAt)
+	return err
+}
+
+// [RO] Sesiunea și Replicile Ei
+func (repo *PostgresChatSessionRepository) RetrieveChatSession(executionContext context.Context, id uuid.UUID) (*chat.ChatSession, []chat.ChatTurn, error) {
+	var session chat.ChatSession
+	var articleID uuid.NullUUID
+	err := repo.databaseConnection.QueryRowContext(executionContext, `
+		SELECT id, article_id, summary, summarized_through, created_at, updated_at
+		FROM chat_sessions WHERE id = $1
+	`, id).Scan(&session.ID, &articleID, &session.Summary, &session.SummarizedThrough, &session.CreatedAt, &session.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil, chat.ErrChatSessionNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	session.ArticleID = articleID.UUID
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT sequence, role, content, retrieval_query, citations, grounded_citations, created_at
+		FROM chat_turns WHERE session_id = $1
+		ORDER BY sequence ASC
+	`, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var turns []chat.ChatTurn
+	for rows.Next() {
+		turn := chat.ChatTurn{SessionID: id}
+		var articleIDs []string
+		var groundedCitations []byte
+		if err := rows.Scan(&turn.Sequence, &turn.Role, &turn.Content, &turn.RetrievalQuery, pq.Array(&articleIDs), &groundedCitations, &turn.CreatedAt); err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal(groundedCitations, &turn.Citations); err != nil {
+			return nil, nil, err
+		}
+
+		// [RO] Replicile dinaintea citărilor ancorate au doar ID-urile articolelor
+		if len(turn.Citations) == 0 {
+			for _, articleID := range articleIDs {
+				if parsed, err := uuid.Parse(articleID); err == nil {
+					turn.Citations = append(turn.Citations, grounding.Citation{ArticleID: parsed})
+				}
+			}
+		}
+		turns = append(turns, turn)
+	}
+	return &session, turns, rows.Err()
+}
+
+// [RO] Adaugă Replici
+// Cheia (session_id, sequence) împiedică două mesaje simultane să primească același număr.
+func (repo *PostgresChatSessionRepository) AppendChatTurns(executionContext context.Context, turns []chat.ChatTurn) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	for _, turn := range turns {
+		citations := turn.Citations
+		if citations == nil {
+			citations = []grounding.Citation{}
+		}
+		groundedCitations, err := json.Marshal(citations)
+		if err != nil {
+			return err
+		}
+		if _, err := transaction.ExecContext(executionContext, `
+			INSERT INTO chat_turns (session_id, sequence, role, content, retrieval_query, citations, grounded_citations, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, turn.SessionID, turn.Sequence, turn.Role, turn.Content, turn.RetrievalQuery,
+			pq.Array(grounding.CitedArticleIDs(citations)), groundedCitations, turn.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if len(turns) > 0 {
+		if _, err := transaction.ExecContext(executionContext,
+			`UPDATE chat_sessions SET updated_at = $2 WHERE id = $1`, turns[0].SessionID, turns[len(turns)-1].CreatedAt); err != nil {
+			return err
+		}
+	}
+	return transaction.Commit()
+}
+
+// [RO] Actualizează Rezumatul
+func (repo *PostgresChatSessionRepository) UpdateChatSummary(executionContext context.Context, id uuid.UUID, summary string, summarizedThrough int) error {
+	_, err := repo.databaseConnection.ExecContext(executionContext,
+		`UPDATE chat_sessions SET summary = $2, summarized_through = $3 WHERE id = $1`, id, summary, summarizedThrough)
+	return err
+}
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/community"
+)
+
+// [RO] Depozit Evaluări ale Comunității (PostgreSQL)
+//
+// Evaluările cititorilor și semnalul de încredere calculat din ele (separat de scorul modelului).
+// Implementează interfața `community.CommunityRatingPersistenceInterface`.
+type PostgresCommunityRatingRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Evaluări Comunitate
+func NewPostgresCommunityRatingRepository(db *sql.DB) *PostgresCommunityRatingRepository {
+	return &PostgresCommunityRatingRepository{databaseConnection: db}
+}
+
+// [RO] Salvează Evaluarea (o înlocuiește pe cea anterioară a aceluiași cititor)
+func (repo *PostgresCommunityRatingRepository) SaveRating(executionContext context.Context, rating community.Rating) error {
+	_, err := repo.databaseConnection.ExecContext(executionContext, `
+		INSERT INTO community_ratings (item_type, article_id, dimension, rater_id, rating, rated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (item_type, article_id, dimension, rater_id)
+		DO UPDATE SET rating = EXCLUDED.rating, rated_at = EXCLUDED.rated_at
+	`, rating.ItemType, rating.ArticleID, rating.Dimension, rating.RaterID, rating.Rating, rating.RatedAt)
+
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return community.ErrRatedArticleNotFound
+	}
+	return err
+}
+
+// [RO] Înregistrează o Identitate de Evaluator Emisă
+func (repo *PostgresCommunityRatingRepository) SaveRaterIssuance(executionContext context.Context, raterID string, issuerFingerprint string, issuedAt time.Time) error {
+	_, err := repo.databaseConnection.ExecContext(executionContext, `
+		INSERT INTO community_raters (rater_id, issuer_fingerprint, issued_at)
+		VALUES ($1, $2, $3)
+	`, raterID, issuerFingerprint, issuedAt)
+	return err
+}
+
+// [RO] Câte Identități a Primit Clientul de la `since` Încoace
+func (repo *PostgresCommunityRatingRepository) CountRatersIssued(executionContext context.Context, issuerFingerprint string, since time.Time) (int, error) {
+	var count int
+	err := repo.databaseConnection.QueryRowContext(executionContext, `
+		SELECT COUNT(*) FROM community_raters
+		WHERE issuer_fingerprint = $1 AND issued_at >= $2
+	`, issuerFingerprint, since).Scan(&count)
+	return count, err
+}
+
+// [RO] Evaluările unei Dimensiuni (toate elementele)
+func (repo *PostgresCommunityRatingRepository) RetrieveRatings(executionContext context.Context, dimension string) ([]community.Rating, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT item_type, article_id, dimension, rater_id, rating, rated_at
+		FROM community_ratings
+		WHERE dimension = $1
+	`, dimension)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ratings []community.Rating
+	for rows.Next() {
+		var rating community.Rating
+		if err := rows.Scan(&rating.ItemType, &rating.ArticleID, &rating.Dimension, &rating.RaterID, &rating.Rating, &rating.RatedAt); err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
+	return ratings, rows.Err()
+}
+
+// [RO] Salvează Scorurile (Tranzacție)
+func (repo *PostgresCommunityRatingRepository) SaveItemScores(executionContext context.Context, scores []community.ItemScore) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	for _, score := range scores {
+		if _, err := transaction.ExecContext(executionContext, `
+			INSERT INTO community_scores (item_type, article_id, dimension, bridging_score, polarization, rating_count, status, scored_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (item_type, article_id, dimension)
+			DO UPDATE SET bridging_score = EXCLUDED.bridging_score, polarization = EXCLUDED.polarization,
+			              rating_count = EXCLUDED.rating_count, status = EXCLUDED.status, scored_at = EXCLUDED.scored_at
+		`, score.ItemType, score.ArticleID, score.Dimension, score.BridgingScore, score.Polarization, score.RatingCount, score.Status, score.ScoredAt); err != nil {
+			return err
+		}
+	}
+	return transaction.Commit()
+}
+
+// [RO] Scorurile Articolului
+func (repo *PostgresCommunityRatingRepository) RetrieveItemScores(executionContext context.Context, articleID uuid.UUID) ([]community.ItemScore, error) {
+	return retrieveCommunityScores(executionContext, repo.databaseConnection, articleID)
+}
+
+// [RO] Semnalul comunității pentru articol și contraargument; folosit și la citirea articolului
+func retrieveCommunityScores(executionContext context.Context, db *sql.DB, articleID uuid.UUID) ([]community.ItemScore, error) {
+	rows, err := db.QueryContext(executionContext, `
+		SELECT item_type, article_id, dimension, bridging_score, polarization, rating_count, status, scored_at
+		FROM community_scores
+		WHERE article_id = $1
+		ORDER BY item_type, dimension
+	`, articleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []community.ItemScore
+	for rows.Next() {
+		var score community.ItemScore
+		if err := rows.Scan(&score.ItemType, &score.ArticleID, &score.Dimension, &score.BridgingScore, &score.Polarization, &score.RatingCount, &score.Status, &score.ScoredAt); err != nil {
+			return nil, err
+		}
+		scores = append(scores, score)
+	}
+	return scores, rows.Err()
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/review"
+)
+
+// [RO] Depozit Coadă de Verificare Editorială (PostgreSQL)
+//
+// Ciornele analizelor oprite la verificare și decizia editorului.
+// Implementează interfața `review.EditorialReviewPersistenceInterface`.
+type PostgresEditorialReviewRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Coadă de Verificare
+func NewPostgresEditorialReviewRepository(db *sql.DB) *PostgresEditorialReviewRepository {
+	return &PostgresEditorialReviewRepository{databaseConnection: db}
+}
+
+// [RO] Pune Articolul în Coadă
+// Idempotent: o reîncercare a activității nu dublează și nu redeschide verificarea.
+func (repo *PostgresEditorialReviewRepository) OpenEditorialReview(executionContext context.Context, item review.EditorialReview) error {
+	draft, err := json.Marshal(item.Draft)
+	if err != nil {
+		return err
+	}
+	_, err = repo.databaseConnection.ExecContext(executionContext, `
+		INSERT INTO editorial_reviews (article_id, workflow_id, run_id, reasons, status, draft, created_at, deadline)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (article_id) DO NOTHING
+	`, item.ArticleID, item.WorkflowID, item.RunID, pq.Array(item.Reasons), review.StatusPending, draft, item.CreatedAt, item.Deadline)
+	return err
+}
+
+// [RO] Verificările în Așteptare (cele mai vechi întâi)
+func (repo *PostgresEditorialReviewRepository) ListPendingEditorialReviews(executionContext context.Context, limit int) ([]review.EditorialReview, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, editorialReviewColumns+`
+		WHERE status = $1
+		ORDER BY created_at ASC
+		LIMIT $2
+	`, review.StatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []review.EditorialReview
+	for rows.Next() {
+		item, err := scanEditorialReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, rows.Err()
+}
+
+// [RO] O Verificare (în orice stare)
+func (repo *PostgresEditorialReviewRepository) RetrieveEditorialReview(executionContext context.Context, articleID uuid.UUID) (*review.EditorialReview, error) {
+	row := repo.databaseConnection.QueryRowContext(executionContext, editorialReviewColumns+`
+		WHERE article_id = $1
+	`, articleID)
+	item, err := scanEditorialReview(row)
+	if err == sql.ErrNoRows {
+		return nil, review.ErrReviewNotFound
+	}
+	return item, err
+}
+
+// [RO] Încheie Verificarea (decizia editorului sau termenul expirat)
+func (repo *PostgresEditorialReviewRepository) CloseEditorialReview(executionContext context.Context, resolution review.EditorialResolution) error {
+	var decision []byte
+	if resolution.Decision != nil {
+		encoded, err := json.Marshal(resolution.Decision)
+		if err != nil {
+			return err
+		}
+		decision = encoded
+	}
+	_, err := repo.databaseConnection.ExecContext(executionContext, `
+		UPDATE editorial_reviews SET status = $3, decision = $4, resolved_at = $5
+		WHERE workflow_id = $1 AND run_id = $2
+	`, resolution.WorkflowID, resolution.RunID, resolution.Status, decision, resolution.ResolvedAt)
+	return err
+}
+
+const editorialReviewColumns = `
+		SELECT article_id, workflow_id, run_id, reasons, status, draft, decision, created_at, deadline, resolved_at
+		FROM editorial_reviews`
+
+// [RO] Interfața comună a lui *sql.Row și *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEditorialReview(row rowScanner) (*review.EditorialReview, error) {
+	var item review.EditorialReview
+	var draft, decision []byte
+	var resolvedAt sql.NullTime
+	if err := row.Scan(&item.ArticleID, &item.WorkflowID, &item.RunID, pq.Array(&item.Reasons), &item.Status,
+		&draft, &decision, &item.CreatedAt, &item.Deadline, &resolvedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(draft, &item.Draft); err != nil {
+		return nil, err
+	}
+	if decision != nil {
+		item.Decision = &review.EditorialDecision{}
+		if err := json.Unmarshal(decision, item.Decision); err != nil {
+			return nil, err
+		}
+	}
+	if resolvedAt.Valid {
+		item.ResolvedAt = &resolvedAt.Time
+	}
+	return &item, nil
+}
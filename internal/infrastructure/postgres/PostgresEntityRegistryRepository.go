@@ -0,0 +1,444 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pgvector/pgvector-go"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// [RO] Depozit de Date PostgreSQL pentru Registrul de Entități
+//
+// Ține "Cartea de Identitate" a fiecărei persoane, organizații sau loc:
+// numele canonic, toate alias-urile, articolele în care apare și istoricul unificărilor.
+// Implementează interfața `EntityRegistryPersistenceInterface`.
+type PostgresEntityRegistryRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Registru Entități
+func NewPostgresEntityRegistryRepository(db *sql.DB) *PostgresEntityRegistryRepository {
+	return &PostgresEntityRegistryRepository{databaseConnection: db}
+}
+
+// [RO] Caută după Alias (Implementare)
+// Entitățile unificate (merged_into) nu mai sunt returnate; alias-urile lor au fost mutate pe țintă.
+func (repo *PostgresEntityRegistryRepository) FindEntityByAlias(executionContext context.Context, normalizedAlias string, entityType string) (*entity.CanonicalEntity, error) {
+	sqlQuery := `
+		SELECT e.id, e.canonical_name, e.type, e.created_at
+		FROM entity_aliases a
+		JOIN entities e ON e.id = a.entity_id
+		WHERE a.alias_normalized = $1 AND a.type = $2 AND e.merged_into IS NULL
+		ORDER BY e.created_at ASC
+		LIMIT 1
+	`
+
+	var found entity.CanonicalEntity
+	err := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, normalizedAlias, entityType).
+		Scan(&found.ID, &found.Name, &found.Type, &found.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &found, nil
+}
+
+// [RO] Caută după Amprentă Semantică (Implementare)
+func (repo *PostgresEntityRegistryRepository) FindEntitiesByEmbedding(executionContext context.Context, embedding []float32, entityType string, minSimilarity float64, limit int) ([]entity.EntityCandidate, error) {
+	sqlQuery := `
+		SELECT id, canonical_name, type, 1 - (embedding <=> $1) AS similarity
+		FROM entities
+		WHERE type = $2 AND merged_into IS NULL AND embedding IS NOT NULL
+		  AND 1 - (embedding <=> $1) >= $3
+		ORDER BY embedding <=> $1 ASC
+		LIMIT $4
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, pgvector.NewVector(embedding), entityType, minSimilarity, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []entity.EntityCandidate
+	for rows.Next() {
+		var candidate entity.EntityCandidate
+		if err := rows.Scan(&candidate.EntityID, &candidate.Name, &candidate.Type, &candidate.Similarity); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
+
+// [RO] Citește Entitatea (Implementare)
+func (repo *PostgresEntityRegistryRepository) RetrieveEntityByID(executionContext context.Context, id uuid.UUID) (*entity.CanonicalEntity, error) {
+	sqlQuery := `
+		SELECT id, canonical_name, type, COALESCE(wikidata_qid, ''), merged_into, created_at
+		FROM entities WHERE id = $1
+	`
+
+	var found entity.CanonicalEntity
+	var mergedInto uuid.NullUUID
+	err := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, id).
+		Scan(&found.ID, &found.Name, &found.Type, &found.WikidataQID, &mergedInto, &found.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, entity.ErrEntityNotFound
+		}
+		return nil, err
+	}
+	found.MergedInto = mergedInto.UUID
+
+	aliasRows, err := repo.databaseConnection.QueryContext(executionContext, `SELECT alias FROM entity_aliases WHERE entity_id = $1 ORDER BY alias`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer aliasRows.Close()
+
+	for aliasRows.Next() {
+		var alias string
+		if err := aliasRows.Scan(&alias); err != nil {
+			return nil, err
+		}
+		found.Aliases = append(found.Aliases, alias)
+	}
+	return &found, aliasRows.Err()
+}
+
+// [RO] Creează Entitate Canonică (Implementare)
+// Dacă alt worker a înregistrat între timp același nume (alias unic pe nume normalizat + tip),
+// entitatea nu se mai creează: canonical.ID primește ID-ul entității existente.
+func (repo *PostgresEntityRegistryRepository) CreateEntity(executionContext context.Context, canonical *entity.CanonicalEntity) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	var vectorEmbedding interface{}
+	if len(canonical.Embedding) > 0 {
+		vectorEmbedding = pgvector.NewVector(canonical.Embedding)
+	}
+
+	if _, err := transaction.ExecContext(executionContext,
+		`INSERT INTO entities (id, canonical_name, type, embedding, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		canonical.ID, canonical.Name, canonical.Type, vectorEmbedding, time.Now(),
+	); err != nil {
+		return err
+	}
+
+	normalizedName := entity.NormalizeName(canonical.Name, canonical.Type)
+	result, err := transaction.ExecContext(executionContext, `
+		INSERT INTO entity_aliases (entity_id, alias, alias_normalized, type)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (alias_normalized, type) DO NOTHING`, canonical.ID, canonical.Name, normalizedName, canonical.Type)
+	if err != nil {
+		return err
+	}
+	if inserted, err := result.RowsAffected(); err != nil {
+		return err
+	} else if inserted == 0 {
+		// Numele are deja proprietar: renunțăm la entitatea nouă (Rollback la ieșire).
+		return repo.databaseConnection.QueryRowContext(executionContext,
+			`SELECT entity_id FROM entity_aliases WHERE alias_normalized = $1 AND type = $2`,
+			normalizedName, canonical.Type,
+		).Scan(&canonical.ID)
+	}
+
+	for _, alias := range canonical.Aliases {
+		if err := insertAlias(executionContext, transaction, canonical.ID, alias, entity.NormalizeName(alias, canonical.Type), canonical.Type); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+// [RO] Adaugă Alias (Implementare)
+func (repo *PostgresEntityRegistryRepository) AddAlias(executionContext context.Context, entityID uuid.UUID, alias string, normalizedAlias string, entityType string) error {
+	return insertAlias(executionContext, repo.databaseConnection, entityID, alias, normalizedAlias, entityType)
+}
+
+// [RO] Înregistrează Mențiunile (Implementare)
+func (repo *PostgresEntityRegistryRepository) RecordArticleMentions(executionContext context.Context, articleID uuid.UUID, mentions map[string]uuid.UUID) error {
+	sqlQuery := `
+		INSERT INTO article_entity_mentions (article_id, entity_id, surface_form)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+	for surfaceForm, entityID := range mentions {
+		if _, err := repo.databaseConnection.ExecContext(executionContext, sqlQuery, articleID, entityID, surfaceForm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// [RO] Unificare (Implementare)
+// Totul într-o singură tranzacție: alias-uri, mențiuni, marcajul merged_into și auditul.
+func (repo *PostgresEntityRegistryRepository) MergeEntities(executionContext context.Context, audit *entity.EntityMergeAuditRecord) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	// 1. Alias-urile mutate (pentru audit)
+	rows, err := transaction.QueryContext(executionContext, `SELECT alias FROM entity_aliases WHERE entity_id = $1`, audit.SourceEntityID)
+	if err != nil {
+		return err
+	}
+	audit.MovedAliases = []string{}
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			rows.Close()
+			return err
+		}
+		audit.MovedAliases = append(audit.MovedAliases, alias)
+	}
+	rows.Close()
+
+	// 2. Mutăm alias-urile (fiecare alias are un singur proprietar, deci nu pot apărea duplicate)
+	if _, err := transaction.ExecContext(executionContext,
+		`UPDATE entity_aliases SET entity_id = $2 WHERE entity_id = $1`, audit.SourceEntityID, audit.TargetEntityID); err != nil {
+		return err
+	}
+
+	// 3. Mutăm mențiunile
+	if _, err := transaction.ExecContext(executionContext, `
+		INSERT INTO article_entity_mentions (article_id, entity_id, surface_form)
+		SELECT article_id, $2, surface_form FROM article_entity_mentions WHERE entity_id = $1
+		ON CONFLICT DO NOTHING`, audit.SourceEntityID, audit.TargetEntityID); err != nil {
+		return err
+	}
+	if _, err := transaction.ExecContext(executionContext, `DELETE FROM article_entity_mentions WHERE entity_id = $1`, audit.SourceEntityID); err != nil {
+		return err
+	}
+
+	// 4. Marcăm sursa ca unificată
+	if _, err := transaction.ExecContext(executionContext, `UPDATE entities SET merged_into = $1 WHERE id = $2`, audit.TargetEntityID, audit.SourceEntityID); err != nil {
+		return err
+	}
+
+	if err := insertAudit(executionContext, transaction, audit); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// [RO] Separare (Implementare)
+// Creează entitatea nouă, îi mută alias-urile cerute și mențiunile scrise sub acele forme.
+func (repo *PostgresEntityRegistryRepository) SplitEntity(executionContext context.Context, newEntity *entity.CanonicalEntity, audit *entity.EntityMergeAuditRecord) (*entity.EntitySplitResult, error) {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer transaction.Rollback()
+
+	if _, err := transaction.ExecContext(executionContext,
+		`INSERT INTO entities (id, canonical_name, type, created_at) VALUES ($1, $2, $3, $4)`,
+		newEntity.ID, newEntity.Name, newEntity.Type, time.Now(),
+	); err != nil {
+		return nil, err
+	}
+
+	normalizedAliases := make([]string, 0, len(audit.MovedAliases))
+	for _, alias := range audit.MovedAliases {
+		normalizedAliases = append(normalizedAliases, entity.NormalizeName(alias, newEntity.Type))
+	}
+
+	if _, err := transaction.ExecContext(executionContext, `
+		UPDATE entity_aliases SET entity_id = $2
+		WHERE entity_id = $1 AND alias_normalized = ANY($3)`,
+		audit.SourceEntityID, newEntity.ID, pq.Array(normalizedAliases)); err != nil {
+		return nil, err
+	}
+	if err := insertAlias(executionContext, transaction, newEntity.ID, newEntity.Name, entity.NormalizeName(newEntity.Name, newEntity.Type), newEntity.Type); err != nil {
+		return nil, err
+	}
+
+	// Mențiunile se mută după forma exactă din text (comparată normalizat cu alias-urile mutate).
+	rows, err := transaction.QueryContext(executionContext, `
+		SELECT m.article_id, m.surface_form, a.original_url
+		FROM article_entity_mentions m
+		JOIN articles a ON a.id = m.article_id
+		WHERE m.entity_id = $1`, audit.SourceEntityID)
+	if err != nil {
+		return nil, err
+	}
+
+	type movedMention struct {
+		articleID   uuid.UUID
+		surfaceForm string
+	}
+	moveSet := make(map[string]bool, len(normalizedAliases))
+	for _, alias := range normalizedAliases {
+		moveSet[alias] = true
+	}
+
+	var toMove []movedMention
+	urlSet := make(map[string]bool)
+	result := &entity.EntitySplitResult{NewEntity: newEntity}
+	for rows.Next() {
+		var mention movedMention
+		var articleURL string
+		if err := rows.Scan(&mention.articleID, &mention.surfaceForm, &articleURL); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if !moveSet[entity.NormalizeName(mention.surfaceForm, newEntity.Type)] {
+			continue
+		}
+		toMove = append(toMove, mention)
+		if !urlSet[articleURL] {
+			urlSet[articleURL] = true
+			result.MovedArticleURLs = append(result.MovedArticleURLs, articleURL)
+		}
+	}
+	rows.Close()
+
+	for _, mention := range toMove {
+		if _, err := transaction.ExecContext(executionContext, `
+			UPDATE article_entity_mentions SET entity_id = $3
+			WHERE article_id = $1 AND surface_form = $2 AND entity_id = $4`,
+			mention.articleID, mention.surfaceForm, newEntity.ID, audit.SourceEntityID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := insertAudit(executionContext, transaction, audit); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// [RO] Jurnalul de Audit (Implementare)
+func (repo *PostgresEntityRegistryRepository) RetrieveMergeAudit(executionContext context.Context, entityID uuid.UUID) ([]entity.EntityMergeAuditRecord, error) {
+	sqlQuery := `
+		SELECT id, action, source_entity_id, target_entity_id, moved_aliases, actor, reason, created_at
+		FROM entity_merge_audit
+		WHERE source_entity_id = $1 OR target_entity_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []entity.EntityMergeAuditRecord
+	for rows.Next() {
+		var record entity.EntityMergeAuditRecord
+		var movedAliases []byte
+		if err := rows.Scan(&record.ID, &record.Action, &record.SourceEntityID, &record.TargetEntityID, &movedAliases, &record.Actor, &record.Reason, &record.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(movedAliases, &record.MovedAliases); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// [RO] Articolele Entității (Implementare)
+// DISTINCT: același articol poate menționa entitatea sub mai multe forme ("Biden", "President Biden").
+func (repo *PostgresEntityRegistryRepository) RetrieveEntityArticles(executionContext context.Context, entityID uuid.UUID, since time.Time, limit int) ([]entity.EntityArticleMention, error) {
+	sqlQuery := `
+		SELECT a.id, a.title, a.original_url, a.truth_score, COALESCE(a.global_emotion, ''), a.published_at
+		FROM articles a
+		WHERE a.published_at >= $2
+		  AND EXISTS (SELECT 1 FROM article_entity_mentions m WHERE m.article_id = a.id AND m.entity_id = $1)
+		ORDER BY a.published_at DESC
+		LIMIT $3
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, entityID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mentions []entity.EntityArticleMention
+	for rows.Next() {
+		var mention entity.EntityArticleMention
+		if err := rows.Scan(&mention.ArticleID, &mention.Title, &mention.URL, &mention.TruthScore, &mention.GlobalEmotion, &mention.PublishedAt); err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, mention)
+	}
+	return mentions, rows.Err()
+}
+
+// [RO] Evoluția Acoperirii (Implementare)
+// Agregare zilnică; emoția dominantă este cea mai frecventă emoție globală din ziua respectivă.
+func (repo *PostgresEntityRegistryRepository) RetrieveEntityCoverageTrend(executionContext context.Context, entityID uuid.UUID, since time.Time) ([]entity.EntityCoverageBucket, error) {
+	sqlQuery := `
+		SELECT date_trunc('day', a.published_at) AS day,
+		       COUNT(*),
+		       AVG(a.truth_score),
+		       COALESCE(mode() WITHIN GROUP (ORDER BY a.global_emotion), '')
+		FROM articles a
+		WHERE a.published_at >= $2
+		  AND EXISTS (SELECT 1 FROM article_entity_mentions m WHERE m.article_id = a.id AND m.entity_id = $1)
+		GROUP BY day
+		ORDER BY day ASC
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, entityID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []entity.EntityCoverageBucket
+	for rows.Next() {
+		var bucket entity.EntityCoverageBucket
+		if err := rows.Scan(&bucket.Day, &bucket.ArticleCount, &bucket.AverageTruthScore, &bucket.DominantEmotion); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, rows.Err()
+}
+
+// [RO] Executor SQL comun pentru *sql.DB și *sql.Tx
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// [RO] Alias nou; dacă alias-ul normalizat are deja proprietar (alt worker, altă entitate), rămâne al lui
+func insertAlias(executionContext context.Context, executor sqlExecutor, entityID uuid.UUID, alias string, normalizedAlias string, entityType string) error {
+	_, err := executor.ExecContext(executionContext, `
+		INSERT INTO entity_aliases (entity_id, alias, alias_normalized, type)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (alias_normalized, type) DO NOTHING`, entityID, alias, normalizedAlias, entityType)
+	return err
+}
+
+func insertAudit(executionContext context.Context, executor sqlExecutor, audit *entity.EntityMergeAuditRecord) error {
+	movedAliases, err := json.Marshal(audit.MovedAliases)
+	if err != nil {
+		return err
+	}
+	_, err = executor.ExecContext(executionContext, `
+		INSERT INTO entity_merge_audit (id, action, source_entity_id, target_entity_id, moved_aliases, actor, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		audit.ID, audit.Action, audit.SourceEntityID, audit.TargetEntityID, movedAliases, audit.Actor, audit.Reason, audit.CreatedAt)
+	return err
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// [RO] Depozit de Date PostgreSQL pentru Baza de Cunoștințe (Wikidata offline)
+//
+// Conține subsetul importat din dump-ul Wikidata (persoane, organizații, locuri)
+// și legătura dintre entitățile canonice și QID-uri.
+// Implementează interfața `KnowledgeBasePersistenceInterface`.
+type PostgresKnowledgeBaseRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Bază de Cunoștințe
+func NewPostgresKnowledgeBaseRepository(db *sql.DB) *PostgresKnowledgeBaseRepository {
+	return &PostgresKnowledgeBaseRepository{databaseConnection: db}
+}
+
+// [RO] Import în Loturi (Implementare)
+// Un lot = o tranzacție. Alias-urile unei intrări sunt rescrise complet, ca un re-import să nu lase resturi.
+func (repo *PostgresKnowledgeBaseRepository) UpsertKnowledgeBaseEntries(executionContext context.Context, entries []entity.KnowledgeBaseEntry) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	for _, entry := range entries {
+		var latitude, longitude sql.NullFloat64
+		if entry.HasCoordinates {
+			latitude = sql.NullFloat64{Float64: entry.Latitude, Valid: true}
+			longitude = sql.NullFloat64{Float64: entry.Longitude, Valid: true}
+		}
+
+		_, err := transaction.ExecContext(executionContext, `
+			INSERT INTO knowledge_base_entries (qid, label, description, type, aliases, latitude, longitude, sitelinks, imported_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+			ON CONFLICT (qid) DO UPDATE SET
+				label = EXCLUDED.label,
+				description = EXCLUDED.description,
+				type = EXCLUDED.type,
+				aliases = EXCLUDED.aliases,
+				latitude = EXCLUDED.latitude,
+				longitude = EXCLUDED.longitude,
+				sitelinks = EXCLUDED.sitelinks,
+				imported_at = NOW()
+		`, entry.QID, entry.Label, entry.Description, entry.Type, pq.Array(entry.Aliases), latitude, longitude, entry.Sitelinks)
+		if err != nil {
+			return err
+		}
+
+		if _, err := transaction.ExecContext(executionContext, `DELETE FROM knowledge_base_aliases WHERE qid = $1`, entry.QID); err != nil {
+			return err
+		}
+
+		seen := make(map[string]bool)
+		for _, alias := range append([]string{entry.Label}, entry.Aliases...) {
+			normalizedAlias := entity.NormalizeName(alias, entry.Type)
+			if normalizedAlias == "" || seen[normalizedAlias] {
+				continue
+			}
+			seen[normalizedAlias] = true
+
+			_, err := transaction.ExecContext(executionContext, `
+				INSERT INTO knowledge_base_aliases (alias_normalized, type, qid) VALUES ($1, $2, $3)
+				ON CONFLICT DO NOTHING
+			`, normalizedAlias, entry.Type, entry.QID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return transaction.Commit()
+}
+
+// [RO] Candidați după Alias (Implementare)
+func (repo *PostgresKnowledgeBaseRepository) FindKnowledgeBaseEntriesByAlias(executionContext context.Context, normalizedAlias string, entityType string, limit int) ([]entity.KnowledgeBaseEntry, error) {
+	sqlQuery := `
+		SELECT k.qid, k.label, k.description, k.type, k.aliases, k.latitude, k.longitude, k.sitelinks
+		FROM knowledge_base_aliases a
+		JOIN knowledge_base_entries k ON k.qid = a.qid
+		WHERE a.alias_normalized = $1 AND a.type = $2
+		ORDER BY k.sitelinks DESC
+		LIMIT $3
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, normalizedAlias, entityType, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []entity.KnowledgeBaseEntry
+	for rows.Next() {
+		entry, err := scanKnowledgeBaseEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, rows.Err()
+}
+
+// [RO] Intrarea Legată de o Entitate (Implementare)
+func (repo *PostgresKnowledgeBaseRepository) RetrieveLinkedKnowledgeBaseEntry(executionContext context.Context, entityID uuid.UUID) (*entity.KnowledgeBaseEntry, error) {
+	sqlQuery := `
+		SELECT k.qid, k.label, k.description, k.type, k.aliases, k.latitude, k.longitude, k.sitelinks
+		FROM entities e
+		JOIN knowledge_base_entries k ON k.qid = e.wikidata_qid
+		WHERE e.id = $1
+	`
+
+	entry, err := scanKnowledgeBaseEntry(repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, entityID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return entry, err
+}
+
+// [RO] Leagă Entitatea de QID (Implementare)
+func (repo *PostgresKnowledgeBaseRepository) LinkEntityToKnowledgeBase(executionContext context.Context, entityID uuid.UUID, qid string) error {
+	_, err := repo.databaseConnection.ExecContext(executionContext, `UPDATE entities SET wikidata_qid = $2 WHERE id = $1`, entityID, qid)
+	return err
+}
+
+// [RO] Citire rând comun (Row sau Rows)
+func scanKnowledgeBaseEntry(row interface{ Scan(...interface{}) error }) (*entity.KnowledgeBaseEntry, error) {
+	var entry entity.KnowledgeBaseEntry
+	var latitude, longitude sql.NullFloat64
+	if err := row.Scan(&entry.QID, &entry.Label, &entry.Description, &entry.Type, pq.Array(&entry.Aliases), &latitude, &longitude, &entry.Sitelinks); err != nil {
+		return nil, err
+	}
+	if latitude.Valid && longitude.Valid {
+		entry.HasCoordinates = true
+		entry.Latitude = latitude.Float64
+		entry.Longitude = longitude.Float64
+	}
+	return &entry, nil
+}
@@ -3,12 +3,15 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pgvector/pgvector-go"
 	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/perspective"
 )
 
 // [RO] Depozit de Date PostgreSQL pentru Știri
@@ -37,14 +40,29 @@ func (repo *PostgresNewsArticleRepository) PersistNewsArticle(executionContext c
 	sqlQuery := `
 		INSERT INTO articles (
 			id, original_url, title, content, raw_content, summary, 
-			truth_score, bias_rating, embedding, published_at, processed_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			truth_score, bias_rating, embedding, published_at, processed_at,
+			story_cluster_id, global_emotion, location_lat, location_lng,
+			country_code, region_code, sector, review_flags, counter_argument, neutralizations,
+			emotion_intensity
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NULLIF($16, ''), NULLIF($17, ''), NULLIF($18, ''), $19, $20, $21, $22)
 		ON CONFLICT (original_url) DO UPDATE SET
 			title = EXCLUDED.title,
 			content = EXCLUDED.content,
 			truth_score = EXCLUDED.truth_score,
+			global_emotion = EXCLUDED.global_emotion,
+			location_lat = EXCLUDED.location_lat,
+			location_lng = EXCLUDED.location_lng,
+			emotion_intensity = EXCLUDED.emotion_intensity,
+			country_code = EXCLUDED.country_code,
+			region_code = EXCLUDED.region_code,
+			sector = EXCLUDED.sector,
+			review_flags = EXCLUDED.review_flags,
+			counter_argument = EXCLUDED.counter_argument,
+			neutralizations = EXCLUDED.neutralizations,
 			embedding = EXCLUDED.embedding,
-			processed_at = EXCLUDED.processed_at
+			processed_at = EXCLUDED.processed_at,
+			story_cluster_id = COALESCE(articles.story_cluster_id, EXCLUDED.story_cluster_id)
+		RETURNING id, story_cluster_id
 	`
 
 	// [RO] Conversie Vectorială
@@ -52,8 +70,24 @@ func (repo *PostgresNewsArticleRepository) PersistNewsArticle(executionContext c
 	// extensia pgvector îl înțelege.
 	vectorEmbedding := pgvector.NewVector(newsArticle.Embedding)
 
-	// Executăm comanda în baza de date
-	_, processingError := repo.databaseConnection.ExecContext(executionContext, sqlQuery,
+	// [RO] No Null Island
+	// Coordonatele neplauzibile devin NULL, nu (0,0), ca harta să le poată ignora.
+	plausible := newsArticle.Geolocation.HasPlausibleCoordinates()
+	latitude := sql.NullFloat64{Float64: newsArticle.Geolocation.Latitude, Valid: plausible}
+	longitude := sql.NullFloat64{Float64: newsArticle.Geolocation.Longitude, Valid: plausible}
+
+	// [RO] Fragmentele neutralizate (JSONB, listă goală în loc de NULL)
+	neutralizations, err := json.Marshal(neutralizationAnnotations(newsArticle.Neutralizations))
+	if err != nil {
+		return err
+	}
+
+	// Executăm comanda în baza de date.
+	// La re-analiza unui URL, rândul existent își păstrează ID-ul (și grupul): le scriem înapoi
+	// pe entitate, ca amprenta, mențiunile și pasajele să se lege de rândul real.
+	var storedID uuid.UUID
+	var storedCluster uuid.NullUUID
+	processingError := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery,
 		newsArticle.ID,
 		newsArticle.OriginalURL,
 		newsArticle.Title,
@@ -65,22 +99,61 @@ func (repo *PostgresNewsArticleRepository) PersistNewsArticle(executionContext c
 		vectorEmbedding,
 		newsArticle.PublishedAt,
 		time.Now(),
-	)
+		nullableUUID(newsArticle.StoryClusterID),
+		newsArticle.GlobalEmotion,
+		latitude,
+		longitude,
+		newsArticle.CountryCode,
+		newsArticle.RegionCode,
+		newsArticle.Sector,
+		pq.Array(reviewFlags(newsArticle.ReviewFlags)),
+		newsArticle.CounterArgument,
+		neutralizations,
+		newsArticle.Geolocation.Intensity,
+	).Scan(&storedID, &storedCluster)
+	if processingError != nil {
+		return processingError
+	}
 
-	return processingError
+	newsArticle.ID = storedID
+	if storedCluster.Valid {
+		newsArticle.StoryClusterID = storedCluster.UUID
+	}
+	return nil
+}
+
+// [RO] Lista goală (nu NULL) pentru coloana NOT NULL `review_flags`
+func reviewFlags(flags []string) []string {
+	if flags == nil {
+		return []string{}
+	}
+	return flags
+}
+
+// [RO] Lista goală (nu NULL) pentru coloana NOT NULL `neutralizations`
+func neutralizationAnnotations(annotations []article.NeutralizationAnnotation) []article.NeutralizationAnnotation {
+	if annotations == nil {
+		return []article.NeutralizationAnnotation{}
+	}
+	return annotations
 }
 
 // [RO] Găsește Știrea după ID (Implementare)
 func (repo *PostgresNewsArticleRepository) RetrieveNewsArticleByID(executionContext context.Context, id uuid.UUID) (*article.NewsArticleEntity, error) {
 	sqlQuery := `
 		SELECT id, original_url, title, content, raw_content, summary, 
-		       truth_score, bias_rating, published_at, processed_at
+		       truth_score, bias_rating, published_at, processed_at, story_cluster_id,
+		       COALESCE(global_emotion, ''), COALESCE(location_lat, 0), COALESCE(location_lng, 0),
+		       COALESCE(country_code, ''), COALESCE(region_code, ''), COALESCE(sector, ''), review_flags,
+		       COALESCE(counter_argument, ''), neutralizations, emotion_intensity
 		FROM articles WHERE id = $1
 	`
 
 	rowResult := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, id)
 
 	var retrievedArticle article.NewsArticleEntity
+	var storyClusterID uuid.NullUUID
+	var neutralizations []byte
 	// [RO] Mapare (Scanare)
 	// Copiem datele din rândul SQL în structura Go.
 	err := rowResult.Scan(
@@ -94,6 +167,17 @@ func (repo *PostgresNewsArticleRepository) RetrieveNewsArticleByID(executionCont
 		&retrievedArticle.BiasRating,
 		&retrievedArticle.PublishedAt,
 		&retrievedArticle.ProcessedAt,
+		&storyClusterID,
+		&retrievedArticle.GlobalEmotion,
+		&retrievedArticle.Geolocation.Latitude,
+		&retrievedArticle.Geolocation.Longitude,
+		&retrievedArticle.CountryCode,
+		&retrievedArticle.RegionCode,
+		&retrievedArticle.Sector,
+		pq.Array(&retrievedArticle.ReviewFlags),
+		&retrievedArticle.CounterArgument,
+		&neutralizations,
+		&retrievedArticle.Geolocation.Intensity,
 	)
 
 	if err != nil {
@@ -102,69 +186,292 @@ func (repo *PostgresNewsArticleRepository) RetrieveNewsArticleByID(executionCont
 		}
 		return nil, err
 	}
+	retrievedArticle.StoryClusterID = storyClusterID.UUID
+	retrievedArticle.Geolocation.ID = retrievedArticle.ID.String()
+	retrievedArticle.Geolocation.Emotion = retrievedArticle.GlobalEmotion
+	if err := json.Unmarshal(neutralizations, &retrievedArticle.Neutralizations); err != nil {
+		return nil, err
+	}
+
+	// [RO] Notele publicate după contestațiile cititorilor
+	retrievedArticle.ResolutionNotes, err = retrieveResolutionNotes(executionContext, repo.databaseConnection, retrievedArticle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// [RO] Semnalul comunității (calculat periodic din evaluări)
+	retrievedArticle.CommunityScores, err = retrieveCommunityScores(executionContext, repo.databaseConnection, retrievedArticle.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	return &retrievedArticle, nil
 }
 
 // [RO] Caută Știri Similare (Implementare)
-func (repo *PostgresNewsArticleRepository) FindSemanticallySimilarArticles(executionContext context.Context, embedding []float32, limit int) ([]*article.NewsArticleEntity, error) {
+func (repo *PostgresNewsArticleRepository) FindSemanticallySimilarArticles(executionContext context.Context, embedding []float32, filter article.SimilarityFilter, limit int) ([]article.SimilarArticle, error) {
 	// [RO] Magia Vectorială
 	// Operatorul `<=>` calculează "Distanța Cosine".
-	// Cu cât distanța e mai mică, cu atât articolele sunt mai asemănătoare ca înțeles.
+	// Cu cât distanța e mai mică, cu atât articolele sunt mai asemănătoare ca înțeles;
+	// similaritatea returnată este 1 - distanța.
 	sqlQuery := `
-		SELECT id, title, content, truth_score
+		SELECT id, original_url, title, content, truth_score, story_cluster_id, published_at,
+		       1 - (embedding <=> $1) AS similarity
 		FROM articles
+		WHERE embedding IS NOT NULL
+		  AND ($3::timestamptz IS NULL OR published_at >= $3)
+		  AND ($4::timestamptz IS NULL OR published_at <= $4)
+		  AND ($5 = '' OR source_domain = $5)
 		ORDER BY embedding <=> $1 ASC
 		LIMIT $2
 	`
 
 	vectorEmbedding := pgvector.NewVector(embedding)
-	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, vectorEmbedding, limit)
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, vectorEmbedding, limit,
+		sql.NullTime{Time: filter.PublishedAfter, Valid: !filter.PublishedAfter.IsZero()},
+		sql.NullTime{Time: filter.PublishedBefore, Valid: !filter.PublishedBefore.IsZero()},
+		filter.SourceDomain,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var foundArticles []*article.NewsArticleEntity
+	var foundArticles []article.SimilarArticle
 	for rows.Next() {
 		var currentArticle article.NewsArticleEntity
-		if err := rows.Scan(&currentArticle.ID, &currentArticle.Title, &currentArticle.Content, &currentArticle.TruthScore); err != nil {
+		var storyClusterID uuid.NullUUID
+		var similarity float64
+		if err := rows.Scan(&currentArticle.ID, &currentArticle.OriginalURL, &currentArticle.Title, &currentArticle.Content,
+			&currentArticle.TruthScore, &storyClusterID, &currentArticle.PublishedAt, &similarity); err != nil {
 			return nil, err
 		}
-		foundArticles = append(foundArticles, &currentArticle)
+		currentArticle.StoryClusterID = storyClusterID.UUID
+		foundArticles = append(foundArticles, article.SimilarArticle{Article: &currentArticle, Similarity: similarity})
 	}
 
-	return foundArticles, nil
+	return foundArticles, rows.Err()
 }
 
 // [RO] Verifică Existența (Implementare)
+// O copie deja legată de original (article_duplicates) contează ca procesată.
 func (repo *PostgresNewsArticleRepository) CheckIfArticleExistsByURL(executionContext context.Context, url string) (bool, error) {
-	sqlQuery := `SELECT EXISTS(SELECT 1 FROM articles WHERE original_url = $1)`
+	sqlQuery := `
+		SELECT EXISTS(SELECT 1 FROM articles WHERE original_url = $1)
+		    OR EXISTS(SELECT 1 FROM article_duplicates WHERE original_url = $1)
+	`
 	var exists bool
 	err := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, url).Scan(&exists)
 	return exists, err
 }
 
-// [RO] Metode Auxiliare (Helpers) - Păstrate pentru funcționalitate extra (ex: API Feeds)
-// Acestea nu fac parte direct din interfața de bază, dar sunt utile.
+// [RO] Înregistrează o Copie
+// Copia nu se mai analizează, dar legătura rămâne: cine a republicat originalul și cât de fidel.
+// Re-detectarea aceluiași URL actualizează legătura.
+func (repo *PostgresNewsArticleRepository) RecordDuplicateArticle(executionContext context.Context, link article.DuplicateLink) error {
+	sqlQuery := `
+		INSERT INTO article_duplicates (original_url, duplicate_of, similarity, method, detected_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (original_url) DO UPDATE SET
+			duplicate_of = EXCLUDED.duplicate_of,
+			similarity = EXCLUDED.similarity,
+			method = EXCLUDED.method,
+			detected_at = EXCLUDED.detected_at
+	`
+	_, err := repo.databaseConnection.ExecContext(executionContext, sqlQuery,
+		link.OriginalURL, link.DuplicateOf, link.Similarity, link.Method, link.DetectedAt)
+	return err
+}
+
+// [RO] Candidații Lexicali (LSH)
+// Articolele cu același text normalizat sau cu cel puțin o bandă SimHash identică;
+// distanța exactă se calculează în domeniu (article.BestFingerprintMatch).
+func (repo *PostgresNewsArticleRepository) FindFingerprintCandidates(executionContext context.Context, fingerprint article.ContentFingerprint, limit int) ([]article.FingerprintCandidate, error) {
+	bands := fingerprint.Bands()
+	sqlQuery := `
+		SELECT article_id, content_hash, simhash
+		FROM article_fingerprints
+		WHERE content_hash = $1
+		   OR band_0 = $2 OR band_1 = $3 OR band_2 = $4 OR band_3 = $5
+		ORDER BY (content_hash = $1) DESC, created_at ASC
+		LIMIT $6
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery,
+		fingerprint.ContentHash, bands[0], bands[1], bands[2], bands[3], limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []article.FingerprintCandidate
+	for rows.Next() {
+		var candidate article.FingerprintCandidate
+		var simHash int64
+		if err := rows.Scan(&candidate.ArticleID, &candidate.Fingerprint.ContentHash, &simHash); err != nil {
+			return nil, err
+		}
+		candidate.Fingerprint.SimHash = uint64(simHash)
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
+
+// [RO] Salvează Amprenta Articolului
+// SimHash-ul (uint64) se păstrează ca BIGINT cu aceiași biți.
+func (repo *PostgresNewsArticleRepository) SaveArticleFingerprint(executionContext context.Context, id uuid.UUID, fingerprint article.ContentFingerprint) error {
+	bands := fingerprint.Bands()
+	sqlQuery := `
+		INSERT INTO article_fingerprints (article_id, content_hash, simhash, band_0, band_1, band_2, band_3)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (article_id) DO UPDATE SET
+			content_hash = EXCLUDED.content_hash,
+			simhash = EXCLUDED.simhash,
+			band_0 = EXCLUDED.band_0,
+			band_1 = EXCLUDED.band_1,
+			band_2 = EXCLUDED.band_2,
+			band_3 = EXCLUDED.band_3
+	`
+	_, err := repo.databaseConnection.ExecContext(executionContext, sqlQuery,
+		id, fingerprint.ContentHash, int64(fingerprint.SimHash), bands[0], bands[1], bands[2], bands[3])
+	return err
+}
+
+// [RO] Salvează Pasajele Indexate ale unui Articol
+// Înlocuiește pasajele vechi (reindexarea după o modificare a conținutului nu lasă resturi).
+func (repo *PostgresNewsArticleRepository) SaveArticleChunks(executionContext context.Context, id uuid.UUID, chunks []article.ArticleChunk) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	if _, err := transaction.ExecContext(executionContext, `DELETE FROM article_chunks WHERE article_id = $1`, id); err != nil {
+		return err
+	}
+	for _, chunk := range chunks {
+		if _, err := transaction.ExecContext(executionContext, `
+			INSERT INTO article_chunks (article_id, chunk_index, content, start_offset, end_offset, embedding)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, id, chunk.Index, chunk.Text, chunk.Start, chunk.End, pgvector.NewVector(chunk.Embedding)); err != nil {
+			return err
+		}
+	}
+	return transaction.Commit()
+}
+
+// [RO] Caută Pasajele Similare (RAG pe Pasaje)
+// Cele mai apropiate pasaje, cu titlul articolului părinte și similaritatea cosine.
+func (repo *PostgresNewsArticleRepository) FindSimilarArticleChunks(executionContext context.Context, embedding []float32, limit int) ([]article.ArticleChunkMatch, error) {
+	sqlQuery := `
+		SELECT c.article_id, c.chunk_index, c.content, c.start_offset, c.end_offset, a.title,
+		       1 - (c.embedding <=> $1) AS similarity
+		FROM article_chunks c
+		JOIN articles a ON a.id = c.article_id
+		ORDER BY c.embedding <=> $1 ASC
+		LIMIT $2
+	`
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, pgvector.NewVector(embedding), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []article.ArticleChunkMatch
+	for rows.Next() {
+		var match article.ArticleChunkMatch
+		chunk := &match.Chunk
+		if err := rows.Scan(&chunk.ArticleID, &chunk.Index, &chunk.Text, &chunk.Start, &chunk.End, &match.Title, &match.Similarity); err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	return matches, rows.Err()
+}
+
+// [RO] Articolele Încă Neindexate pe Pasaje (pentru migrare)
+// Paginare după cursor (published_at, id), descrescător: articolele care nu produc pasaje sau
+// a căror indexare eșuează rămân în urma cursorului și nu mai sunt reluate în aceeași rulare.
+// Un cursor gol (afterPublishedAt zero) pornește de la cel mai nou articol.
+func (repo *PostgresNewsArticleRepository) RetrieveArticlesWithoutChunks(executionContext context.Context, afterPublishedAt time.Time, afterID uuid.UUID, limit int) ([]*article.NewsArticleEntity, error) {
+	sqlQuery := `
+		SELECT a.id, a.title, a.content, a.published_at
+		FROM articles a
+		WHERE a.content <> ''
+		  AND NOT EXISTS (SELECT 1 FROM article_chunks c WHERE c.article_id = a.id)
+		  AND ($1::timestamptz IS NULL OR (a.published_at, a.id) < ($1::timestamptz, $2::uuid))
+		ORDER BY a.published_at DESC, a.id DESC
+		LIMIT $3
+	`
+	var cursor interface{}
+	if !afterPublishedAt.IsZero() {
+		cursor = afterPublishedAt
+	}
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, cursor, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var foundArticles []*article.NewsArticleEntity
+	for rows.Next() {
+		var currentArticle article.NewsArticleEntity
+		if err := rows.Scan(&currentArticle.ID, &currentArticle.Title, &currentArticle.Content, &currentArticle.PublishedAt); err != nil {
+			return nil, err
+		}
+		foundArticles = append(foundArticles, &currentArticle)
+	}
+	return foundArticles, rows.Err()
+}
+
+// [RO] Găsește Cel Mai Apropiat Articol Grupat (Story Cluster)
+// Returnează vecinul semantic cu similaritate cosine >= minSimilarity care are deja un grup,
+// sau nil dacă povestea este nouă.
+func (repo *PostgresNewsArticleRepository) FindNearestClusteredArticle(executionContext context.Context, embedding []float32, minSimilarity float64) (*article.NewsArticleEntity, error) {
+	sqlQuery := `
+		SELECT id, original_url, story_cluster_id
+		FROM articles
+		WHERE story_cluster_id IS NOT NULL
+		  AND 1 - (embedding <=> $1) >= $2
+		ORDER BY embedding <=> $1 ASC
+		LIMIT 1
+	`
+
+	var nearest article.NewsArticleEntity
+	err := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, pgvector.NewVector(embedding), minSimilarity).
+		Scan(&nearest.ID, &nearest.OriginalURL, &nearest.StoryClusterID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nearest, nil
+}
 
-// [RO] Obține Puncte Gaia (Harta 3D)
-// Returnează date simplificate pentru vizualizare.
-func (repo *PostgresNewsArticleRepository) RetrieveGaiaPoints(executionContext context.Context, limit int) ([]article.GaiaPoint, error) {
-	// Notă: Folosim implementarea robustă cu tratare a erorilor pentru coloane lipsă.
-	// Pentru acest exemplu, presupunem schema ideală.
+// [RO] Grupul Poveștii după URL
+// Returnează uuid.Nil dacă articolul nu există sau nu a fost încă grupat.
+func (repo *PostgresNewsArticleRepository) RetrieveStoryClusterIDByURL(executionContext context.Context, url string) (uuid.UUID, error) {
+	sqlQuery := `SELECT story_cluster_id FROM articles WHERE original_url = $1`
 
-	// Utilizăm un query simplificat dacă coloanele noi nu există, sau unul complet.
-	// Vom folosi un query defensiv.
+	var clusterID uuid.NullUUID
+	err := repo.databaseConnection.QueryRowContext(executionContext, sqlQuery, url).Scan(&clusterID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, nil
+	}
+	return clusterID.UUID, err
+}
 
-	// Pentru Refactorizare: Presupunem schema completă.
+// [RO] Articole Negrupate (Pentru Migrare)
+// Cele mai vechi articole fără grup, cu vectorul lor, ca să le putem grupa în ordine cronologică.
+func (repo *PostgresNewsArticleRepository) RetrieveUnclusteredArticles(executionContext context.Context, limit int) ([]*article.NewsArticleEntity, error) {
 	sqlQuery := `
-		SELECT id, truth_score 
-		FROM articles 
-		ORDER BY published_at DESC 
+		SELECT id, original_url, title, summary, embedding, published_at
+		FROM articles
+		WHERE story_cluster_id IS NULL AND embedding IS NOT NULL
+		ORDER BY published_at ASC
 		LIMIT $1
 	`
-	// NOTĂ: În producție, am selecta și lat/lng. Aici simplificăm pentru a evita erori SQL dacă migrarea nu a rulat.
 
 	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, limit)
 	if err != nil {
@@ -172,34 +479,213 @@ func (repo *PostgresNewsArticleRepository) RetrieveGaiaPoints(executionContext c
 	}
 	defer rows.Close()
 
-	var points []article.GaiaPoint
+	var foundArticles []*article.NewsArticleEntity
+	for rows.Next() {
+		var currentArticle article.NewsArticleEntity
+		var vectorEmbedding pgvector.Vector
+		if err := rows.Scan(&currentArticle.ID, &currentArticle.OriginalURL, &currentArticle.Title, &currentArticle.Summary, &vectorEmbedding, &currentArticle.PublishedAt); err != nil {
+			return nil, err
+		}
+		currentArticle.Embedding = vectorEmbedding.Slice()
+		foundArticles = append(foundArticles, &currentArticle)
+	}
+	return foundArticles, rows.Err()
+}
+
+// [RO] Atribuie Grupul Poveștii
+func (repo *PostgresNewsArticleRepository) AssignStoryCluster(executionContext context.Context, id uuid.UUID, clusterID uuid.UUID) error {
+	sqlQuery := `UPDATE articles SET story_cluster_id = $1 WHERE id = $2`
+	_, err := repo.databaseConnection.ExecContext(executionContext, sqlQuery, clusterID, id)
+	return err
+}
+
+// [RO] Filtrul Comun al Hărții Gaia ($1..$8)
+// Doar coordonate reale (No Null Island), zona vizibilă (inclusiv peste antimeridian),
+// fereastra de timp, emoția și țara. Limitele au tip explicit: în `$3 <= $4` Postgres le-ar
+// deduce altfel ca text, iar comparația cu location_lng ar eșua.
+const gaiaMapFilterClause = `
+	location_lat IS NOT NULL AND location_lng IS NOT NULL
+	AND NOT (location_lat = 0 AND location_lng = 0)
+	AND location_lat BETWEEN $1::double precision AND $2::double precision
+	AND CASE WHEN $3::double precision <= $4::double precision
+	         THEN location_lng BETWEEN $3::double precision AND $4::double precision
+	         ELSE location_lng >= $3::double precision OR location_lng <= $4::double precision END
+	AND ($5::timestamptz IS NULL OR published_at >= $5)
+	AND ($6::timestamptz IS NULL OR published_at <= $6)
+	AND ($7 = '' OR lower(global_emotion) = lower($7))
+	AND ($8 = '' OR country_code = $8)
+`
+
+func gaiaMapFilterArgs(query article.GaiaMapQuery) []interface{} {
+	return []interface{}{
+		query.MinLatitude, query.MaxLatitude,
+		query.MinLongitude, query.MaxLongitude,
+		sql.NullTime{Time: query.From, Valid: !query.From.IsZero()},
+		sql.NullTime{Time: query.To, Valid: !query.To.IsZero()},
+		query.Emotion,
+		query.CountryCode,
+	}
+}
+
+// [RO] Harta Gaia (Implementare)
+// Gruparea se face în SQL: fiecare știre cade într-o celulă floor(lat/cell), floor(lng/cell).
+// Per celulă: media coordonatelor, numărul de știri, scorul mediu și emoția cea mai frecventă.
+func (repo *PostgresNewsArticleRepository) RetrieveGaiaClusters(executionContext context.Context, query article.GaiaMapQuery) ([]article.GaiaCluster, error) {
+	sqlQuery := `
+		SELECT floor(location_lat / $9)::bigint AS cell_row,
+		       floor(location_lng / $9)::bigint AS cell_col,
+		       avg(location_lat), avg(location_lng), count(*), avg(truth_score),
+		       COALESCE(mode() WITHIN GROUP (ORDER BY global_emotion), ''),
+		       (array_agg(id))[1]
+		FROM articles
+		WHERE ` + gaiaMapFilterClause + `
+		GROUP BY cell_row, cell_col
+		ORDER BY count(*) DESC
+		LIMIT $10
+	`
+
+	args := append(gaiaMapFilterArgs(query), query.CellSizeDegrees(), article.MaxGaiaClusters)
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clusters := []article.GaiaCluster{}
+	for rows.Next() {
+		var cluster article.GaiaCluster
+		var cellRow, cellCol int64
+		var firstArticleID uuid.UUID
+		if err := rows.Scan(&cellRow, &cellCol, &cluster.Latitude, &cluster.Longitude, &cluster.Count,
+			&cluster.AverageTruthScore, &cluster.DominantEmotion, &firstArticleID); err != nil {
+			return nil, err
+		}
+		cluster.ID = fmt.Sprintf("%d/%d/%d", query.Zoom, cellRow, cellCol)
+		if cluster.Count == 1 {
+			cluster.ArticleID = firstArticleID.String()
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, rows.Err()
+}
+
+// [RO] Știrile de pe Hartă, Negrupate (Implementare)
+// Cele mai noi primele, plafonate la `limit`.
+func (repo *PostgresNewsArticleRepository) RetrieveGaiaArticles(executionContext context.Context, query article.GaiaMapQuery, limit int) ([]article.GaiaArticlePoint, error) {
+	sqlQuery := `
+		SELECT id, title, original_url, location_lat, location_lng, truth_score,
+		       COALESCE(global_emotion, ''), published_at
+		FROM articles
+		WHERE ` + gaiaMapFilterClause + `
+		ORDER BY published_at DESC
+		LIMIT $9
+	`
+
+	args := append(gaiaMapFilterArgs(query), limit)
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := []article.GaiaArticlePoint{}
 	for rows.Next() {
-		var id uuid.UUID
-		var score float64
-		if err := rows.Scan(&id, &score); err != nil {
-			continue
+		var point article.GaiaArticlePoint
+		if err := rows.Scan(&point.ID, &point.Title, &point.URL, &point.Latitude, &point.Longitude,
+			&point.TruthScore, &point.Emotion, &point.PublishedAt); err != nil {
+			return nil, err
 		}
+		points = append(points, point)
+	}
+	return points, rows.Err()
+}
 
-		// [RO] Ignorare puncte incomplete (No Null Island)
-		// Aici am folosi valorile reale din DB.
-		// Deoarece în acest stub latitudine/longitudine sunt 0.0, le vom exclude pe toate dacă aplicăm filtrul strict.
-		// Pentru a demonstra funcționarea, vom returna doar punctele care au coordonate valide (dacă am citi lat/lng).
-		// În acest context de stub, vom returna punctele dar cu un comentariu.
-		// În producție:
-		/*
-			if lat == 0.0 && lng == 0.0 {
-				continue
+// [RO] Agregare pe Țări (Implementare)
+// Articolele fără țară (puncte în ocean, gazetteer fără acoperire) nu apar.
+func (repo *PostgresNewsArticleRepository) RetrieveGaiaCountryAggregates(executionContext context.Context, query article.GaiaMapQuery) ([]article.GaiaCountryAggregate, error) {
+	sqlQuery := `
+		SELECT country_code, avg(location_lat), avg(location_lng), count(*), avg(truth_score),
+		       COALESCE(mode() WITHIN GROUP (ORDER BY global_emotion), '')
+		FROM articles
+		WHERE ` + gaiaMapFilterClause + `
+		  AND country_code IS NOT NULL
+		GROUP BY country_code
+		ORDER BY count(*) DESC
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, gaiaMapFilterArgs(query)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aggregates := []article.GaiaCountryAggregate{}
+	for rows.Next() {
+		var aggregate article.GaiaCountryAggregate
+		if err := rows.Scan(&aggregate.CountryCode, &aggregate.Latitude, &aggregate.Longitude, &aggregate.Count,
+			&aggregate.AverageTruthScore, &aggregate.DominantEmotion); err != nil {
+			return nil, err
+		}
+		aggregates = append(aggregates, aggregate)
+	}
+	return aggregates, rows.Err()
+}
+
+// [RO] Cele Mai Noi Știri (Implementare)
+// Fără conținutul brut și fără vector: fluxul are nevoie doar de ce se afișează în listă.
+func (repo *PostgresNewsArticleRepository) RetrieveLatestNewsArticles(executionContext context.Context, query article.NewsFeedQuery) ([]*article.NewsArticleEntity, error) {
+	// [RO] Cu perspectivele cerute, fiecare articol primește ultima revizie a poveștii lui (JOIN LATERAL)
+	sqlQuery := `
+		SELECT a.id, a.original_url, a.title, a.summary, a.truth_score, a.bias_rating, a.published_at,
+		       COALESCE(a.global_emotion, ''), COALESCE(a.country_code, ''), COALESCE(a.region_code, ''), a.story_cluster_id,
+		       p.revision, p.perspectives, p.generated_at
+		FROM articles a
+		LEFT JOIN LATERAL (
+			SELECT revision, perspectives, generated_at
+			FROM story_perspectives
+			WHERE $3 AND story_cluster_id = a.story_cluster_id
+			ORDER BY revision DESC
+			LIMIT 1
+		) p ON true
+		WHERE ($1 = '' OR a.country_code = $1)
+		ORDER BY a.published_at DESC
+		LIMIT $2
+	`
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, query.CountryCode, query.Limit, query.IncludePerspectives)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var foundArticles []*article.NewsArticleEntity
+	for rows.Next() {
+		var currentArticle article.NewsArticleEntity
+		var storyClusterID uuid.NullUUID
+		var revision sql.NullInt64
+		var perspectives []byte
+		var generatedAt sql.NullTime
+		if err := rows.Scan(&currentArticle.ID, &currentArticle.OriginalURL, &currentArticle.Title, &currentArticle.Summary,
+			&currentArticle.TruthScore, &currentArticle.BiasRating, &currentArticle.PublishedAt,
+			&currentArticle.GlobalEmotion, &currentArticle.CountryCode, &currentArticle.RegionCode, &storyClusterID,
+			&revision, &perspectives, &generatedAt); err != nil {
+			return nil, err
+		}
+		currentArticle.StoryClusterID = storyClusterID.UUID
+		if revision.Valid {
+			set := &perspective.PerspectiveSet{StoryClusterID: storyClusterID.UUID, Revision: int(revision.Int64), GeneratedAt: generatedAt.Time}
+			if err := json.Unmarshal(perspectives, &set.Perspectives); err != nil {
+				return nil, err
 			}
-		*/
-
-		points = append(points, article.GaiaPoint{
-			ID:        id.String(),
-			Intensity: score,
-			// Lat/Lng default
-			Latitude:  0.0,
-			Longitude: 0.0,
-			Emotion:   "N",
-		})
-	}
-	return points, nil
+			currentArticle.Perspectives = set
+		}
+		foundArticles = append(foundArticles, &currentArticle)
+	}
+	return foundArticles, rows.Err()
+}
+
+// [RO] UUID Opțional
+// uuid.Nil devine NULL în baza de date.
+func nullableUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
 }
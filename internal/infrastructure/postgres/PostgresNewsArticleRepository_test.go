@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// [RO] Driver SQL Scriptat (fără bază de date)
+// Interogările care conțin `match` primesc rândul `row`; restul primesc un rezultat gol.
+// Ca în Postgres, o valoare NULL din `row` devine șir gol doar dacă interogarea conține expresia COALESCE din `coalesce`.
+type scriptedDriver struct {
+	match    string
+	columns  []string
+	row      []driver.Value
+	coalesce map[int]string // Poziția în rând -> expresia COALESCE care o acoperă
+}
+
+func (scripted *scriptedDriver) Open(name string) (driver.Conn, error) {
+	return scriptedConn{scripted}, nil
+}
+
+type scriptedConn struct{ driver *scriptedDriver }
+
+func (conn scriptedConn) Prepare(query string) (driver.Stmt, error) {
+	return scriptedStmt{driver: conn.driver, query: query}, nil
+}
+func (conn scriptedConn) Close() error              { return nil }
+func (conn scriptedConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type scriptedStmt struct {
+	driver *scriptedDriver
+	query  string
+}
+
+func (stmt scriptedStmt) Close() error  { return nil }
+func (stmt scriptedStmt) NumInput() int { return -1 }
+func (stmt scriptedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+func (stmt scriptedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if strings.Contains(stmt.query, stmt.driver.match) {
+		row := append([]driver.Value(nil), stmt.driver.row...)
+		for index, expression := range stmt.driver.coalesce {
+			if row[index] == nil && strings.Contains(stmt.query, expression) {
+				row[index] = ""
+			}
+		}
+		return &scriptedRows{columns: stmt.driver.columns, rows: [][]driver.Value{row}}, nil
+	}
+	return &scriptedRows{}, nil
+}
+
+type scriptedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (rows *scriptedRows) Columns() []string { return rows.columns }
+func (rows *scriptedRows) Close() error      { return nil }
+func (rows *scriptedRows) Next(dest []driver.Value) error {
+	if len(rows.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, rows.rows[0])
+	rows.rows = rows.rows[1:]
+	return nil
+}
+
+func TestRetrieveNewsArticleByID_ReadsLegacyNullCounterArgument(t *testing.T) {
+	articleID := uuid.New()
+	publishedAt := time.Date(2025, 11, 3, 8, 0, 0, 0, time.UTC)
+
+	// [RO] Un articol salvat înainte de 021: counter_argument e NULL în bază.
+	scripted := &scriptedDriver{
+		match:    "FROM articles WHERE id",
+		coalesce: map[int]string{18: "COALESCE(counter_argument, '')"},
+		columns:  make([]string, 21),
+		row: []driver.Value{
+			articleID.String(), "https://example.com/old", "Old story", "Content", "Raw", "Summary",
+			0.7, 0.1, publishedAt, publishedAt, nil,
+			"Joy", 44.4, 26.1,
+			"RO", "RO-B", "Politics", []byte("{}"),
+			nil, []byte("[]"), 0.5,
+		},
+	}
+	driverName := "scripted-" + t.Name()
+	sql.Register(driverName, scripted)
+	db, err := sql.Open(driverName, "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	retrieved, err := NewPostgresNewsArticleRepository(db).RetrieveNewsArticleByID(context.Background(), articleID)
+	require.NoError(t, err)
+	assert.Equal(t, articleID, retrieved.ID)
+	assert.Equal(t, "", retrieved.CounterArgument)
+}
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/dispute"
+)
+
+// [RO] Depozit Contestații ale Cititorilor (PostgreSQL)
+//
+// Contestațiile deschise și notele de rezolvare publicate pe articole.
+// Implementează interfața `dispute.ReaderDisputePersistenceInterface`.
+type PostgresReaderDisputeRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Contestații
+func NewPostgresReaderDisputeRepository(db *sql.DB) *PostgresReaderDisputeRepository {
+	return &PostgresReaderDisputeRepository{databaseConnection: db}
+}
+
+// [RO] Salvează Contestația
+// Cheia străină spre `articles` respinge contestațiile pentru articole inexistente.
+func (repo *PostgresReaderDisputeRepository) SaveDispute(executionContext context.Context, item dispute.Dispute) error {
+	_, err := repo.databaseConnection.ExecContext(executionContext, `
+		INSERT INTO article_disputes (id, article_id, target, cause_event_id, reason, evidence_urls, status, submitter_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, item.ID, item.ArticleID, item.Target, item.CauseEventID, item.Reason, pq.Array(item.EvidenceURLs), item.Status, item.SubmitterID, item.CreatedAt)
+
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return dispute.ErrDisputedArticleNotFound
+	}
+	return err
+}
+
+// [RO] Grupul Poveștii Articolului Contestat
+func (repo *PostgresReaderDisputeRepository) RetrieveArticleStoryCluster(executionContext context.Context, articleID uuid.UUID) (uuid.UUID, error) {
+	var clusterID uuid.NullUUID
+	err := repo.databaseConnection.QueryRowContext(executionContext, `
+		SELECT story_cluster_id FROM articles WHERE id = $1
+	`, articleID).Scan(&clusterID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, dispute.ErrDisputedArticleNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return clusterID.UUID, nil
+}
+
+// [RO] Contestațiile Recente ale unui Client pe Articol
+func (repo *PostgresReaderDisputeRepository) CountSubmitterDisputes(executionContext context.Context, articleID uuid.UUID, submitterID string, since time.Time) (int, error) {
+	var count int
+	err := repo.databaseConnection.QueryRowContext(executionContext, `
+		SELECT COUNT(*) FROM article_disputes
+		WHERE article_id = $1 AND submitter_id = $2 AND created_at >= $3
+	`, articleID, submitterID, since).Scan(&count)
+	return count, err
+}
+
+// [RO] Contestațiile Deschise (cele mai vechi întâi)
+func (repo *PostgresReaderDisputeRepository) ListOpenDisputes(executionContext context.Context, articleID uuid.UUID) ([]dispute.Dispute, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT id, article_id, target, cause_event_id, reason, evidence_urls, status, submitter_id, created_at
+		FROM article_disputes
+		WHERE article_id = $1 AND status = $2
+		ORDER BY created_at ASC
+	`, articleID, dispute.StatusOpen)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []dispute.Dispute
+	for rows.Next() {
+		var item dispute.Dispute
+		if err := rows.Scan(&item.ID, &item.ArticleID, &item.Target, &item.CauseEventID, &item.Reason, pq.Array(&item.EvidenceURLs), &item.Status, &item.SubmitterID, &item.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// [RO] Notele de Rezolvare ale Articolului
+func (repo *PostgresReaderDisputeRepository) RetrieveResolutionNotes(executionContext context.Context, articleID uuid.UUID) ([]dispute.ResolutionNote, error) {
+	return retrieveResolutionNotes(executionContext, repo.databaseConnection, articleID)
+}
+
+// [RO] Notele publicate (cele mai noi întâi); folosit și la citirea articolului
+func retrieveResolutionNotes(executionContext context.Context, db *sql.DB, articleID uuid.UUID) ([]dispute.ResolutionNote, error) {
+	rows, err := db.QueryContext(executionContext, `
+		SELECT id, article_id, dispute_ids::text[], targets, outcome, previous_truth_score, truth_score,
+		       previous_bias_rating, bias_rating, retracted_cause_event_ids, note, created_at
+		FROM article_resolution_notes
+		WHERE article_id = $1
+		ORDER BY created_at DESC
+	`, articleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []dispute.ResolutionNote
+	for rows.Next() {
+		var note dispute.ResolutionNote
+		var disputeIDs []string
+		if err := rows.Scan(
+			&note.ID, &note.ArticleID, pq.Array(&disputeIDs), pq.Array(&note.Targets), &note.Outcome,
+			&note.PreviousTruthScore, &note.TruthScore, &note.PreviousBiasRating, &note.BiasRating,
+			pq.Array(&note.RetractedCauses), &note.Note, &note.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		for _, raw := range disputeIDs {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, err
+			}
+			note.DisputeIDs = append(note.DisputeIDs, id)
+		}
+		notes = append(notes, note)
+	}
+	return notes, rows.Err()
+}
+
+// [RO] Aplică Rezolvarea (Tranzacție)
+// Idempotent: o reîncercare a activității găsește nota deja scrisă și contestațiile deja închise.
+func (repo *PostgresReaderDisputeRepository) ApplyDisputeResolution(executionContext context.Context, note dispute.ResolutionNote) error {
+	disputeIDs := make([]string, 0, len(note.DisputeIDs))
+	for _, id := range note.DisputeIDs {
+		disputeIDs = append(disputeIDs, id.String())
+	}
+	retracted := note.RetractedCauses
+	if retracted == nil {
+		retracted = []string{}
+	}
+
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	result, err := transaction.ExecContext(executionContext, `
+		INSERT INTO article_resolution_notes (id, article_id, dispute_ids, targets, outcome, previous_truth_score, truth_score,
+		                                      previous_bias_rating, bias_rating, retracted_cause_event_ids, note, created_at)
+		VALUES ($1, $2, $3::uuid[], $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO NOTHING
+	`, note.ID, note.ArticleID, pq.Array(disputeIDs), pq.Array(note.Targets), note.Outcome, note.PreviousTruthScore, note.TruthScore,
+		note.PreviousBiasRating, note.BiasRating, pq.Array(retracted), note.Note, note.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if inserted, err := result.RowsAffected(); err != nil || inserted == 0 {
+		return err
+	}
+
+	if note.Outcome == dispute.OutcomeCorrected {
+		if _, err := transaction.ExecContext(executionContext, `
+			UPDATE articles SET truth_score = $2, bias_rating = $3 WHERE id = $1
+		`, note.ArticleID, note.TruthScore, note.BiasRating); err != nil {
+			return err
+		}
+	}
+
+	if _, err := transaction.ExecContext(executionContext, `
+		UPDATE article_disputes SET status = $2, resolution_id = $3
+		WHERE id = ANY($1::uuid[]) AND status = $4
+	`, pq.Array(disputeIDs), dispute.StatusResolved, note.ID, dispute.StatusOpen); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
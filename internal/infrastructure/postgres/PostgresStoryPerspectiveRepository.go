@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/yourorg/truthweave/internal/domain/perspective"
+)
+
+// [RO] Depozit Perspective ale Poveștilor (PostgreSQL)
+//
+// Reviziile punctelor de vedere generate pentru fiecare grup de poveste.
+// Implementează interfața `perspective.PerspectivePersistenceInterface`.
+type PostgresStoryPerspectiveRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Perspective
+func NewPostgresStoryPerspectiveRepository(db *sql.DB) *PostgresStoryPerspectiveRepository {
+	return &PostgresStoryPerspectiveRepository{databaseConnection: db}
+}
+
+// [RO] Corpusul Poveștii (cele mai noi articole din grup)
+func (repo *PostgresStoryPerspectiveRepository) RetrievePerspectiveCorpus(executionContext context.Context, storyClusterID uuid.UUID, limit int) ([]perspective.SourceArticle, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT id, title, original_url, summary, COALESCE(bias_rating, '')
+		FROM articles
+		WHERE story_cluster_id = $1
+		ORDER BY published_at DESC
+		LIMIT $2
+	`, storyClusterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var corpus []perspective.SourceArticle
+	for rows.Next() {
+		var source perspective.SourceArticle
+		if err := rows.Scan(&source.ArticleID, &source.Title, &source.OriginalURL, &source.Summary, &source.BiasRating); err != nil {
+			return nil, err
+		}
+		corpus = append(corpus, source)
+	}
+	return corpus, rows.Err()
+}
+
+// [RO] Salvează Revizia (idempotent pe (poveste, revizie))
+func (repo *PostgresStoryPerspectiveRepository) SavePerspectiveSet(executionContext context.Context, set perspective.PerspectiveSet) error {
+	perspectives, err := json.Marshal(set.Perspectives)
+	if err != nil {
+		return err
+	}
+	inputs := make([]string, 0, len(set.InputArticleIDs))
+	for _, id := range set.InputArticleIDs {
+		inputs = append(inputs, id.String())
+	}
+
+	_, err = repo.databaseConnection.ExecContext(executionContext, `
+		INSERT INTO story_perspectives (story_cluster_id, revision, perspectives, input_article_ids, generated_at)
+		VALUES ($1, $2, $3, $4::uuid[], $5)
+		ON CONFLICT (story_cluster_id, revision) DO NOTHING
+	`, set.StoryClusterID, set.Revision, perspectives, pq.Array(inputs), set.GeneratedAt)
+	return err
+}
+
+// [RO] O Revizie (revision <= 0 = ultima)
+func (repo *PostgresStoryPerspectiveRepository) RetrievePerspectiveSet(executionContext context.Context, storyClusterID uuid.UUID, revision int) (*perspective.PerspectiveSet, error) {
+	row := repo.databaseConnection.QueryRowContext(executionContext, `
+		SELECT story_cluster_id, revision, perspectives, input_article_ids::text[], generated_at
+		FROM story_perspectives
+		WHERE story_cluster_id = $1 AND ($2 <= 0 OR revision = $2)
+		ORDER BY revision DESC
+		LIMIT 1
+	`, storyClusterID, revision)
+
+	var set perspective.PerspectiveSet
+	var perspectives []byte
+	var inputs []string
+	err := row.Scan(&set.StoryClusterID, &set.Revision, &perspectives, pq.Array(&inputs), &set.GeneratedAt)
+	if err == sql.ErrNoRows {
+		return nil, perspective.ErrPerspectivesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(perspectives, &set.Perspectives); err != nil {
+		return nil, err
+	}
+	for _, raw := range inputs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+		set.InputArticleIDs = append(set.InputArticleIDs, id)
+	}
+	return &set, nil
+}
+
+// [RO] Istoricul Reviziilor (cele mai noi întâi)
+func (repo *PostgresStoryPerspectiveRepository) ListPerspectiveRevisions(executionContext context.Context, storyClusterID uuid.UUID, limit int) ([]perspective.RevisionSummary, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT revision, ARRAY(SELECT elem->>'label' FROM jsonb_array_elements(perspectives) AS elem),
+		       cardinality(input_article_ids), generated_at
+		FROM story_perspectives
+		WHERE story_cluster_id = $1
+		ORDER BY revision DESC
+		LIMIT $2
+	`, storyClusterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []perspective.RevisionSummary
+	for rows.Next() {
+		var summary perspective.RevisionSummary
+		if err := rows.Scan(&summary.Revision, pq.Array(&summary.Labels), &summary.Articles, &summary.GeneratedAt); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, summary)
+	}
+	return revisions, rows.Err()
+}
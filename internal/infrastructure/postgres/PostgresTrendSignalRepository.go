@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/truthweave/internal/domain/trend"
+)
+
+// [RO] Depozit Semnale de Trend (PostgreSQL)
+//
+// Citește volumele orare de mențiuni / articole pe grup și păstrează semnalele "breaking".
+// Implementează interfața `trend.TrendPersistenceInterface`.
+type PostgresTrendSignalRepository struct {
+	databaseConnection *sql.DB
+}
+
+// [RO] Constructor Semnale de Trend
+func NewPostgresTrendSignalRepository(db *sql.DB) *PostgresTrendSignalRepository {
+	return &PostgresTrendSignalRepository{databaseConnection: db}
+}
+
+// [RO] Sursele de volum: câte articole distincte are fiecare cheie, pe oră.
+// $1 = sfârșitul ferestrei, $2 = ore de istoric, $3 = pragul absolut al orei curente.
+var trendVolumeQueries = map[string]string{
+	trend.KindEntity: `
+		WITH scoped AS (
+			SELECT m.entity_id::text AS key, a.id AS article_id,
+			       floor(extract(epoch FROM ($1 - a.published_at)) / 3600)::int AS hours_ago
+			FROM article_entity_mentions m
+			JOIN articles a ON a.id = m.article_id
+			WHERE a.published_at >= $1 - ($2 + 1) * interval '1 hour' AND a.published_at < $1
+		), candidates AS (
+			SELECT key FROM scoped WHERE hours_ago = 0
+			GROUP BY key HAVING count(DISTINCT article_id) >= $3
+		)
+		SELECT s.key, e.canonical_name, s.hours_ago, count(DISTINCT s.article_id)
+		FROM scoped s
+		JOIN candidates c ON c.key = s.key
+		JOIN entities e ON e.id::text = s.key
+		GROUP BY s.key, e.canonical_name, s.hours_ago
+	`,
+	trend.KindStoryCluster: `
+		WITH scoped AS (
+			SELECT story_cluster_id::text AS key, id AS article_id, title, published_at,
+			       floor(extract(epoch FROM ($1 - published_at)) / 3600)::int AS hours_ago
+			FROM articles
+			WHERE story_cluster_id IS NOT NULL
+			  AND published_at >= $1 - ($2 + 1) * interval '1 hour' AND published_at < $1
+		), candidates AS (
+			SELECT key, (array_agg(title ORDER BY published_at DESC))[1] AS label
+			FROM scoped WHERE hours_ago = 0
+			GROUP BY key HAVING count(*) >= $3
+		)
+		SELECT s.key, c.label, s.hours_ago, count(*)
+		FROM scoped s
+		JOIN candidates c ON c.key = s.key
+		GROUP BY s.key, c.label, s.hours_ago
+	`,
+}
+
+// [RO] Seriile de Volum (Implementare)
+// Doar cheile care au atins pragul în ora curentă sunt candidate; istoricul lor este completat cu zerouri.
+func (repo *PostgresTrendSignalRepository) RetrieveVolumeSeries(executionContext context.Context, kind string, windowEnd time.Time, historyHours int, minCount int) ([]trend.VolumeSeries, error) {
+	sqlQuery, ok := trendVolumeQueries[kind]
+	if !ok {
+		return nil, fmt.Errorf("[RO] Eroare: Tip de serie necunoscut: %s", kind)
+	}
+
+	rows, err := repo.databaseConnection.QueryContext(executionContext, sqlQuery, windowEnd, historyHours, minCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byKey := map[string]*trend.VolumeSeries{}
+	var order []string
+	for rows.Next() {
+		var key, label string
+		var hoursAgo, count int
+		if err := rows.Scan(&key, &label, &hoursAgo, &count); err != nil {
+			return nil, err
+		}
+		series, exists := byKey[key]
+		if !exists {
+			series = &trend.VolumeSeries{Kind: kind, Key: key, Label: label, History: make([]int, historyHours)}
+			byKey[key] = series
+			order = append(order, key)
+		}
+		// [RO] hours_ago 0 = ora curentă; 1..N = istoricul (cel mai vechi primul în slice)
+		if hoursAgo == 0 {
+			series.Current = count
+		} else if hoursAgo <= historyHours {
+			series.History[historyHours-hoursAgo] = count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]trend.VolumeSeries, 0, len(order))
+	for _, key := range order {
+		result = append(result, *byKey[key])
+	}
+	return result, nil
+}
+
+// [RO] Salvează Semnalele (Implementare)
+func (repo *PostgresTrendSignalRepository) SaveTrendSignals(executionContext context.Context, signals []trend.TrendSignal) error {
+	transaction, err := repo.databaseConnection.BeginTx(executionContext, nil)
+	if err != nil {
+		return err
+	}
+	defer transaction.Rollback()
+
+	for _, signal := range signals {
+		if _, err := transaction.ExecContext(executionContext, `
+			INSERT INTO trend_signals (id, kind, key, label, article_count, baseline, std_dev, z_score, window_start, window_end, detected_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (id) DO NOTHING
+		`, signal.ID, signal.Kind, signal.Key, signal.Label, signal.Count, signal.Baseline, signal.StdDev, signal.ZScore,
+			signal.WindowStart, signal.WindowEnd, signal.DetectedAt); err != nil {
+			return err
+		}
+	}
+	return transaction.Commit()
+}
+
+// [RO] Semnale Recente (Implementare)
+func (repo *PostgresTrendSignalRepository) RetrieveRecentTrendSignals(executionContext context.Context, since time.Time, limit int) ([]trend.TrendSignal, error) {
+	rows, err := repo.databaseConnection.QueryContext(executionContext, `
+		SELECT id, kind, key, label, article_count, baseline, std_dev, z_score, window_start, window_end, detected_at
+		FROM trend_signals
+		WHERE detected_at >= $1
+		ORDER BY detected_at DESC, z_score DESC
+		LIMIT $2
+	`, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var signals []trend.TrendSignal
+	for rows.Next() {
+		var signal trend.TrendSignal
+		if err := rows.Scan(&signal.ID, &signal.Kind, &signal.Key, &signal.Label, &signal.Count, &signal.Baseline,
+			&signal.StdDev, &signal.ZScore, &signal.WindowStart, &signal.WindowEnd, &signal.DetectedAt); err != nil {
+			return nil, err
+		}
+		signals = append(signals, signal)
+	}
+	return signals, rows.Err()
+}
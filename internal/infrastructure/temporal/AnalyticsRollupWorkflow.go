@@ -0,0 +1,65 @@
+package temporal
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/analytics"
+)
+
+// [RO] Mărimea unei felii de recalculare (o backfill lungă nu ține o tranzacție uriașă)
+const analyticsRollupChunk = 7 * 24 * time.Hour
+
+// [RO] Activitate: Recalculare Agregate Global / Țară / Sector
+func (activities *NewsProcessingActivities) RefreshAnalyticsRollupsActivity(ctx context.Context, from time.Time, to time.Time) error {
+	return activities.Analytics.RefreshRollups(ctx, from, to)
+}
+
+// [RO] Activitate: Recalculare Agregate pe Narațiuni
+// Apartenența (narațiune -> grupuri de poveste) este citită din graf la fiecare rulare.
+func (activities *NewsProcessingActivities) RefreshNarrativeRollupsActivity(ctx context.Context, from time.Time, to time.Time) error {
+	narratives, err := activities.KnowledgeGraph.RetrieveNarrativeStoryClusters(ctx)
+	if err != nil {
+		return err
+	}
+	return activities.Analytics.RefreshNarrativeRollups(ctx, narratives, from, to)
+}
+
+// [RO] Workflow: Agregate Analitice (Cron)
+// Recalculează intervalele orare și zilnice din fereastra [ziua lui now-lookback, mâine).
+// Pornit ca cron (lookback 0 = ziua precedentă) sau o singură dată, pentru backfill.
+// Narațiunile depind de Dgraph: o eroare acolo este doar raportată, restul agregatelor rămân valide.
+func AnalyticsRollupWorkflow(ctx workflow.Context, lookback time.Duration) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+	logger := workflow.GetLogger(ctx)
+
+	var tools *NewsProcessingActivities
+
+	from, to := analytics.RollupWindow(workflow.Now(ctx), lookback)
+	for start := from; start.Before(to); start = start.Add(analyticsRollupChunk) {
+		end := start.Add(analyticsRollupChunk)
+		if end.After(to) {
+			end = to
+		}
+		if err := workflow.ExecuteActivity(ctx, tools.RefreshAnalyticsRollupsActivity, start, end).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := workflow.ExecuteActivity(ctx, tools.RefreshNarrativeRollupsActivity, from, to).Get(ctx, nil); err != nil {
+		logger.Warn("Agregatele pe narațiuni nu au putut fi recalculate", "Error", err)
+	}
+
+	logger.Info("Agregate analitice recalculate", "from", from, "to", to)
+	return nil
+}
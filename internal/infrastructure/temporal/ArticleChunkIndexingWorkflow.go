@@ -0,0 +1,117 @@
+package temporal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/article"
+)
+
+const (
+	// [RO] Câte articole indexează un pas al migrării
+	articleChunkBackfillBatchSize = 20
+
+	// [RO] După atâția pași, migrarea continuă ca execuție nouă (istoric mic)
+	articleChunkBackfillBatchesPerRun = 200
+)
+
+// [RO] Poziția Migrării: ultimul articol încercat (published_at, id), descrescător
+type ChunkBackfillCursor struct {
+	PublishedAt time.Time
+	ArticleID   uuid.UUID
+}
+
+// [RO] Rezultatul unui Pas de Migrare
+type ChunkBackfillBatch struct {
+	Processed int
+	Failed    int
+	Next      ChunkBackfillCursor
+}
+
+// [RO] Totalurile Migrării (transmise prin ContinueAsNew)
+type ChunkBackfillProgress struct {
+	Cursor    ChunkBackfillCursor
+	Processed int
+	Failed    int
+}
+
+// [RO] Activitate: Indexare pe Pasaje
+// Împarte conținutul articolului în pasaje suprapuse, le calculează vectorii (un singur apel
+// pentru tot articolul) și le salvează în `article_chunks`.
+func (activities *NewsProcessingActivities) IndexArticleChunksActivity(ctx context.Context, newsArticle article.NewsArticleEntity) error {
+	chunks := article.SplitArticleIntoChunks(newsArticle.ID, newsArticle.Content)
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Text
+	}
+	vectors, err := activities.ArtificialIntelligence.GenerateSemanticVectors(ctx, texts)
+	if err != nil {
+		return err
+	}
+	for i := range chunks {
+		chunks[i].Embedding = vectors[i]
+	}
+	return activities.Database.SaveArticleChunks(ctx, newsArticle.ID, chunks)
+}
+
+// [RO] Activitate Migrare: Indexarea Articolelor Vechi
+// Un pas după cursor (Processed = 0 înseamnă gata). Un articol care eșuează este numărat și
+// sărit, nu oprește migrarea; rămâne fără pasaje și este reluat la următoarea migrare.
+func (activities *NewsProcessingActivities) BackfillArticleChunksActivity(ctx context.Context, cursor ChunkBackfillCursor) (*ChunkBackfillBatch, error) {
+	articles, err := activities.Database.RetrieveArticlesWithoutChunks(ctx, cursor.PublishedAt, cursor.ArticleID, articleChunkBackfillBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &ChunkBackfillBatch{Processed: len(articles), Next: cursor}
+	for _, art := range articles {
+		if err := activities.IndexArticleChunksActivity(ctx, *art); err != nil {
+			activity.GetLogger(ctx).Warn("Indexarea pe pasaje a eșuat", "article_id", art.ID, "Error", err)
+			batch.Failed++
+		}
+		batch.Next = ChunkBackfillCursor{PublishedAt: art.PublishedAt, ArticleID: art.ID}
+	}
+	return batch, nil
+}
+
+// [RO] Workflow: Indexarea pe Pasaje a Arhivei
+// Job unic (pornit din panoul Admin) pentru articolele salvate înainte de indexul pe pasaje.
+// Parcurge arhiva o singură dată după cursor, deci articolele fără pasaje (conținut prea scurt)
+// sau cu erori nu blochează migrarea; la fiecare articleChunkBackfillBatchesPerRun pași
+// continuă ca execuție nouă, cu progresul în argument.
+func ArticleChunkBackfillWorkflow(ctx workflow.Context, progress ChunkBackfillProgress) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var tools *NewsProcessingActivities
+
+	for step := 0; step < articleChunkBackfillBatchesPerRun; step++ {
+		var batch ChunkBackfillBatch
+		if err := workflow.ExecuteActivity(ctx, tools.BackfillArticleChunksActivity, progress.Cursor).Get(ctx, &batch); err != nil {
+			return err
+		}
+		if batch.Processed == 0 {
+			workflow.GetLogger(ctx).Info("Indexare pe pasaje finalizată", "processed", progress.Processed, "failed", progress.Failed)
+			return nil
+		}
+		progress.Cursor = batch.Next
+		progress.Processed += batch.Processed
+		progress.Failed += batch.Failed
+	}
+	return workflow.NewContinueAsNewError(ctx, ArticleChunkBackfillWorkflow, progress)
+}
@@ -60,8 +60,16 @@ func CausalChainWorkflow(ctx workflow.Context) error {
 
 		// Step 3: Graph Rebalancing (Dgraph Upsert)
 		// Detects cycles and inserts edges
-		if err := workflow.ExecuteActivity(ctx, tools.UpsertCausalGraph, processedData).Get(ctx, nil); err != nil {
+		var eventID string
+		upsertInput := CausalGraphUpsertInput{ArticleURL: signal.ArticleURL, Analysis: processedData}
+		if err := workflow.ExecuteActivity(ctx, tools.UpsertCausalGraph, upsertInput).Get(ctx, &eventID); err != nil {
 			logger.Error("Graph upsert failed", "Error", err)
+			continue
+		}
+
+		// Step 4: Retroactive Causality (debounced per event)
+		if err := workflow.ExecuteActivity(ctx, tools.ScheduleGraphRebalanceActivity, eventID).Get(ctx, nil); err != nil {
+			logger.Error("Rebalance scheduling failed", "Error", err)
 		}
 	}
 }
@@ -78,23 +86,62 @@ func (activities *NewsProcessingActivities) AnalyzeWithGemini(ctx context.Contex
 	return activities.ArtificialIntelligence.AnalyzeCausality(ctx, rawText, contextEvents)
 }
 
+// [RO] Intrare Upsert Graf
+// Analiza cauzală împreună cu articolul care a raportat evenimentul.
+type CausalGraphUpsertInput struct {
+	ArticleURL string
+	Analysis   causality.AnalysisResult
+}
+
 // [RO] Activitate: Upsert Graf
-func (activities *NewsProcessingActivities) UpsertCausalGraph(ctx context.Context, data causality.AnalysisResult) error {
-	// 1. Generate new ID for this event
-	newEventID := uuid.New().String()
+// Returnează ID-ul evenimentului salvat, pentru programarea rebalansării.
+func (activities *NewsProcessingActivities) UpsertCausalGraph(ctx context.Context, input CausalGraphUpsertInput) (string, error) {
+	data := input.Analysis
+
+	// 1. Derive the event ID from the article's story cluster.
+	// Articles not yet clustered in Postgres fall back to their URL as cluster key.
+	clusterID, err := activities.Database.RetrieveStoryClusterIDByURL(ctx, input.ArticleURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve story cluster: %w", err)
+	}
+	storyCluster := input.ArticleURL
+	if clusterID != uuid.Nil {
+		storyCluster = clusterID.String()
+	}
+	newEventID := string(causality.StoryEventID(storyCluster))
 	timestamp := time.Now()
 
+	// 1b. Older analyses of this article left events with random IDs; fold them into the story event.
+	linkedEventIDs, err := activities.KnowledgeGraph.RetrieveArticleEventIDs(ctx, input.ArticleURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to load events reported by article: %w", err)
+	}
+	for _, legacyEventID := range linkedEventIDs {
+		if !causality.IsLegacyEventID(legacyEventID) {
+			continue
+		}
+		if err := activities.KnowledgeGraph.MergeCausalEventNodes(ctx, legacyEventID, newEventID, storyCluster); err != nil {
+			return "", fmt.Errorf("failed to merge legacy event %s: %w", legacyEventID, err)
+		}
+	}
+
 	fmt.Printf("Persisting Event: %s (Trust: %f)\n", data.EventProcessing.NeutralHeadline, data.EventProcessing.BridgingScore)
 
 	// 2. Upsert the Event Node
 	if err := activities.KnowledgeGraph.UpsertCausalEvent(
 		ctx,
 		newEventID,
+		storyCluster,
 		timestamp,
 		data.EventProcessing.NeutralHeadline,
 		data.EventProcessing.BridgingScore,
 	); err != nil {
-		return fmt.Errorf("failed to upsert event node: %w", err)
+		return "", fmt.Errorf("failed to upsert event node: %w", err)
+	}
+
+	// 2b. Trace the event back to its source article (event.reported_by)
+	if err := activities.KnowledgeGraph.LinkEventToArticle(ctx, newEventID, input.ArticleURL); err != nil {
+		return "", fmt.Errorf("failed to link event to article: %w", err)
 	}
 
 	// 3. Create Causal Edges
@@ -110,9 +157,9 @@ func (activities *NewsProcessingActivities) UpsertCausalGraph(ctx context.Contex
 		if err := activities.KnowledgeGraph.CreateCausalEdge(ctx, link.TargetEventID, newEventID, link.Type); err != nil {
 			// Log error but don't fail the whole transaction?
 			// For strictness, we return error.
-			return fmt.Errorf("failed to create edge to %s: %w", link.TargetEventID, err)
+			return "", fmt.Errorf("failed to create edge to %s: %w", link.TargetEventID, err)
 		}
 	}
 
-	return nil
+	return newEventID, nil
 }
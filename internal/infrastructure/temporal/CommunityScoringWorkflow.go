@@ -0,0 +1,57 @@
+package temporal
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/community"
+)
+
+// [RO] Activitate: Consensul de Punte pe o Dimensiune
+// Citește toate evaluările dimensiunii, păstrează doar evaluatorii cu istoric (MinRaterHistory),
+// rulează factorizarea și salvează scorurile.
+// Returnează numărul de elemente punctate.
+func (activities *NewsProcessingActivities) ScoreCommunityRatingsActivity(ctx context.Context, dimension string, scoredAt time.Time) (int, error) {
+	ratings, err := activities.CommunityRatings.RetrieveRatings(ctx, dimension)
+	if err != nil {
+		return 0, err
+	}
+	ratings = community.EstablishedRaterRatings(ratings, community.MinRaterHistory)
+	if len(ratings) == 0 {
+		return 0, nil
+	}
+	scores := community.NewBridgingModel().Score(ratings, scoredAt)
+	if err := activities.CommunityRatings.SaveItemScores(ctx, scores); err != nil {
+		return 0, err
+	}
+	return len(scores), nil
+}
+
+// [RO] Workflow: Scorurile Comunității (Cron)
+// Factorizarea se reface pe toate evaluările la fiecare rulare: factorii evaluatorilor
+// (tabăra lor) depind de toate elementele, nu doar de cele evaluate recent.
+func CommunityScoringWorkflow(ctx workflow.Context) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var tools *NewsProcessingActivities
+
+	scoredAt := workflow.Now(ctx)
+	for _, dimension := range community.Dimensions {
+		var scored int
+		if err := workflow.ExecuteActivity(ctx, tools.ScoreCommunityRatingsActivity, dimension, scoredAt).Get(ctx, &scored); err != nil {
+			return err
+		}
+		workflow.GetLogger(ctx).Info("Scorurile comunității au fost recalculate", "dimension", dimension, "items", scored)
+	}
+	return nil
+}
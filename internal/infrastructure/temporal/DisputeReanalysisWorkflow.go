@@ -0,0 +1,159 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/causality"
+	"github.com/yourorg/truthweave/internal/domain/dispute"
+)
+
+// [RO] Prefixul ID-ului de workflow: o singură reanaliză pe articol în același timp
+const DisputeReanalysisWorkflowIDPrefix = "dispute-reanalysis-"
+
+// [RO] Activitate: Dosarul Contestațiilor
+// Articolul, analiza actuală, contestațiile deschise și rezumatele evenimentelor-cauză contestate.
+// Un eveniment-cauză care nu mai există în graf nu oprește reanaliza: legătura e deja retrasă.
+func (activities *NewsProcessingActivities) LoadDisputeCaseActivity(ctx context.Context, articleID uuid.UUID) (*dispute.DisputeCase, error) {
+	disputes, err := activities.Disputes.ListOpenDisputes(ctx, articleID)
+	if err != nil || len(disputes) == 0 {
+		return &dispute.DisputeCase{ArticleID: articleID}, err
+	}
+
+	newsArticle, err := activities.Database.RetrieveNewsArticleByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	disputeCase := &dispute.DisputeCase{
+		ArticleID:      articleID,
+		Title:          newsArticle.Title,
+		RawContent:     newsArticle.RawContent,
+		TruthScore:     newsArticle.TruthScore,
+		BiasRating:     newsArticle.BiasRating,
+		CauseSummaries: map[string]string{},
+		Disputes:       disputes,
+	}
+	if newsArticle.StoryClusterID != uuid.Nil {
+		disputeCase.StoryEventID = string(causality.StoryEventID(newsArticle.StoryClusterID.String()))
+	}
+	for _, item := range disputes {
+		if item.Target != dispute.TargetCausalLink {
+			continue
+		}
+		if _, seen := disputeCase.CauseSummaries[item.CauseEventID]; seen {
+			continue
+		}
+		summary, err := activities.KnowledgeGraph.RetrieveCausalEventSummary(ctx, item.CauseEventID)
+		if errors.Is(err, causality.ErrEventNotFound) {
+			disputeCase.CauseSummaries[item.CauseEventID] = ""
+			disputeCase.MissingCauses = append(disputeCase.MissingCauses, item.CauseEventID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		disputeCase.CauseSummaries[item.CauseEventID] = summary
+	}
+	return disputeCase, nil
+}
+
+// [RO] Activitate: Reanaliza cu Dovezile Cititorilor
+// Verdictul modelului devine o notă de rezolvare care schimbă doar câmpurile contestate.
+// Dacă toate contestațiile privesc legături deja dispărute, nota se scrie fără model.
+func (activities *NewsProcessingActivities) ReanalyzeDisputesActivity(ctx context.Context, disputeCase dispute.DisputeCase) (*dispute.ResolutionNote, error) {
+	verdict := &dispute.ReanalysisVerdict{
+		TruthScore: disputeCase.TruthScore,
+		BiasRating: disputeCase.BiasRating,
+		Note:       "Legăturile cauzale contestate nu mai există în graf.",
+	}
+	if len(disputeCase.ReviewableDisputes()) > 0 {
+		var err error
+		verdict, err = activities.ArtificialIntelligence.ReanalyzeDisputedArticle(ctx, disputeCase)
+		if err != nil {
+			return nil, err
+		}
+	}
+	note := dispute.ResolveDisputes(disputeCase, *verdict, time.Now().UTC())
+	return &note, nil
+}
+
+// [RO] Activitate: Publicarea Rezolvării
+// Întâi baza de date (nota, corecturile, contestațiile închise), apoi legăturile retrase din graf.
+// Ambii pași sunt idempotenți, deci o reîncercare este sigură.
+func (activities *NewsProcessingActivities) ApplyDisputeResolutionActivity(ctx context.Context, note dispute.ResolutionNote) error {
+	if err := activities.Disputes.ApplyDisputeResolution(ctx, note); err != nil {
+		return err
+	}
+	if note.StoryEventID == "" {
+		return nil
+	}
+	for _, cause := range note.RetractedCauses {
+		if err := activities.KnowledgeGraph.RemoveCausalEdge(ctx, cause, note.StoryEventID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// [RO] Activitate: Mai Trebuie o Reanaliză?
+// Contestațiile sosite în timpul reanalizei au rămas deschise; pragul se verifică din nou pe ele.
+func (activities *NewsProcessingActivities) CheckDisputeReanalysisDueActivity(ctx context.Context, articleID uuid.UUID) (bool, error) {
+	open, err := activities.Disputes.ListOpenDisputes(ctx, articleID)
+	if err != nil {
+		return false, err
+	}
+	return dispute.TallyOpenDisputes(articleID, open, activities.DisputeThreshold).ReanalysisDue, nil
+}
+
+// [RO] Workflow: Reanaliza unui Articol Contestat
+// Pornit de API când contestațiile deschise ale articolului depășesc pragul de dovezi distincte.
+// API-ul nu poate porni o a doua rulare cu același ID, așa că, după rezolvare, workflow-ul recitește
+// contestațiile deschise și continuă ca nou dacă pragul e încă atins.
+func DisputeReanalysisWorkflow(ctx workflow.Context, articleID uuid.UUID) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 2,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+	logger := workflow.GetLogger(ctx)
+
+	var tools *NewsProcessingActivities
+
+	var disputeCase dispute.DisputeCase
+	if err := workflow.ExecuteActivity(ctx, tools.LoadDisputeCaseActivity, articleID).Get(ctx, &disputeCase); err != nil {
+		return err
+	}
+	if len(disputeCase.Disputes) == 0 {
+		logger.Info("Nicio contestație deschisă, reanaliza nu mai este necesară", "article_id", articleID)
+		return nil
+	}
+
+	var note dispute.ResolutionNote
+	if err := workflow.ExecuteActivity(ctx, tools.ReanalyzeDisputesActivity, disputeCase).Get(ctx, &note); err != nil {
+		return err
+	}
+	if err := workflow.ExecuteActivity(ctx, tools.ApplyDisputeResolutionActivity, note).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	logger.Info("Contestațiile au fost rezolvate", "article_id", articleID, "outcome", note.Outcome, "disputes", len(note.DisputeIDs))
+
+	var reanalysisDue bool
+	if err := workflow.ExecuteActivity(ctx, tools.CheckDisputeReanalysisDueActivity, articleID).Get(ctx, &reanalysisDue); err != nil {
+		return err
+	}
+	if reanalysisDue {
+		logger.Info("Contestații noi au atins pragul în timpul reanalizei, o reluăm", "article_id", articleID)
+		return workflow.NewContinueAsNewError(ctx, DisputeReanalysisWorkflow, articleID)
+	}
+	return nil
+}
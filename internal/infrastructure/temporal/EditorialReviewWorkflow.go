@@ -0,0 +1,101 @@
+package temporal
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/review"
+)
+
+// [RO] Activitate 4d: Evaluare Editorială
+// Motivele opririi (integritate, scor nesigur, emoție intensă, legături cauzale grave) și
+// termenul de așteptare, cu pragurile din configurare.
+func (activities *NewsProcessingActivities) AssessEditorialReviewActivity(executionContext context.Context, candidate article.NewsArticleEntity) (*review.Assessment, error) {
+	assessment := review.AssessArticle(candidate, activities.ReviewPolicy)
+	return &assessment, nil
+}
+
+// [RO] Activitate 4e: Intrare în Coada de Verificare
+func (activities *NewsProcessingActivities) OpenEditorialReviewActivity(executionContext context.Context, item review.EditorialReview) error {
+	return activities.Reviews.OpenEditorialReview(executionContext, item)
+}
+
+// [RO] Activitate 4f: Încheierea Verificării
+func (activities *NewsProcessingActivities) CloseEditorialReviewActivity(executionContext context.Context, resolution review.EditorialResolution) error {
+	return activities.Reviews.CloseEditorialReview(executionContext, resolution)
+}
+
+// [RO] Așteaptă Decizia Editorului (Human-in-the-Loop)
+//
+// Ciorna intră în coada /admin/review, apoi workflow-ul doarme până la primul semnal
+// EditorialDecision valid sau până la termen. Returnează articolul de publicat (nil = respins).
+// Semnalele invalide (trimise ocolind API-ul) sunt ignorate, iar așteptarea continuă.
+func awaitEditorialDecision(ctx workflow.Context, draft article.NewsArticleEntity, assessment review.Assessment) (*article.NewsArticleEntity, error) {
+	logger := workflow.GetLogger(ctx)
+	var tools *NewsProcessingActivities
+
+	info := workflow.GetInfo(ctx)
+	openedAt := workflow.Now(ctx)
+	item := review.EditorialReview{
+		ArticleID:  draft.ID,
+		WorkflowID: info.WorkflowExecution.ID,
+		RunID:      info.WorkflowExecution.RunID,
+		Reasons:    assessment.Reasons,
+		Status:     review.StatusPending,
+		Draft:      draft,
+		CreatedAt:  openedAt,
+		Deadline:   openedAt.Add(assessment.Timeout),
+	}
+	if err := workflow.ExecuteActivity(ctx, tools.OpenEditorialReviewActivity, item).Get(ctx, nil); err != nil {
+		return nil, err
+	}
+	logger.Info("Articolul așteaptă verificarea editorială", "article_id", draft.ID, "reasons", assessment.Reasons, "deadline", item.Deadline)
+
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	deadline := workflow.NewTimer(timerCtx, assessment.Timeout)
+	decisions := workflow.GetSignalChannel(ctx, review.EditorialDecisionSignal)
+
+	var decision *review.EditorialDecision
+	expired := false
+	for decision == nil && !expired {
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(deadline, func(f workflow.Future) {
+			expired = true
+		})
+		selector.AddReceive(decisions, func(c workflow.ReceiveChannel, more bool) {
+			var received review.EditorialDecision
+			c.Receive(ctx, &received)
+			if err := received.Validate(); err != nil {
+				logger.Warn("Decizie editorială invalidă ignorată", "article_id", draft.ID, "Error", err)
+				return
+			}
+			decision = &received
+		})
+		selector.Select(ctx)
+	}
+
+	var published *article.NewsArticleEntity
+	var status string
+	if decision != nil {
+		published, status = review.ApplyDecision(draft, *decision)
+	} else {
+		published, status = review.ResolveTimeout(draft, assessment.TimeoutAction)
+	}
+
+	resolution := review.EditorialResolution{
+		ArticleID:  draft.ID,
+		WorkflowID: item.WorkflowID,
+		RunID:      item.RunID,
+		Status:     status,
+		Decision:   decision,
+		ResolvedAt: workflow.Now(ctx),
+	}
+	if err := workflow.ExecuteActivity(ctx, tools.CloseEditorialReviewActivity, resolution).Get(ctx, nil); err != nil {
+		return nil, err
+	}
+	logger.Info("Verificarea editorială s-a încheiat", "article_id", draft.ID, "status", status)
+	return published, nil
+}
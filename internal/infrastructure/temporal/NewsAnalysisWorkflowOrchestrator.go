@@ -4,16 +4,22 @@ import (
 	"context"
 	"time"
 
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 
 	"github.com/google/uuid"
 	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/geo"
+	"github.com/yourorg/truthweave/internal/domain/review"
 
 	"github.com/yourorg/truthweave/internal/infrastructure/dgraph"
+	"github.com/yourorg/truthweave/internal/infrastructure/gazetteer"
 	"github.com/yourorg/truthweave/internal/infrastructure/gdelt"
 	"github.com/yourorg/truthweave/internal/infrastructure/gemini"
+	"github.com/yourorg/truthweave/internal/infrastructure/nats"
 	"github.com/yourorg/truthweave/internal/infrastructure/postgres"
+	entityusecase "github.com/yourorg/truthweave/internal/usecase/entity"
 )
 
 // [RO] Activitățile Fluxului de Lucru
@@ -24,19 +30,35 @@ type NewsProcessingActivities struct {
 	KnowledgeGraph         *dgraph.DgraphKnowledgeGraphRepository
 	Database               *postgres.PostgresNewsArticleRepository
 	NewsFetcher            *gdelt.GDELTAdapter // Replaced NewsAPI with GDELT V2
+	Orchestrator           *TemporalOrchestratorClient
+	EntityResolver         *entityusecase.EntityResolutionService
+	Gazetteer              *gazetteer.OfflineGazetteer
+	Analytics              *postgres.PostgresAnalyticsRollupRepository
+	Trends                 *postgres.PostgresTrendSignalRepository
+	Events                 *nats.JetStreamEventPublisher // Opțional (nil = fără publicare)
+	Reviews                *postgres.PostgresEditorialReviewRepository
+	Disputes               *postgres.PostgresReaderDisputeRepository
+	CommunityRatings       *postgres.PostgresCommunityRatingRepository
+	Perspectives           *postgres.PostgresStoryPerspectiveRepository
 	DeduplicationThreshold float64
+	StoryClusterThreshold  float64
+	ReviewPolicy           review.ReviewPolicy // Valorile zero = cele implicite
+	DisputeThreshold       int                 // <= 0 = dispute.DefaultReanalysisThreshold
 }
 
 // [RO] Rezultat Similaritate
+// Decizia (IsDuplicate) se ia în activitate, cu pragul din configurare; workflow-ul doar o aplică.
 type SimilarityCheckResult struct {
 	ExistingArticle *article.NewsArticleEntity
 	SimilarityScore float64
+	IsDuplicate     bool
 }
 
 // [RO] Activitate: Colectare Știri Globale (Reală)
-// Acum folosește GDELT Project V2 pentru a detecta evenimente cu impact major (|tone| > 5).
-func (activities *NewsProcessingActivities) FetchLatestGlobalNewsActivity(ctx context.Context) ([]string, error) {
-	return activities.NewsFetcher.FetchHighImpactEvents(ctx)
+// Folosește GDELT Project V2: evenimentele despre subiectele în trend (orice ton) și cele
+// cu impact major (|tone| > 5), în liste separate.
+func (activities *NewsProcessingActivities) FetchLatestGlobalNewsActivity(ctx context.Context, trendingTerms []string) (*gdelt.PrioritizedEvents, error) {
+	return activities.NewsFetcher.FetchPrioritizedEvents(ctx, trendingTerms)
 }
 
 // [RO] Activitate 1: Extragere Conținut (Scraping)
@@ -47,46 +69,111 @@ func (activities *NewsProcessingActivities) ExtractWebPageContentActivity(execut
 	return "Simulated FULL content scraped from " + url + ". The situation is evolving rapidly...", nil
 }
 
+// [RO] Câți candidați LSH comparăm pentru o amprentă
+const fingerprintCandidateLimit = 50
+
+// [RO] Activitate 1b: Amprentă Lexicală (SimHash)
+// Copiile exacte și aproape exacte (agenții de presă republicate pe sute de site-uri) sunt
+// găsite fără vector și fără AI. Nil = text nou (sau prea scurt pentru o amprentă de încredere).
+func (activities *NewsProcessingActivities) CheckLexicalFingerprintActivity(executionContext context.Context, rawContent string) (*article.FingerprintMatch, error) {
+	fingerprint, ok := article.ComputeContentFingerprint(rawContent)
+	if !ok {
+		return nil, nil
+	}
+	candidates, err := activities.Database.FindFingerprintCandidates(executionContext, fingerprint, fingerprintCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	return article.BestFingerprintMatch(fingerprint, candidates), nil
+}
+
 // [RO] Activitate 2: Generare Vector Semantic
 func (activities *NewsProcessingActivities) GenerateSemanticVectorActivity(executionContext context.Context, text string) ([]float32, error) {
 	return activities.ArtificialIntelligence.GenerateSemanticVector(executionContext, text)
 }
 
 // [RO] Activitate 3: Verificare Duplicate (Deduplicare)
+// Cel mai apropiat articol din ultimele două săptămâni și similaritatea lui cosine reală.
+// Este duplicat doar dacă similaritatea atinge pragul configurat (DEDUPLICATION_THRESHOLD).
 func (activities *NewsProcessingActivities) CheckForExistingDuplicatesActivity(executionContext context.Context, embedding []float32) (*SimilarityCheckResult, error) {
-	articles, err := activities.Database.FindSemanticallySimilarArticles(executionContext, embedding, 1)
+	filter := article.SimilarityFilter{PublishedAfter: time.Now().Add(-article.DeduplicationWindow)}
+	matches, err := activities.Database.FindSemanticallySimilarArticles(executionContext, embedding, filter, 1)
 	if err != nil {
 		return nil, err
 	}
-	if len(articles) == 0 {
-		return &SimilarityCheckResult{ExistingArticle: nil, SimilarityScore: 0.0}, nil
+	if len(matches) == 0 {
+		return &SimilarityCheckResult{}, nil
 	}
 
-	// [RO] Folosim Pragul din Configurare
-	threshold := activities.DeduplicationThreshold
-	if threshold == 0 {
-		threshold = 0.90 // Fallback dacă nu e setat
-	}
+	bestMatch := matches[0]
+	threshold := article.EffectiveDeduplicationThreshold(activities.DeduplicationThreshold)
+	return &SimilarityCheckResult{
+		ExistingArticle: bestMatch.Article,
+		SimilarityScore: bestMatch.Similarity,
+		IsDuplicate:     bestMatch.Similarity >= threshold,
+	}, nil
+}
 
-	bestMatch := articles[0]
-	// Simulam scorul de similaritate pentru demo (deoarece pgvector nu-l returneaza direct in structura entity aici)
-	simScore := 0.9
+// [RO] Activitate 3b: Înregistrare Copie
+// Copia nu mai trece prin analiză, dar rămâne legată de original (`duplicate_of`).
+func (activities *NewsProcessingActivities) RecordDuplicateArticleActivity(executionContext context.Context, link article.DuplicateLink) error {
+	return activities.Database.RecordDuplicateArticle(executionContext, link)
+}
 
-	if simScore < threshold {
-		return &SimilarityCheckResult{ExistingArticle: nil, SimilarityScore: simScore}, nil
+// [RO] Activitate 4: Analiză AI Completă
+// Fragmentele neutralizate sunt ancorate în textul original, apoi rezultatul trece prin verificarea
+// de integritate: analizele dictate de text (instrucțiuni injectate, scor perfect + rescriere
+// identică) primesc `ReviewFlags` pentru verificarea umană.
+func (activities *NewsProcessingActivities) AnalyzeNewsContentActivity(executionContext context.Context, rawContent string) (*article.AIAnalysisResult, error) {
+	result, err := activities.ArtificialIntelligence.AnalyzeAndNeutralizeNewsContent(executionContext, rawContent)
+	if err != nil {
+		return nil, err
 	}
+	result.Annotations = article.AnchorNeutralizationAnnotations(rawContent, result.Annotations)
+	result.ReviewFlags = article.ReviewAnalysisIntegrity(rawContent, *result)
+	return result, nil
+}
 
-	return &SimilarityCheckResult{ExistingArticle: bestMatch, SimilarityScore: simScore}, nil
+// [RO] Activitate 4b: Rezolvarea Entităților (Canonicalizare)
+// "Biden" și "President Biden" primesc același ID canonic înainte să ajungă în graf.
+func (activities *NewsProcessingActivities) ResolveEntitiesActivity(executionContext context.Context, mentions []article.NamedEntity) ([]article.NamedEntity, error) {
+	return activities.EntityResolver.ResolveMentions(executionContext, mentions)
 }
 
-// [RO] Activitate 4: Analiză AI Completă
-func (activities *NewsProcessingActivities) AnalyzeNewsContentActivity(executionContext context.Context, rawContent string) (*article.AIAnalysisResult, error) {
-	return activities.ArtificialIntelligence.AnalyzeAndNeutralizeNewsContent(executionContext, rawContent)
+// [RO] Activitate 4c: Geocodare (Baza de Cunoștințe + Gazetteer Offline)
+// Corectează (0,0) din locurile menționate și află țara/regiunea punctului final.
+func (activities *NewsProcessingActivities) ResolveGeolocationActivity(executionContext context.Context, point article.GaiaPoint, mentions []article.NamedEntity) (*article.GaiaPlacement, error) {
+	var lookup geo.GazetteerLookupInterface
+	if activities.Gazetteer != nil {
+		lookup = activities.Gazetteer
+	}
+	placement := article.PlaceGaiaGeolocation(point, mentions, lookup)
+	return &placement, nil
+}
+
+// [RO] Identitatea Articolului Salvat
+// La re-analiza unui URL deja salvat, rândul existent își păstrează ID-ul și grupul poveștii.
+type PersistedArticle struct {
+	ID             uuid.UUID
+	StoryClusterID uuid.UUID
 }
 
 // [RO] Activitate 5: Salvare în Baza de Date
-func (activities *NewsProcessingActivities) PersistAnalysisToDatabaseActivity(executionContext context.Context, newsArticle article.NewsArticleEntity) error {
-	return activities.Database.PersistNewsArticle(executionContext, &newsArticle)
+// Împreună cu amprenta lexicală a textului brut, ca viitoarele copii să fie recunoscute.
+// Amprenta și mențiunile folosesc ID-ul rândului salvat, nu pe cel generat de workflow.
+func (activities *NewsProcessingActivities) PersistAnalysisToDatabaseActivity(executionContext context.Context, newsArticle article.NewsArticleEntity) (*PersistedArticle, error) {
+	if err := activities.Database.PersistNewsArticle(executionContext, &newsArticle); err != nil {
+		return nil, err
+	}
+	if fingerprint, ok := article.ComputeContentFingerprint(newsArticle.RawContent); ok {
+		if err := activities.Database.SaveArticleFingerprint(executionContext, newsArticle.ID, fingerprint); err != nil {
+			return nil, err
+		}
+	}
+	if err := activities.EntityResolver.RecordArticleMentions(executionContext, newsArticle.ID, newsArticle.Mentions); err != nil {
+		return nil, err
+	}
+	return &PersistedArticle{ID: newsArticle.ID, StoryClusterID: newsArticle.StoryClusterID}, nil
 }
 
 // [RO] Activitate 6: Actualizare Graf Cunoștințe
@@ -114,6 +201,24 @@ func OrchestrateNewsAnalysisWorkflow(workflowContext workflow.Context, articleUR
 		return err
 	}
 
+	// 1b. Lexical Fingerprint (copiile nu ajung la vector și nici la AI)
+	var lexicalMatch *article.FingerprintMatch
+	if err := workflow.ExecuteActivity(workflowContext, tools.CheckLexicalFingerprintActivity, rawContent).Get(workflowContext, &lexicalMatch); err != nil {
+		return err
+	}
+	if lexicalMatch != nil {
+		logger.Info("[RO] Copie lexicală detectată. Legăm copia de original și oprim procesarea.",
+			"existing_id", lexicalMatch.ArticleID, "method", lexicalMatch.Method, "distance", lexicalMatch.Distance)
+		link := article.DuplicateLink{
+			OriginalURL: articleURL,
+			DuplicateOf: lexicalMatch.ArticleID,
+			Similarity:  lexicalMatch.Similarity,
+			Method:      lexicalMatch.Method,
+			DetectedAt:  workflow.Now(workflowContext),
+		}
+		return workflow.ExecuteActivity(workflowContext, tools.RecordDuplicateArticleActivity, link).Get(workflowContext, nil)
+	}
+
 	// 2. Vector
 	var semanticVector []float32
 	if err := workflow.ExecuteActivity(workflowContext, tools.GenerateSemanticVectorActivity, rawContent).Get(workflowContext, &semanticVector); err != nil {
@@ -126,11 +231,23 @@ func OrchestrateNewsAnalysisWorkflow(workflowContext workflow.Context, articleUR
 		return err
 	}
 
-	// Logică duplicat în Workflow (folosind un threshold hardcoded aici pentru siguranță sau bazându-ne pe activitate dacă returna bool)
-	// Pentru consistență cu activitatea care returnează score, decidem aici.
-	if similarityResult.SimilarityScore >= 0.90 && similarityResult.ExistingArticle != nil {
-		logger.Info("[RO] Duplicat detectat. Oprim procesarea.", "existing_id", similarityResult.ExistingArticle.ID)
-		return nil
+	if similarityResult.IsDuplicate && similarityResult.ExistingArticle != nil {
+		logger.Info("[RO] Duplicat detectat. Legăm copia de original și oprim procesarea.",
+			"existing_id", similarityResult.ExistingArticle.ID, "similarity", similarityResult.SimilarityScore)
+		link := article.DuplicateLink{
+			OriginalURL: articleURL,
+			DuplicateOf: similarityResult.ExistingArticle.ID,
+			Similarity:  similarityResult.SimilarityScore,
+			Method:      article.DuplicateMethodSemantic,
+			DetectedAt:  workflow.Now(workflowContext),
+		}
+		return workflow.ExecuteActivity(workflowContext, tools.RecordDuplicateArticleActivity, link).Get(workflowContext, nil)
+	}
+
+	// 3b. Story Cluster (identitatea Evenimentului din graf)
+	var existingStoryCluster string
+	if err := workflow.ExecuteActivity(workflowContext, tools.ResolveStoryClusterActivity, semanticVector).Get(workflowContext, &existingStoryCluster); err != nil {
+		return err
 	}
 
 	// 4. AI Analysis
@@ -139,46 +256,113 @@ func OrchestrateNewsAnalysisWorkflow(workflowContext workflow.Context, articleUR
 		return err
 	}
 
+	// 4b. Entity Resolution
+	var resolvedMentions []article.NamedEntity
+	if err := workflow.ExecuteActivity(workflowContext, tools.ResolveEntitiesActivity, aiAnalysis.Entities).Get(workflowContext, &resolvedMentions); err != nil {
+		return err
+	}
+
+	// 4c. Geocoding (Wikidata + gazetteer; (0,0) -> locul menționat, apoi țara/regiunea)
+	var placement article.GaiaPlacement
+	modelPoint := article.GaiaPoint{
+		ID:        newWorkflowUUID(workflowContext).String(),
+		Latitude:  aiAnalysis.Location.Latitude,
+		Longitude: aiAnalysis.Location.Longitude,
+		Emotion:   aiAnalysis.Location.Emotion,
+		Intensity: aiAnalysis.Location.Intensity,
+	}
+	if err := workflow.ExecuteActivity(workflowContext, tools.ResolveGeolocationActivity, modelPoint, resolvedMentions).Get(workflowContext, &placement); err != nil {
+		return err
+	}
+
 	// Construcție Entitate
+	// [RO] ID-ul e înregistrat în istoric: la replay (ex: după 48h de așteptare editorială)
+	// ciorna și articolul publicat păstrează același ID.
+	articleID := newWorkflowUUID(workflowContext)
 	processedArticle := article.NewsArticleEntity{
-		ID:          uuid.New(),
-		OriginalURL: articleURL,
-		Title:       "Analyzed: " + articleURL,
-		Content:     aiAnalysis.RewrittenText,
-		RawContent:  rawContent,
-		Summary:     aiAnalysis.Summary,
-		TruthScore:  aiAnalysis.Score,
-		BiasRating:  aiAnalysis.BiasRating,
-		Embedding:   semanticVector,
-		PublishedAt: workflow.Now(workflowContext),
-		Geolocation: article.GaiaPoint{
-			ID:        uuid.New().String(),
-			Latitude:  aiAnalysis.Location.Latitude,
-			Longitude: aiAnalysis.Location.Longitude,
-			Emotion:   aiAnalysis.Location.Emotion,
-			Intensity: aiAnalysis.Location.Intensity,
-		},
+		ID:              articleID,
+		OriginalURL:     articleURL,
+		Title:           "Analyzed: " + articleURL,
+		Content:         aiAnalysis.RewrittenText,
+		RawContent:      rawContent,
+		Summary:         aiAnalysis.Summary,
+		TruthScore:      aiAnalysis.Score,
+		BiasRating:      aiAnalysis.BiasRating,
+		Embedding:       semanticVector,
+		PublishedAt:     workflow.Now(workflowContext),
+		StoryClusterID:  resolveStoryClusterID(existingStoryCluster, articleID),
+		Geolocation:     placement.Point,
+		CountryCode:     placement.CountryCode,
+		RegionCode:      placement.RegionCode,
 		GlobalEmotion:   aiAnalysis.GlobalEmotion,
+		Sector:          article.NormalizeSector(aiAnalysis.Sector),
 		Causes:          aiAnalysis.CausalRelations,
 		CounterArgument: aiAnalysis.CounterArgument,
-		Mentions:        aiAnalysis.Entities,
+		Mentions:        resolvedMentions,
+		ReviewFlags:     aiAnalysis.ReviewFlags,
+		Neutralizations: aiAnalysis.Annotations,
+	}
+	if len(processedArticle.ReviewFlags) > 0 {
+		logger.Warn("Analiza a fost marcată pentru verificare umană", "article_id", articleID, "reasons", processedArticle.ReviewFlags)
+	}
+
+	// 4d. Editorial Review (analizele nesigure sau controversate așteaptă decizia unui editor)
+	var assessment review.Assessment
+	if err := workflow.ExecuteActivity(workflowContext, tools.AssessEditorialReviewActivity, processedArticle).Get(workflowContext, &assessment); err != nil {
+		return err
+	}
+	if len(assessment.Reasons) > 0 {
+		reviewed, err := awaitEditorialDecision(workflowContext, processedArticle, assessment)
+		if err != nil {
+			return err
+		}
+		if reviewed == nil {
+			return nil
+		}
+		processedArticle = *reviewed
 	}
 
 	// 5. Save DB
-	if err := workflow.ExecuteActivity(workflowContext, tools.PersistAnalysisToDatabaseActivity, processedArticle).Get(workflowContext, nil); err != nil {
+	var persisted PersistedArticle
+	if err := workflow.ExecuteActivity(workflowContext, tools.PersistAnalysisToDatabaseActivity, processedArticle).Get(workflowContext, &persisted); err != nil {
 		return err
 	}
+	// [RO] URL re-analizat: pasajele, graful și evenimentul se leagă de rândul existent
+	processedArticle.ID = persisted.ID
+	processedArticle.StoryClusterID = persisted.StoryClusterID
+
+	// 5b. Index pe pasaje (RAG). Doar un index: la eșec, migrarea din Admin îl recuperează.
+	if err := workflow.ExecuteActivity(workflowContext, tools.IndexArticleChunksActivity, processedArticle).Get(workflowContext, nil); err != nil {
+		logger.Warn("Indexarea pe pasaje a eșuat", "article_id", processedArticle.ID, "Error", err)
+	}
 
 	// 6. Save Graph
 	if err := workflow.ExecuteActivity(workflowContext, tools.ConnectKnowledgeGraphActivity, processedArticle).Get(workflowContext, nil); err != nil {
 		return err
 	}
 
+	// 7. Article -> Event (event.reported_by)
+	var eventID string
+	if err := workflow.ExecuteActivity(workflowContext, tools.LinkStoryEventActivity, processedArticle).Get(workflowContext, &eventID); err != nil {
+		return err
+	}
+
+	// 8. Retroactive Causality (debounced per event)
+	if err := workflow.ExecuteActivity(workflowContext, tools.ScheduleGraphRebalanceActivity, eventID).Get(workflowContext, nil); err != nil {
+		logger.Warn("Programarea rebalansării a eșuat", "event_id", eventID, "Error", err)
+	}
+
+	// 9. Perspectivele poveștii (regenerate doar dacă articolele grupului s-au schimbat)
+	if err := workflow.ExecuteActivity(workflowContext, tools.SchedulePerspectiveRefreshActivity, processedArticle.StoryClusterID).Get(workflowContext, nil); err != nil {
+		logger.Warn("Programarea perspectivelor a eșuat", "story_cluster_id", processedArticle.StoryClusterID, "Error", err)
+	}
+
 	return nil
 }
 
 // [RO] Agregatorul Global (Cron Workflow)
 // Rulează periodic, aduce știri, lansează procesarea individuală.
+// Subiectele în trend (semnalele din TrendDetectionWorkflow) sunt colectate și lansate primele.
 func GlobalNewsIngestionWorkflow(ctx workflow.Context) error {
 	options := workflow.ActivityOptions{
 		StartToCloseTimeout: time.Minute * 2,
@@ -188,19 +372,27 @@ func GlobalNewsIngestionWorkflow(ctx workflow.Context) error {
 
 	var tools *NewsProcessingActivities
 
-	// 1. Fetch Headlines
-	var newArticleURLs []string
-	if err := workflow.ExecuteActivity(ctx, tools.FetchLatestGlobalNewsActivity).Get(ctx, &newArticleURLs); err != nil {
-		logger.Error("Eșec NewsAPI", "Error", err)
+	// 1. Trending Terms (opțional: fără ele rămâne doar filtrul de ton)
+	var trendingTerms []string
+	if err := workflow.ExecuteActivity(ctx, tools.LoadTrendingTermsActivity).Get(ctx, &trendingTerms); err != nil {
+		logger.Warn("Termenii în trend nu au putut fi citiți", "Error", err)
+	}
+
+	// 2. Fetch Headlines
+	var batch gdelt.PrioritizedEvents
+	if err := workflow.ExecuteActivity(ctx, tools.FetchLatestGlobalNewsActivity, trendingTerms).Get(ctx, &batch); err != nil {
+		logger.Error("Eșec GDELT", "Error", err)
 		return err
 	}
 
-	logger.Info("Am găsit știri noi", "count", len(newArticleURLs))
+	logger.Info("Am găsit știri noi", "trending", len(batch.Trending), "high_impact", len(batch.HighImpact))
 
-	// 2. Fan-Out
-	for _, url := range newArticleURLs {
+	// 3. Fan-Out (trendurile primele)
+	for _, url := range append(batch.Trending, batch.HighImpact...) {
 		childOptions := workflow.ChildWorkflowOptions{
 			WorkflowID: "analyze-" + url, // Deduplicare naturală Temporal
+			// Analiza continuă după încheierea colectorului (poate aștepta zile o verificare editorială)
+			ParentClosePolicy: enums.PARENT_CLOSE_POLICY_ABANDON,
 		}
 		childCtx := workflow.WithChildOptions(ctx, childOptions)
 		workflow.ExecuteChildWorkflow(childCtx, OrchestrateNewsAnalysisWorkflow, url)
@@ -208,3 +400,16 @@ func GlobalNewsIngestionWorkflow(ctx workflow.Context) error {
 
 	return nil
 }
+
+// [RO] UUID Determinist pentru Workflow
+// uuid.New() direct în workflow dă alt ID la fiecare replay; SideEffect îl scrie în istoric.
+func newWorkflowUUID(ctx workflow.Context) uuid.UUID {
+	var id uuid.UUID
+	encoded := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return uuid.New()
+	})
+	if err := encoded.Get(&id); err != nil {
+		panic(err)
+	}
+	return id
+}
@@ -2,27 +2,96 @@ package temporal
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 
+	"github.com/yourorg/truthweave/internal/domain/causality"
 	"github.com/yourorg/truthweave/internal/infrastructure/gemini"
 )
 
+const (
+	// [RO] Semnalul prin care cerem (din nou) rebalansarea unui eveniment (payload RebalanceRequest).
+	// Fiecare semnal nou resetează fereastra de debounce; RunNow o încheie imediat.
+	RebalanceRequestSignal = "RebalanceRequestSignal"
+
+	// [RO] Prefixul ID-ului de workflow: un singur workflow activ per eveniment.
+	RebalanceWorkflowIDPrefix = "rebalance-"
+
+	// [RO] Fereastra de Debounce
+	// Așteptăm atâta liniște (fără semnale noi) înainte să chemăm AI-ul.
+	RebalanceDebounceWindow = 2 * time.Minute
+
+	// [RO] Orizontul Istoric și numărul maxim de candidați trimiși la AI.
+	RebalanceLookbackWindow = 7 * 24 * time.Hour
+	RebalanceCandidateLimit = 20
+
+	// [RO] După atâtea semnale într-o singură așteptare, istoricul e reluat (ContinueAsNew)
+	rebalanceMaxDebounceSignals = 200
+)
+
 type RebalanceInput struct {
 	TargetEventID   string
 	CandidateEvents []gemini.PotentialCause
+
+	// [RO] Dacă e 0, rulăm imediat (ex: cerere manuală din panoul Admin).
+	DebounceWindow time.Duration
+}
+
+// [RO] Cererea din semnal
+// Un semnal fără payload (trimis de versiunile vechi) înseamnă RunNow = false.
+type RebalanceRequest struct {
+	RunNow bool
+}
+
+// [RO] Activitate: Selectare Candidați (7 zile înaintea evenimentului țintă)
+// Aduce din graf evenimentele anterioare țintei care ar putea fi cauza ei; evenimentele
+// apărute după țintă nu pot fi cauze, chiar dacă sunt recente.
+func (activities *NewsProcessingActivities) LoadRebalanceCandidatesActivity(ctx context.Context, targetEventID string) ([]gemini.PotentialCause, error) {
+	until, err := activities.KnowledgeGraph.RetrieveCausalEventTimestamp(ctx, targetEventID)
+	if errors.Is(err, causality.ErrEventNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	since := until.Add(-RebalanceLookbackWindow)
+	events, err := activities.KnowledgeGraph.RetrieveRecentCausalEvents(ctx, since, until, targetEventID, RebalanceCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]gemini.PotentialCause, 0, len(events))
+	for _, ev := range events {
+		candidates = append(candidates, gemini.PotentialCause{
+			ID:      string(ev.ID),
+			Title:   ev.Summary,
+			Summary: ev.Payload,
+		})
+	}
+	return candidates, nil
 }
 
 // [RO] Activitate: Analiză Cauzală Retroactivă
 func (activities *NewsProcessingActivities) CalculateCausalityActivity(ctx context.Context, input RebalanceInput) (*gemini.CausalityAnalysisResult, error) {
-	// Obținem summary-ul target event-ului (Simulat, ar trebui un DB fetch)
-	targetSummary := "Event " + input.TargetEventID + " summary placeholder."
+	// Citim rezumatul real al evenimentului țintă din graf.
+	targetSummary, err := activities.KnowledgeGraph.RetrieveCausalEventSummary(ctx, input.TargetEventID)
+	if err != nil {
+		return nil, err
+	}
 
 	return activities.ArtificialIntelligence.DetermineCausality(ctx, targetSummary, input.CandidateEvents)
 }
 
+// [RO] Activitate: Programare Rebalansare (Debounced)
+// Chemată după fiecare upsert de eveniment. Nu pornește un workflow nou dacă există deja unul
+// în așteptare pentru același eveniment, ci doar îi resetează fereastra de debounce.
+func (activities *NewsProcessingActivities) ScheduleGraphRebalanceActivity(ctx context.Context, eventID string) error {
+	return activities.Orchestrator.ScheduleGraphRebalance(ctx, eventID, RebalanceDebounceWindow)
+}
+
 // [RO] Activitate: Actualizare Graf
 type GraphMutationParams struct {
 	ChildID         string
@@ -40,6 +109,11 @@ func (activities *NewsProcessingActivities) ApplyGraphMutationsActivity(ctx cont
 // [RO] Workflow: Rebalansare Graf (Retroactive Causality)
 // Acest workflow este declanșat atunci când o știre nouă are potențialul de a explica evenimente trecute,
 // sau invers, când vrem să legăm o știre nouă de un context istoric (7 zile).
+//
+// Este pornit prin SignalWithStart cu ID-ul "rebalance-<eventID>", deci o rafală de upsert-uri
+// pentru același eveniment produce un singur workflow, care rulează după ce rafala se liniștește.
+// Cererile sosite cât timp analiza rula (evenimentul s-a schimbat între timp) nu se pierd:
+// la final workflow-ul continuă ca execuție nouă, cu același debounce, și analizează starea nouă.
 func RebalanceGraphWorkflow(ctx workflow.Context, input RebalanceInput) error {
 	options := workflow.ActivityOptions{
 		StartToCloseTimeout: time.Minute * 2,
@@ -51,16 +125,56 @@ func RebalanceGraphWorkflow(ctx workflow.Context, input RebalanceInput) error {
 	ctx = workflow.WithActivityOptions(ctx, options)
 	logger := workflow.GetLogger(ctx)
 
+	signalChan := workflow.GetSignalChannel(ctx, RebalanceRequestSignal)
+	continueWithFreshHistory := func() error {
+		return workflow.NewContinueAsNewError(ctx, RebalanceGraphWorkflow, RebalanceInput{
+			TargetEventID:  input.TargetEventID,
+			DebounceWindow: input.DebounceWindow,
+		})
+	}
+
+	// 0. Debounce: așteptăm până nu mai vin semnale timp de o fereastră întreagă.
+	if input.DebounceWindow > 0 && !waitForQuietPeriod(ctx, signalChan, input.DebounceWindow) {
+		logger.Info("Rafală lungă de cereri: continuăm cu un istoric nou.")
+		return continueWithFreshHistory()
+	}
+	if err := rebalanceEvent(ctx, input); err != nil {
+		return err
+	}
+
+	// 4. Cereri primite în timpul analizei: evenimentul s-a schimbat, deci reluăm.
+	if drainSignals(signalChan) {
+		logger.Info("Evenimentul s-a schimbat în timpul analizei: reluăm rebalansarea.")
+		return continueWithFreshHistory()
+	}
+	return nil
+}
+
+// [RO] Pașii Rebalansării (candidați, analiză AI, muchie)
+func rebalanceEvent(ctx workflow.Context, input RebalanceInput) error {
+	logger := workflow.GetLogger(ctx)
 	var tools *NewsProcessingActivities
 
-	// 1. Determină Cauzalitatea folosind AI
+	// 1. Selectăm candidații dacă nu au fost furnizați de apelant.
+	if len(input.CandidateEvents) == 0 {
+		if err := workflow.ExecuteActivity(ctx, tools.LoadRebalanceCandidatesActivity, input.TargetEventID).Get(ctx, &input.CandidateEvents); err != nil {
+			logger.Error("Eșec selectare candidați", "Error", err)
+			return err
+		}
+	}
+	if len(input.CandidateEvents) == 0 {
+		logger.Info("Niciun eveniment candidat în cele 7 zile dinaintea țintei.")
+		return nil
+	}
+
+	// 2. Determină Cauzalitatea folosind AI
 	var causalityResult gemini.CausalityAnalysisResult
 	if err := workflow.ExecuteActivity(ctx, tools.CalculateCausalityActivity, input).Get(ctx, &causalityResult); err != nil {
 		logger.Error("Eșec analiză cauzalitate", "Error", err)
 		return err
 	}
 
-	// 2. Dacă există o legătură, actualizează graful
+	// 3. Dacă există o legătură, actualizează graful
 	if causalityResult.IsConsequence {
 		logger.Info("Legătură cauzală găsită", "Parent", causalityResult.ParentEventID, "Type", causalityResult.RelationshipType)
 
@@ -78,3 +192,32 @@ func RebalanceGraphWorkflow(ctx workflow.Context, input RebalanceInput) error {
 
 	return nil
 }
+
+// [RO] Așteptare Liniște (Debounce)
+// Fiecare semnal RebalanceRequestSignal primit resetează cronometrul; unul cu RunNow
+// (analiză prioritară) oprește așteptarea. Returnează false dacă rafala a depășit
+// rebalanceMaxDebounceSignals: apelantul continuă atunci cu un istoric nou.
+func waitForQuietPeriod(ctx workflow.Context, signalChan workflow.ReceiveChannel, window time.Duration) bool {
+	for received := 0; received < rebalanceMaxDebounceSignals; received++ {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		timer := workflow.NewTimer(timerCtx, window)
+
+		quiet := false
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(timer, func(f workflow.Future) {
+			quiet = true
+		})
+		selector.AddReceive(signalChan, func(c workflow.ReceiveChannel, more bool) {
+			var request RebalanceRequest
+			c.Receive(ctx, &request)
+			cancelTimer()
+			quiet = request.RunNow
+		})
+		selector.Select(ctx)
+
+		if quiet {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package temporal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/causality"
+)
+
+const (
+	// [RO] Mărimea unui lot procesat de migrare într-o singură activitate.
+	graphLinkMigrationBatchSize = 100
+
+	// [RO] După atâtea loturi, migrarea continuă ca execuție nouă (istoric mic)
+	graphLinkMigrationBatchesPerRun = 200
+)
+
+// [RO] Poziția Migrării (transmisă prin ContinueAsNew): pasul curent și totalul lui
+type GraphLinkMigrationProgress struct {
+	Step      int
+	Processed int
+}
+
+// [RO] Activitate: Rezolvare Grup Poveste (Story Cluster)
+// Returnează grupul celui mai apropiat articol deja grupat, sau "" dacă povestea este nouă.
+func (activities *NewsProcessingActivities) ResolveStoryClusterActivity(ctx context.Context, embedding []float32) (string, error) {
+	nearest, err := activities.Database.FindNearestClusteredArticle(ctx, embedding, activities.storyClusterThreshold())
+	if err != nil {
+		return "", err
+	}
+	if nearest == nil {
+		return "", nil
+	}
+	return nearest.StoryClusterID.String(), nil
+}
+
+// [RO] Activitate: Legare Articol -> Eveniment
+// Creează (sau actualizează) nodul Event al poveștii și trage muchia event.reported_by către articol.
+// Returnează ID-ul evenimentului.
+func (activities *NewsProcessingActivities) LinkStoryEventActivity(ctx context.Context, newsArticle article.NewsArticleEntity) (string, error) {
+	storyCluster := newsArticle.StoryClusterID.String()
+	eventID := string(causality.StoryEventID(storyCluster))
+
+	if err := activities.KnowledgeGraph.UpsertCausalEvent(ctx, eventID, storyCluster, newsArticle.PublishedAt, newsArticle.Summary, newsArticle.TruthScore); err != nil {
+		return "", err
+	}
+	if err := activities.KnowledgeGraph.LinkEventToArticle(ctx, eventID, newsArticle.OriginalURL); err != nil {
+		return "", err
+	}
+	return eventID, nil
+}
+
+// [RO] Activitate Migrare: Grupare Articole Vechi
+// Atribuie un grup articolelor care au fost salvate înainte de introducerea grupurilor și
+// le leagă de evenimentul poveștii. Returnează numărul de articole procesate (0 = gata).
+func (activities *NewsProcessingActivities) BackfillStoryClustersActivity(ctx context.Context) (int, error) {
+	articles, err := activities.Database.RetrieveUnclusteredArticles(ctx, graphLinkMigrationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, art := range articles {
+		nearest, err := activities.Database.FindNearestClusteredArticle(ctx, art.Embedding, activities.storyClusterThreshold())
+		if err != nil {
+			return 0, err
+		}
+
+		// Povestea nouă își are propriul articol drept rădăcină a grupului.
+		art.StoryClusterID = art.ID
+		if nearest != nil {
+			art.StoryClusterID = nearest.StoryClusterID
+		}
+
+		if err := activities.Database.AssignStoryCluster(ctx, art.ID, art.StoryClusterID); err != nil {
+			return 0, err
+		}
+		if _, err := activities.LinkStoryEventActivity(ctx, *art); err != nil {
+			return 0, err
+		}
+	}
+	return len(articles), nil
+}
+
+// [RO] Activitate Migrare: Legare Evenimente Vechi
+// Evenimentele create cu uuid.New() nu au sursă. Le căutăm articolul cel mai apropiat semantic
+// de rezumat și le contopim în evenimentul poveștii lui (muchiile trec pe acesta, nodul vechi
+// dispare), apoi tragem event.reported_by; cele fără potrivire sunt marcate "orphan".
+// Evenimentele poveștilor (ID derivat din grup) nu sunt niciodată contopite: contopirea unui nod
+// în el însuși i-ar șterge predicatele. Returnează numărul de evenimente procesate (0 = gata).
+func (activities *NewsProcessingActivities) LinkLegacyEventsActivity(ctx context.Context) (int, error) {
+	events, err := activities.KnowledgeGraph.RetrieveUnlinkedCausalEvents(ctx, graphLinkMigrationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, ev := range events {
+		eventID := string(ev.ID)
+		if !causality.IsLegacyEventID(eventID) {
+			continue
+		}
+
+		var nearest *article.NewsArticleEntity
+		if ev.Summary != "" {
+			embedding, err := activities.ArtificialIntelligence.GenerateSemanticVector(ctx, ev.Summary)
+			if err != nil {
+				return 0, err
+			}
+			nearest, err = activities.Database.FindNearestClusteredArticle(ctx, embedding, activities.storyClusterThreshold())
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if nearest == nil {
+			if err := activities.KnowledgeGraph.SetCausalEventStoryCluster(ctx, eventID, "orphan"); err != nil {
+				return 0, err
+			}
+			processed++
+			continue
+		}
+
+		storyCluster := nearest.StoryClusterID.String()
+		storyEventID := string(causality.StoryEventID(storyCluster))
+		if eventID == storyEventID {
+			continue
+		}
+		if err := activities.KnowledgeGraph.MergeCausalEventNodes(ctx, eventID, storyEventID, storyCluster); err != nil {
+			return 0, err
+		}
+		if err := activities.KnowledgeGraph.LinkEventToArticle(ctx, storyEventID, nearest.OriginalURL); err != nil {
+			return 0, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// [RO] Pragul de Grupare (cu fallback dacă nu e setat)
+func (activities *NewsProcessingActivities) storyClusterThreshold() float64 {
+	if activities.StoryClusterThreshold == 0 {
+		return 0.80
+	}
+	return activities.StoryClusterThreshold
+}
+
+// [RO] Workflow: Migrare Legături Articol <-> Eveniment
+// Job unic (pornit din panoul Admin) care unifică graful existent:
+// 1. Grupează articolele vechi și le creează evenimentele poveștii.
+// 2. Contopește evenimentele vechi (ID aleator) în evenimentul poveștii articolului sursă cel mai probabil.
+// La fiecare graphLinkMigrationBatchesPerRun loturi continuă ca execuție nouă, cu pasul în argument.
+func LinkGraphNodesMigrationWorkflow(ctx workflow.Context, progress GraphLinkMigrationProgress) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+	logger := workflow.GetLogger(ctx)
+
+	var tools *NewsProcessingActivities
+
+	steps := []interface{}{tools.BackfillStoryClustersActivity, tools.LinkLegacyEventsActivity}
+	for batches := 0; progress.Step < len(steps); batches++ {
+		if batches == graphLinkMigrationBatchesPerRun {
+			return workflow.NewContinueAsNewError(ctx, LinkGraphNodesMigrationWorkflow, progress)
+		}
+
+		var processed int
+		if err := workflow.ExecuteActivity(ctx, steps[progress.Step]).Get(ctx, &processed); err != nil {
+			return err
+		}
+		if processed == 0 {
+			logger.Info("Pas migrare finalizat", "step", progress.Step, "processed", progress.Processed)
+			progress = GraphLinkMigrationProgress{Step: progress.Step + 1}
+			continue
+		}
+		progress.Processed += processed
+	}
+
+	return nil
+}
+
+// [RO] ID-ul Grupului pentru un articol nou
+// Dacă nu există un grup apropiat, articolul devine rădăcina propriului grup.
+func resolveStoryClusterID(existingCluster string, articleID uuid.UUID) uuid.UUID {
+	if clusterID, err := uuid.Parse(existingCluster); err == nil {
+		return clusterID
+	}
+	return articleID
+}
@@ -0,0 +1,131 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/perspective"
+)
+
+const (
+	// [RO] Prefixul ID-ului de workflow: o singură generare pe poveste în același timp
+	PerspectiveRefreshWorkflowIDPrefix = "perspectives-"
+
+	// [RO] Semnalul "corpus schimbat": un articol nou a intrat în poveste în timpul generării
+	PerspectiveCorpusChangedSignal = "PerspectiveCorpusChanged"
+
+	// [RO] După atâtea treceri consecutive, workflow-ul continuă ca execuție nouă (istoric mic)
+	perspectiveRefreshMaxPasses = 10
+)
+
+// [RO] Intrarea Generării: corpusul poveștii și revizia care urmează
+type PerspectiveCorpus struct {
+	Corpus       []perspective.SourceArticle
+	NextRevision int
+	NeedsRefresh bool
+}
+
+// [RO] Activitate: Programare Regenerare Perspective
+// Chemată după salvarea fiecărui articol; dacă generarea pentru poveste rulează deja, nu pornește
+// alta, ci o semnalizează ca să reîncarce corpusul înainte să se încheie.
+func (activities *NewsProcessingActivities) SchedulePerspectiveRefreshActivity(ctx context.Context, storyClusterID uuid.UUID) error {
+	return activities.Orchestrator.SchedulePerspectiveRefresh(ctx, storyClusterID)
+}
+
+// [RO] Activitate: Corpusul Poveștii
+// Cele mai noi articole ale grupului și decizia dacă merită o revizie nouă (articolele s-au schimbat).
+func (activities *NewsProcessingActivities) LoadPerspectiveCorpusActivity(ctx context.Context, storyClusterID uuid.UUID) (*PerspectiveCorpus, error) {
+	corpus, err := activities.Perspectives.RetrievePerspectiveCorpus(ctx, storyClusterID, perspective.MaxCorpusArticles)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := activities.Perspectives.RetrievePerspectiveSet(ctx, storyClusterID, 0)
+	if err != nil && !errors.Is(err, perspective.ErrPerspectivesNotFound) {
+		return nil, err
+	}
+
+	result := &PerspectiveCorpus{Corpus: corpus, NextRevision: 1, NeedsRefresh: perspective.NeedsRefresh(latest, corpus)}
+	if latest != nil {
+		result.NextRevision = latest.Revision + 1
+	}
+	return result, nil
+}
+
+// [RO] Activitate: Generarea Perspectivelor (Gemini)
+func (activities *NewsProcessingActivities) GenerateStoryPerspectivesActivity(ctx context.Context, storyClusterID uuid.UUID, input PerspectiveCorpus) (*perspective.PerspectiveSet, error) {
+	drafts, err := activities.ArtificialIntelligence.GenerateStoryPerspectives(ctx, input.Corpus)
+	if err != nil {
+		return nil, err
+	}
+	set, err := perspective.BuildPerspectiveSet(storyClusterID, input.NextRevision, input.Corpus, drafts, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
+
+// [RO] Activitate: Salvarea Reviziei
+func (activities *NewsProcessingActivities) SavePerspectiveSetActivity(ctx context.Context, set perspective.PerspectiveSet) error {
+	return activities.Perspectives.SavePerspectiveSet(ctx, set)
+}
+
+// [RO] Workflow: Perspectivele unei Povești
+// Regenerează punctele de vedere doar când grupul are cel puțin două articole și setul lor s-a
+// schimbat de la ultima revizie. O generare eșuată lasă revizia anterioară neatinsă.
+// Pornit prin SignalWithStart: dacă în timpul unei treceri sosește PerspectiveCorpusChanged,
+// corpusul este reîncărcat înainte de încheiere, deci articolele noi nu sunt pierdute.
+func StoryPerspectiveWorkflow(ctx workflow.Context, storyClusterID uuid.UUID) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 2,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+	logger := workflow.GetLogger(ctx)
+	corpusChanged := workflow.GetSignalChannel(ctx, PerspectiveCorpusChangedSignal)
+
+	var tools *NewsProcessingActivities
+
+	for pass := 1; ; pass++ {
+		// Semnalele de până acum sunt acoperite de încărcarea care urmează.
+		drainSignals(corpusChanged)
+
+		var input PerspectiveCorpus
+		if err := workflow.ExecuteActivity(ctx, tools.LoadPerspectiveCorpusActivity, storyClusterID).Get(ctx, &input); err != nil {
+			return err
+		}
+		if input.NeedsRefresh {
+			var set perspective.PerspectiveSet
+			if err := workflow.ExecuteActivity(ctx, tools.GenerateStoryPerspectivesActivity, storyClusterID, input).Get(ctx, &set); err != nil {
+				return err
+			}
+			if err := workflow.ExecuteActivity(ctx, tools.SavePerspectiveSetActivity, set).Get(ctx, nil); err != nil {
+				return err
+			}
+			logger.Info("Perspectivele poveștii au fost regenerate", "story_cluster_id", storyClusterID, "revision", set.Revision, "perspectives", len(set.Perspectives))
+		}
+
+		if !drainSignals(corpusChanged) {
+			return nil
+		}
+		if pass >= perspectiveRefreshMaxPasses {
+			return workflow.NewContinueAsNewError(ctx, StoryPerspectiveWorkflow, storyClusterID)
+		}
+	}
+}
+
+// [RO] Golește semnalele primite; true dacă a existat măcar unul
+func drainSignals(channel workflow.ReceiveChannel) bool {
+	received := false
+	for channel.ReceiveAsync(nil) {
+		received = true
+	}
+	return received
+}
@@ -2,10 +2,18 @@ package temporal
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"go.temporal.io/sdk/client"
+
+	"github.com/yourorg/truthweave/internal/domain/review"
 )
 
+// [RO] Coada de Sarcini
+// Canalul pe care ascultă Worker-ul și pe care pornim toate workflow-urile.
+const TaskQueueName = "truthweave-task-queue"
+
 // [RO] Client Orchestrator Temporal
 //
 // Această componentă este "Telecomanda" prin care pornim procesele complexe în clusterul Temporal.
@@ -24,3 +32,37 @@ func NewTemporalOrchestratorClient(c client.Client) *TemporalOrchestratorClient
 func (t *TemporalOrchestratorClient) ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (client.WorkflowRun, error) {
 	return t.client.ExecuteWorkflow(ctx, options, workflow, args...)
 }
+
+// [RO] Programează Rebalansarea Grafului
+// Folosește SignalWithStart pe ID-ul "rebalance-<eventID>": dacă workflow-ul există deja,
+// doar îl semnalizăm (resetăm debounce-ul); altfel îl pornim. Cu debounce 0, semnalul cere
+// rularea imediată și scurtează și așteptarea unui workflow deja pornit.
+func (t *TemporalOrchestratorClient) ScheduleGraphRebalance(ctx context.Context, eventID string, debounce time.Duration) error {
+	options := client.StartWorkflowOptions{
+		ID:        RebalanceWorkflowIDPrefix + eventID,
+		TaskQueue: TaskQueueName,
+	}
+	input := RebalanceInput{TargetEventID: eventID, DebounceWindow: debounce}
+	request := RebalanceRequest{RunNow: debounce <= 0}
+
+	_, err := t.client.SignalWithStartWorkflow(ctx, options.ID, RebalanceRequestSignal, request, options, RebalanceGraphWorkflow, input)
+	return err
+}
+
+// [RO] Programează Regenerarea Perspectivelor
+// SignalWithStart pe ID-ul "perspectives-<storyClusterID>": dacă generarea rulează deja, primește
+// semnalul PerspectiveCorpusChanged și reîncarcă corpusul înainte să se încheie; altfel pornește.
+func (t *TemporalOrchestratorClient) SchedulePerspectiveRefresh(ctx context.Context, storyClusterID uuid.UUID) error {
+	options := client.StartWorkflowOptions{
+		ID:        PerspectiveRefreshWorkflowIDPrefix + storyClusterID.String(),
+		TaskQueue: TaskQueueName,
+	}
+	_, err := t.client.SignalWithStartWorkflow(ctx, options.ID, PerspectiveCorpusChangedSignal, nil, options, StoryPerspectiveWorkflow, storyClusterID)
+	return err
+}
+
+// [RO] Trimite Decizia Editorului
+// Workflow-ul de analiză oprit la verificare o primește pe semnalul EditorialDecision.
+func (t *TemporalOrchestratorClient) SignalEditorialDecision(ctx context.Context, workflowID string, runID string, decision review.EditorialDecision) error {
+	return t.client.SignalWorkflow(ctx, workflowID, runID, review.EditorialDecisionSignal, decision)
+}
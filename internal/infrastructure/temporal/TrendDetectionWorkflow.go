@@ -0,0 +1,125 @@
+package temporal
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/yourorg/truthweave/internal/domain/causality"
+	"github.com/yourorg/truthweave/internal/domain/trend"
+)
+
+// [RO] Câte semnale recente citim pentru pauză și pentru termenii de trend
+const recentTrendSignalsLimit = 500
+
+// [RO] Activitate: Detectare Vârfuri
+// Citește volumele orare (entități și grupuri de poveste), aplică detectorul EWMA/z-score
+// și salvează semnalele noi. Seriile semnalate în ultimele ore sunt în pauză.
+func (activities *NewsProcessingActivities) DetectTrendsActivity(ctx context.Context, windowEnd time.Time) ([]trend.TrendSignal, error) {
+	recent, err := activities.Trends.RetrieveRecentTrendSignals(ctx, windowEnd.Add(-trend.SignalCooldown), recentTrendSignalsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []trend.VolumeSeries
+	for _, kind := range []string{trend.KindEntity, trend.KindStoryCluster} {
+		kindSeries, err := activities.Trends.RetrieveVolumeSeries(ctx, kind, windowEnd, trend.DefaultHistoryHours, trend.DefaultMinCount)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, kindSeries...)
+	}
+
+	signals := trend.NewSpikeDetector().Detect(series, recent, windowEnd)
+	if len(signals) == 0 {
+		return nil, nil
+	}
+	if err := activities.Trends.SaveTrendSignals(ctx, signals); err != nil {
+		return nil, err
+	}
+	return signals, nil
+}
+
+// [RO] Activitate: Publicare Semnale (NATS `trend.detected`)
+// Fără NATS configurat, semnalele rămân doar în Postgres.
+func (activities *NewsProcessingActivities) PublishTrendSignalsActivity(ctx context.Context, signals []trend.TrendSignal) error {
+	if activities.Events == nil {
+		return nil
+	}
+	for _, signal := range signals {
+		if err := activities.Events.PublishTrendDetected(ctx, signal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// [RO] Activitate: Analiză Cauzală Prioritară
+// Un grup de poveste în creștere bruscă își reevaluează cauzele imediat, fără debounce.
+func (activities *NewsProcessingActivities) PrioritizeCausalAnalysisActivity(ctx context.Context, storyCluster string) error {
+	return activities.Orchestrator.ScheduleGraphRebalance(ctx, string(causality.StoryEventID(storyCluster)), 0)
+}
+
+// [RO] Activitate: Termenii în Trend (pentru colectarea GDELT)
+// Numele entităților semnalate în ultimele ore; fără depozit de trenduri, lista e goală.
+func (activities *NewsProcessingActivities) LoadTrendingTermsActivity(ctx context.Context) ([]string, error) {
+	if activities.Trends == nil {
+		return nil, nil
+	}
+	signals, err := activities.Trends.RetrieveRecentTrendSignals(ctx, time.Now().Add(-trend.SignalCooldown), recentTrendSignalsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []string
+	for _, signal := range signals {
+		if signal.Kind == trend.KindEntity && signal.Label != "" {
+			terms = append(terms, signal.Label)
+		}
+	}
+	return terms, nil
+}
+
+// [RO] Workflow: Detectarea Trendurilor (Cron)
+// 1. Detectează vârfurile din ultima oră față de linia de bază a ultimei săptămâni.
+// 2. Publică semnalele pe NATS.
+// 3. Pentru grupurile de poveste în creștere, cere imediat analiza cauzală.
+// Colectarea globală (GlobalNewsIngestionWorkflow) citește semnalele separat, la fiecare rulare.
+func TrendDetectionWorkflow(ctx workflow.Context) error {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 5,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+	logger := workflow.GetLogger(ctx)
+
+	var tools *NewsProcessingActivities
+
+	var signals []trend.TrendSignal
+	if err := workflow.ExecuteActivity(ctx, tools.DetectTrendsActivity, workflow.Now(ctx)).Get(ctx, &signals); err != nil {
+		return err
+	}
+	if len(signals) == 0 {
+		return nil
+	}
+	logger.Info("Trenduri detectate", "count", len(signals))
+
+	if err := workflow.ExecuteActivity(ctx, tools.PublishTrendSignalsActivity, signals).Get(ctx, nil); err != nil {
+		logger.Warn("Publicarea semnalelor pe NATS a eșuat", "Error", err)
+	}
+
+	for _, signal := range signals {
+		if signal.Kind != trend.KindStoryCluster {
+			continue
+		}
+		if err := workflow.ExecuteActivity(ctx, tools.PrioritizeCausalAnalysisActivity, signal.Key).Get(ctx, nil); err != nil {
+			logger.Warn("Analiza cauzală prioritară nu a putut fi programată", "story_cluster", signal.Key, "Error", err)
+		}
+	}
+	return nil
+}
@@ -1,13 +1,24 @@
 package temporal
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/community"
+	"github.com/yourorg/truthweave/internal/domain/dispute"
+	"github.com/yourorg/truthweave/internal/domain/perspective"
+	"github.com/yourorg/truthweave/internal/domain/review"
+	"github.com/yourorg/truthweave/internal/domain/trend"
+	"github.com/yourorg/truthweave/internal/infrastructure/gemini"
+	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
 )
 
 // [RO] Suita de Teste Temporal
@@ -35,24 +46,42 @@ func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_Success() {
 	// Aici VREM sa facem Mock la activități ca să testăm doar logica de workflow (orchestrarea).
 
 	s.env.OnActivity(activities.ExtractWebPageContentActivity, mock.Anything, "http://test.com").Return("Raw Content", nil)
+	s.env.OnActivity(activities.CheckLexicalFingerprintActivity, mock.Anything, "Raw Content").Return((*article.FingerprintMatch)(nil), nil)
 	s.env.OnActivity(activities.GenerateSemanticVectorActivity, mock.Anything, "Raw Content").Return([]float32{0.1, 0.2}, nil)
 
 	// Simulăm că NU e duplicat (Score mic)
 	simResult := &SimilarityCheckResult{ExistingArticle: nil, SimilarityScore: 0.1}
 	s.env.OnActivity(activities.CheckForExistingDuplicatesActivity, mock.Anything, []float32{0.1, 0.2}).Return(simResult, nil)
+	s.env.OnActivity(activities.ResolveStoryClusterActivity, mock.Anything, []float32{0.1, 0.2}).Return("", nil)
 
 	// Analiza AI
 	aiResult := &article.AIAnalysisResult{
 		RewrittenText: "Neutral Text",
 		Score:         85.5,
 		Location:      article.GaiaPoint{Latitude: 10, Longitude: 20},
+		Sector:        "Business",
 	}
 	s.env.OnActivity(activities.AnalyzeNewsContentActivity, mock.Anything, "Raw Content").Return(aiResult, nil)
+	s.env.OnActivity(activities.ResolveEntitiesActivity, mock.Anything, mock.Anything).Return([]article.NamedEntity{}, nil)
+	placement := &article.GaiaPlacement{Point: article.GaiaPoint{Latitude: 10, Longitude: 20}, CountryCode: "TD"}
+	s.env.OnActivity(activities.ResolveGeolocationActivity, mock.Anything, mock.Anything, mock.Anything).Return(placement, nil)
 
-	// Salvare DB și Graph
-	s.env.OnActivity(activities.PersistAnalysisToDatabaseActivity, mock.Anything, mock.Anything).Return(nil)
+	// Salvare DB și Graph (țara din geocodare și sectorul normalizat ajung pe articol);
+	// eșecul indexării pe pasaje nu oprește workflow-ul.
+	located := mock.MatchedBy(func(a article.NewsArticleEntity) bool {
+		return a.CountryCode == "TD" && a.Geolocation.Longitude == 20 && a.Sector == article.SectorEconomy &&
+			len(a.ReviewFlags) == 0
+	})
+	s.env.OnActivity(activities.AssessEditorialReviewActivity, mock.Anything, located).Return(&review.Assessment{}, nil)
+	s.env.OnActivity(activities.PersistAnalysisToDatabaseActivity, mock.Anything, located).Return(&PersistedArticle{ID: uuid.New(), StoryClusterID: uuid.New()}, nil)
+	s.env.OnActivity(activities.IndexArticleChunksActivity, mock.Anything, mock.Anything).Return(errors.New("embedding quota"))
 	s.env.OnActivity(activities.ConnectKnowledgeGraphActivity, mock.Anything, mock.Anything).Return(nil)
 
+	// Legătura Articol -> Eveniment și programarea rebalansării
+	s.env.OnActivity(activities.LinkStoryEventActivity, mock.Anything, mock.Anything).Return("evt-1", nil)
+	s.env.OnActivity(activities.ScheduleGraphRebalanceActivity, mock.Anything, "evt-1").Return(nil)
+	s.env.OnActivity(activities.SchedulePerspectiveRefreshActivity, mock.Anything, mock.Anything).Return(nil)
+
 	// 2. Execuție Workflow
 	s.env.ExecuteWorkflow(OrchestrateNewsAnalysisWorkflow, "http://test.com")
 
@@ -61,18 +90,143 @@ func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_Success() {
 	s.NoError(s.env.GetWorkflowError())
 }
 
+// [RO] Test: Articolul se alătură unei povești existente
+// Grupul celui mai apropiat articol devine grupul articolului nou (deci același Eveniment în graf).
+func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_JoinsExistingStoryCluster() {
+	activities := &NewsProcessingActivities{}
+	existingCluster := uuid.New()
+
+	s.env.OnActivity(activities.ExtractWebPageContentActivity, mock.Anything, "http://follow-up.com").Return("Follow-up Content", nil)
+	s.env.OnActivity(activities.CheckLexicalFingerprintActivity, mock.Anything, "Follow-up Content").Return((*article.FingerprintMatch)(nil), nil)
+	s.env.OnActivity(activities.GenerateSemanticVectorActivity, mock.Anything, "Follow-up Content").Return([]float32{0.3, 0.4}, nil)
+	s.env.OnActivity(activities.CheckForExistingDuplicatesActivity, mock.Anything, []float32{0.3, 0.4}).Return(&SimilarityCheckResult{SimilarityScore: 0.85}, nil)
+	s.env.OnActivity(activities.ResolveStoryClusterActivity, mock.Anything, []float32{0.3, 0.4}).Return(existingCluster.String(), nil)
+	// [RO] Analiza marcată pentru verificare ajunge pe articol, cu motivele ei
+	flagged := &article.AIAnalysisResult{RewrittenText: "Neutral", ReviewFlags: []string{article.ReviewReasonModelFlagged}}
+	s.env.OnActivity(activities.AnalyzeNewsContentActivity, mock.Anything, "Follow-up Content").Return(flagged, nil)
+	s.env.OnActivity(activities.ResolveEntitiesActivity, mock.Anything, mock.Anything).Return([]article.NamedEntity{}, nil)
+	s.env.OnActivity(activities.ResolveGeolocationActivity, mock.Anything, mock.Anything, mock.Anything).Return(&article.GaiaPlacement{}, nil)
+
+	// [RO] URL re-analizat: rândul existent își păstrează ID-ul, iar pașii următori îl folosesc
+	storedID := uuid.New()
+	inCluster := mock.MatchedBy(func(a article.NewsArticleEntity) bool { return a.StoryClusterID == existingCluster && a.ID == storedID })
+	flaggedInCluster := mock.MatchedBy(func(a article.NewsArticleEntity) bool {
+		return a.StoryClusterID == existingCluster && len(a.ReviewFlags) == 1 && a.ReviewFlags[0] == article.ReviewReasonModelFlagged
+	})
+	s.env.OnActivity(activities.AssessEditorialReviewActivity, mock.Anything, flaggedInCluster).Return(&review.Assessment{}, nil)
+	s.env.OnActivity(activities.PersistAnalysisToDatabaseActivity, mock.Anything, flaggedInCluster).Return(&PersistedArticle{ID: storedID, StoryClusterID: existingCluster}, nil)
+	s.env.OnActivity(activities.IndexArticleChunksActivity, mock.Anything, inCluster).Return(nil)
+	s.env.OnActivity(activities.ConnectKnowledgeGraphActivity, mock.Anything, inCluster).Return(nil)
+	s.env.OnActivity(activities.LinkStoryEventActivity, mock.Anything, inCluster).Return("evt-story", nil)
+	s.env.OnActivity(activities.ScheduleGraphRebalanceActivity, mock.Anything, "evt-story").Return(nil)
+	s.env.OnActivity(activities.SchedulePerspectiveRefreshActivity, mock.Anything, mock.Anything).Return(nil)
+
+	s.env.ExecuteWorkflow(OrchestrateNewsAnalysisWorkflow, "http://follow-up.com")
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Pașii comuni până la evaluarea editorială (articol nou, fără grup existent)
+func (s *WorkflowTestSuite) mockAnalysisUntilEditorialReview(activities *NewsProcessingActivities, url string, analysis *article.AIAnalysisResult) {
+	s.env.OnActivity(activities.ExtractWebPageContentActivity, mock.Anything, url).Return("Contested Content", nil)
+	s.env.OnActivity(activities.CheckLexicalFingerprintActivity, mock.Anything, "Contested Content").Return((*article.FingerprintMatch)(nil), nil)
+	s.env.OnActivity(activities.GenerateSemanticVectorActivity, mock.Anything, "Contested Content").Return([]float32{0.5, 0.5}, nil)
+	s.env.OnActivity(activities.CheckForExistingDuplicatesActivity, mock.Anything, []float32{0.5, 0.5}).Return(&SimilarityCheckResult{}, nil)
+	s.env.OnActivity(activities.ResolveStoryClusterActivity, mock.Anything, []float32{0.5, 0.5}).Return("", nil)
+	s.env.OnActivity(activities.AnalyzeNewsContentActivity, mock.Anything, "Contested Content").Return(analysis, nil)
+	s.env.OnActivity(activities.ResolveEntitiesActivity, mock.Anything, mock.Anything).Return([]article.NamedEntity{}, nil)
+	s.env.OnActivity(activities.ResolveGeolocationActivity, mock.Anything, mock.Anything, mock.Anything).Return(&article.GaiaPlacement{}, nil)
+}
+
+// [RO] Test: Analiza controversată așteaptă editorul, care o corectează înainte de publicare
+func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_EditorialReviewEditsBeforePublishing() {
+	activities := &NewsProcessingActivities{}
+	s.mockAnalysisUntilEditorialReview(activities, "http://contested.com", &article.AIAnalysisResult{RewrittenText: "Neutral", Score: 0.52})
+
+	assessment := &review.Assessment{Reasons: []string{review.ReasonUncertainTruth}, Timeout: 48 * time.Hour, TimeoutAction: review.TimeoutPublish}
+	s.env.OnActivity(activities.AssessEditorialReviewActivity, mock.Anything, mock.Anything).Return(assessment, nil)
+	var opened review.EditorialReview
+	s.env.OnActivity(activities.OpenEditorialReviewActivity, mock.Anything, mock.MatchedBy(func(item review.EditorialReview) bool {
+		opened = item
+		return item.Status == review.StatusPending && item.Draft.TruthScore == 0.52 &&
+			item.Deadline.Sub(item.CreatedAt) == 48*time.Hour && item.WorkflowID != ""
+	})).Return(nil)
+
+	// [RO] Primul semnal e invalid (fără editor) și e ignorat; al doilea corectează scorul
+	corrected := 0.3
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(review.EditorialDecisionSignal, review.EditorialDecision{Action: review.DecisionApprove})
+	}, time.Hour)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(review.EditorialDecisionSignal, review.EditorialDecision{
+			Action: review.DecisionEdit, Reviewer: "editor@truthweave", Edits: &review.EditorialEdits{TruthScore: &corrected},
+		})
+	}, 2*time.Hour)
+
+	s.env.OnActivity(activities.CloseEditorialReviewActivity, mock.Anything, mock.MatchedBy(func(resolution review.EditorialResolution) bool {
+		// [RO] Închiderea țintește execuția care a deschis verificarea
+		return resolution.Status == review.StatusEdited && resolution.Decision != nil && resolution.Decision.Reviewer == "editor@truthweave" &&
+			resolution.WorkflowID == opened.WorkflowID && resolution.RunID == opened.RunID && resolution.ArticleID == opened.ArticleID
+	})).Return(nil)
+
+	// [RO] Se publică exact ciorna aprobată (același ID)
+	edited := mock.MatchedBy(func(a article.NewsArticleEntity) bool { return a.TruthScore == 0.3 && a.ID == opened.ArticleID })
+	s.env.OnActivity(activities.PersistAnalysisToDatabaseActivity, mock.Anything, edited).Return(func(ctx context.Context, a article.NewsArticleEntity) (*PersistedArticle, error) {
+		return &PersistedArticle{ID: a.ID, StoryClusterID: a.StoryClusterID}, nil
+	})
+	s.env.OnActivity(activities.IndexArticleChunksActivity, mock.Anything, edited).Return(nil)
+	s.env.OnActivity(activities.ConnectKnowledgeGraphActivity, mock.Anything, edited).Return(nil)
+	s.env.OnActivity(activities.LinkStoryEventActivity, mock.Anything, edited).Return("evt-contested", nil)
+	s.env.OnActivity(activities.ScheduleGraphRebalanceActivity, mock.Anything, "evt-contested").Return(nil)
+	s.env.OnActivity(activities.SchedulePerspectiveRefreshActivity, mock.Anything, mock.Anything).Return(nil)
+
+	s.env.ExecuteWorkflow(OrchestrateNewsAnalysisWorkflow, "http://contested.com")
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Nimeni nu decide la timp, iar textul suspect de injecție nu se publică
+func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_EditorialReviewTimeoutRejectsFlaggedArticle() {
+	activities := &NewsProcessingActivities{}
+	s.mockAnalysisUntilEditorialReview(activities, "http://injected.com", &article.AIAnalysisResult{
+		RewrittenText: "Neutral", Score: 1, ReviewFlags: []string{article.ReviewReasonInjectionPattern},
+	})
+
+	assessment := &review.Assessment{Reasons: []string{article.ReviewReasonInjectionPattern}, Timeout: time.Hour, TimeoutAction: review.TimeoutPublish}
+	s.env.OnActivity(activities.AssessEditorialReviewActivity, mock.Anything, mock.Anything).Return(assessment, nil)
+	s.env.OnActivity(activities.OpenEditorialReviewActivity, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(activities.CloseEditorialReviewActivity, mock.Anything, mock.MatchedBy(func(resolution review.EditorialResolution) bool {
+		return resolution.Status == review.StatusTimeoutRejected && resolution.Decision == nil
+	})).Return(nil)
+
+	// Așteptare: nimic nu e salvat sau publicat
+	s.env.ExecuteWorkflow(OrchestrateNewsAnalysisWorkflow, "http://injected.com")
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 // [RO] Test: Scenariul Duplicat (Deduplicare)
 func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_DuplicateDetected() {
 	activities := &NewsProcessingActivities{}
 
 	s.env.OnActivity(activities.ExtractWebPageContentActivity, mock.Anything, "http://duplicate.com").Return("Duplicate Content", nil)
+	s.env.OnActivity(activities.CheckLexicalFingerprintActivity, mock.Anything, "Duplicate Content").Return((*article.FingerprintMatch)(nil), nil)
 	s.env.OnActivity(activities.GenerateSemanticVectorActivity, mock.Anything, "Duplicate Content").Return([]float32{0.9, 0.9}, nil)
 
-	// Simulăm că ESTE duplicat (Score mare > 0.90)
+	// Simulăm că ESTE duplicat (Score mare > pragul configurat)
 	existing := &article.NewsArticleEntity{ID: uuid.New()}
-	simResult := &SimilarityCheckResult{ExistingArticle: existing, SimilarityScore: 0.98}
+	simResult := &SimilarityCheckResult{ExistingArticle: existing, SimilarityScore: 0.98, IsDuplicate: true}
 	s.env.OnActivity(activities.CheckForExistingDuplicatesActivity, mock.Anything, []float32{0.9, 0.9}).Return(simResult, nil)
 
+	// Copia este legată de original, nu ignorată.
+	s.env.OnActivity(activities.RecordDuplicateArticleActivity, mock.Anything, mock.MatchedBy(func(link article.DuplicateLink) bool {
+		return link.OriginalURL == "http://duplicate.com" && link.DuplicateOf == existing.ID &&
+			link.Similarity == 0.98 && link.Method == article.DuplicateMethodSemantic
+	})).Return(nil)
+
 	// Așteptare: Workflow-ul se oprește AICI. NU apelează Analyze, nici Save.
 	// Dacă ar apela, testul ar crăpa cu "Unexpected call".
 
@@ -83,6 +237,354 @@ func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_DuplicateDetecte
 	// Implicit: AssertExpectations verifică că nu s-au apelat alte activități.
 }
 
+// [RO] Test: Copie Lexicală (agenție de presă republicată)
+// Copia e legată de original înainte de vector: nici embedding, nici analiză AI.
+func (s *WorkflowTestSuite) TestOrchestrateNewsAnalysisWorkflow_LexicalCopySkipsEmbeddingAndAI() {
+	activities := &NewsProcessingActivities{}
+	canonicalID := uuid.New()
+
+	s.env.OnActivity(activities.ExtractWebPageContentActivity, mock.Anything, "http://syndicated.com").Return("Wire Content", nil)
+	s.env.OnActivity(activities.CheckLexicalFingerprintActivity, mock.Anything, "Wire Content").Return(&article.FingerprintMatch{
+		ArticleID: canonicalID, Method: article.DuplicateMethodSimHash, Distance: 2, Similarity: 1 - 2.0/64,
+	}, nil)
+	s.env.OnActivity(activities.RecordDuplicateArticleActivity, mock.Anything, mock.MatchedBy(func(link article.DuplicateLink) bool {
+		return link.DuplicateOf == canonicalID && link.Method == article.DuplicateMethodSimHash
+	})).Return(nil)
+
+	s.env.ExecuteWorkflow(OrchestrateNewsAnalysisWorkflow, "http://syndicated.com")
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Rebalansare cu Debounce
+// O rafală de semnale pentru același eveniment trebuie să producă o singură analiză AI.
+func (s *WorkflowTestSuite) TestRebalanceGraphWorkflow_DebouncesBurst() {
+	activities := &NewsProcessingActivities{}
+
+	candidates := []gemini.PotentialCause{{ID: "evt-parent", Title: "Parent", Summary: "Parent summary"}}
+	s.env.OnActivity(activities.LoadRebalanceCandidatesActivity, mock.Anything, "evt-child").Return(candidates, nil).Once()
+
+	causality := &gemini.CausalityAnalysisResult{IsConsequence: true, ParentEventID: "evt-parent", RelationshipType: "DIRECT_RESPONSE"}
+	s.env.OnActivity(activities.CalculateCausalityActivity, mock.Anything, mock.Anything).Return(causality, nil).Once()
+	s.env.OnActivity(activities.ApplyGraphMutationsActivity, mock.Anything, mock.Anything).Return(nil).Once()
+
+	for _, delay := range []time.Duration{30 * time.Second, 60 * time.Second, 90 * time.Second} {
+		s.env.RegisterDelayedCallback(func() {
+			s.env.SignalWorkflow(RebalanceRequestSignal, nil)
+		}, delay)
+	}
+
+	s.env.ExecuteWorkflow(RebalanceGraphWorkflow, RebalanceInput{TargetEventID: "evt-child", DebounceWindow: time.Minute})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Rebalansare Prioritară
+// Un semnal RunNow (debounce 0, ex: trend) oprește așteptarea unui workflow deja pornit.
+func (s *WorkflowTestSuite) TestRebalanceGraphWorkflow_RunNowSignalSkipsRemainingDebounce() {
+	activities := &NewsProcessingActivities{}
+	start := s.env.Now()
+
+	var loadedAt time.Time
+	s.env.OnActivity(activities.LoadRebalanceCandidatesActivity, mock.Anything, "evt-child").Return(
+		func(ctx context.Context, targetEventID string) ([]gemini.PotentialCause, error) {
+			loadedAt = s.env.Now()
+			return nil, nil
+		}).Once()
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(RebalanceRequestSignal, RebalanceRequest{RunNow: true})
+	}, 30*time.Second)
+
+	s.env.ExecuteWorkflow(RebalanceGraphWorkflow, RebalanceInput{TargetEventID: "evt-child", DebounceWindow: time.Hour})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Less(loadedAt.Sub(start), time.Minute)
+}
+
+// [RO] Test: Cerere Nouă în Timpul Analizei
+// Evenimentul se schimbă cât timp AI-ul rulează: cererea nu se pierde, workflow-ul continuă
+// ca execuție nouă (aceeași țintă, același debounce, candidați recitiți).
+func (s *WorkflowTestSuite) TestRebalanceGraphWorkflow_SignalDuringAnalysisContinuesAsNew() {
+	activities := &NewsProcessingActivities{}
+
+	candidates := []gemini.PotentialCause{{ID: "evt-parent", Title: "Parent", Summary: "Parent summary"}}
+	s.env.OnActivity(activities.LoadRebalanceCandidatesActivity, mock.Anything, "evt-child").Return(candidates, nil).Once()
+	s.env.OnActivity(activities.CalculateCausalityActivity, mock.Anything, mock.Anything).
+		After(time.Minute).Return(&gemini.CausalityAnalysisResult{}, nil).Once()
+
+	// [RO] Debounce-ul se termină la 1 minut; analiza rulează între 1 și 2 minute.
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(RebalanceRequestSignal, RebalanceRequest{})
+	}, 90*time.Second)
+
+	s.env.ExecuteWorkflow(RebalanceGraphWorkflow, RebalanceInput{TargetEventID: "evt-child", DebounceWindow: time.Minute})
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continued *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &continued)
+	var next RebalanceInput
+	s.Require().NoError(converter.GetDefaultDataConverter().FromPayloads(continued.Input, &next))
+	s.Equal(RebalanceInput{TargetEventID: "evt-child", DebounceWindow: time.Minute}, next)
+}
+
+// [RO] Test: Rafală Nesfârșită
+// Semnalele care tot resetează debounce-ul nu cresc la nesfârșit același istoric.
+func (s *WorkflowTestSuite) TestRebalanceGraphWorkflow_EndlessBurstContinuesAsNew() {
+	for i := 1; i <= rebalanceMaxDebounceSignals; i++ {
+		s.env.RegisterDelayedCallback(func() {
+			s.env.SignalWorkflow(RebalanceRequestSignal, nil)
+		}, time.Duration(i)*30*time.Second)
+	}
+
+	s.env.ExecuteWorkflow(RebalanceGraphWorkflow, RebalanceInput{TargetEventID: "evt-child", DebounceWindow: time.Minute})
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continued *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &continued)
+}
+
+// [RO] Test: Reconstrucția Agregatelor
+// 20 de zile + ziua curentă = 21 de zile, recalculate în felii de 7 zile;
+// o eroare Dgraph la narațiuni nu oprește workflow-ul.
+func (s *WorkflowTestSuite) TestAnalyticsRollupWorkflow_ChunksBackfillAndToleratesNarrativeFailure() {
+	activities := &NewsProcessingActivities{}
+	s.env.SetStartTime(time.Date(2026, 3, 21, 10, 30, 0, 0, time.UTC))
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 22, 0, 0, 0, 0, time.UTC)
+	for start := from; start.Before(to); start = start.Add(7 * 24 * time.Hour) {
+		s.env.OnActivity(activities.RefreshAnalyticsRollupsActivity, mock.Anything, start, start.Add(7*24*time.Hour)).Return(nil).Once()
+	}
+	s.env.OnActivity(activities.RefreshNarrativeRollupsActivity, mock.Anything, from, to).Return(errors.New("dgraph down"))
+
+	s.env.ExecuteWorkflow(AnalyticsRollupWorkflow, 20*24*time.Hour)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Detectarea Trendurilor
+// Semnalele se publică pe NATS; doar grupul de poveste primește analiza cauzală prioritară,
+// iar o eroare NATS nu oprește workflow-ul.
+func (s *WorkflowTestSuite) TestTrendDetectionWorkflow_PublishesAndPrioritizesStoryClusters() {
+	activities := &NewsProcessingActivities{}
+
+	signals := []trend.TrendSignal{
+		{Kind: trend.KindEntity, Key: "ent-nato", Label: "NATO", ZScore: 9},
+		{Kind: trend.KindStoryCluster, Key: "cluster-1", Label: "Border crisis", ZScore: 6},
+	}
+	s.env.OnActivity(activities.DetectTrendsActivity, mock.Anything, mock.Anything).Return(signals, nil).Once()
+	s.env.OnActivity(activities.PublishTrendSignalsActivity, mock.Anything, signals).Return(errors.New("nats down"))
+	s.env.OnActivity(activities.PrioritizeCausalAnalysisActivity, mock.Anything, "cluster-1").Return(nil).Once()
+
+	s.env.ExecuteWorkflow(TrendDetectionWorkflow)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Reanaliza Contestațiilor
+// Dosarul ajunge la model, iar nota rezultată (cu legătura retrasă) se publică.
+func (s *WorkflowTestSuite) TestDisputeReanalysisWorkflow_PublishesResolutionNote() {
+	activities := &NewsProcessingActivities{}
+	articleID := uuid.New()
+
+	disputeCase := &dispute.DisputeCase{
+		ArticleID:    articleID,
+		TruthScore:   0.8,
+		StoryEventID: "evt-child",
+		Disputes:     []dispute.Dispute{{ID: uuid.New(), Target: dispute.TargetCausalLink, CauseEventID: "evt-parent"}},
+	}
+	note := &dispute.ResolutionNote{ArticleID: articleID, Outcome: dispute.OutcomeCorrected, RetractedCauses: []string{"evt-parent"}, StoryEventID: "evt-child"}
+
+	s.env.OnActivity(activities.LoadDisputeCaseActivity, mock.Anything, articleID).Return(disputeCase, nil).Once()
+	s.env.OnActivity(activities.ReanalyzeDisputesActivity, mock.Anything, *disputeCase).Return(note, nil).Once()
+	s.env.OnActivity(activities.ApplyDisputeResolutionActivity, mock.Anything, *note).Return(nil).Once()
+	s.env.OnActivity(activities.CheckDisputeReanalysisDueActivity, mock.Anything, articleID).Return(false, nil).Once()
+
+	s.env.ExecuteWorkflow(DisputeReanalysisWorkflow, articleID)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Contestații sosite în timpul reanalizei
+// Pragul e din nou atins după rezolvare, deci workflow-ul se reia pentru același articol.
+func (s *WorkflowTestSuite) TestDisputeReanalysisWorkflow_ContinuesAsNewWhenNewDisputesArrived() {
+	activities := &NewsProcessingActivities{}
+	articleID := uuid.New()
+
+	disputeCase := &dispute.DisputeCase{
+		ArticleID:  articleID,
+		TruthScore: 0.8,
+		Disputes:   []dispute.Dispute{{ID: uuid.New(), Target: dispute.TargetTruthScore}},
+	}
+	note := &dispute.ResolutionNote{ArticleID: articleID, Outcome: dispute.OutcomeUpheld}
+
+	s.env.OnActivity(activities.LoadDisputeCaseActivity, mock.Anything, articleID).Return(disputeCase, nil).Once()
+	s.env.OnActivity(activities.ReanalyzeDisputesActivity, mock.Anything, *disputeCase).Return(note, nil).Once()
+	s.env.OnActivity(activities.ApplyDisputeResolutionActivity, mock.Anything, *note).Return(nil).Once()
+	s.env.OnActivity(activities.CheckDisputeReanalysisDueActivity, mock.Anything, articleID).Return(true, nil).Once()
+
+	s.env.ExecuteWorkflow(DisputeReanalysisWorkflow, articleID)
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continued *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &continued)
+	var continuedArticleID uuid.UUID
+	s.Require().NoError(converter.GetDefaultDataConverter().FromPayloads(continued.Input, &continuedArticleID))
+	s.Equal(articleID, continuedArticleID)
+}
+
+// [RO] Test: Contestațiile au fost deja rezolvate de o rulare anterioară
+func (s *WorkflowTestSuite) TestDisputeReanalysisWorkflow_StopsWithoutOpenDisputes() {
+	activities := &NewsProcessingActivities{}
+	articleID := uuid.New()
+
+	s.env.OnActivity(activities.LoadDisputeCaseActivity, mock.Anything, articleID).Return(&dispute.DisputeCase{ArticleID: articleID}, nil).Once()
+
+	s.env.ExecuteWorkflow(DisputeReanalysisWorkflow, articleID)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Scorurile Comunității
+// Fiecare dimensiune e punctată separat, cu același moment de calcul.
+func (s *WorkflowTestSuite) TestCommunityScoringWorkflow_ScoresEveryDimension() {
+	activities := &NewsProcessingActivities{}
+	startedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.env.SetStartTime(startedAt)
+
+	s.env.OnActivity(activities.ScoreCommunityRatingsActivity, mock.Anything, community.DimensionHelpfulness, startedAt).Return(4, nil).Once()
+	s.env.OnActivity(activities.ScoreCommunityRatingsActivity, mock.Anything, community.DimensionFairness, startedAt).Return(0, nil).Once()
+
+	s.env.ExecuteWorkflow(CommunityScoringWorkflow)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Perspectivele Poveștii
+// Corpus schimbat: revizia următoare e generată și salvată.
+func (s *WorkflowTestSuite) TestStoryPerspectiveWorkflow_SavesNextRevision() {
+	activities := &NewsProcessingActivities{}
+	clusterID := uuid.New()
+
+	input := &PerspectiveCorpus{
+		Corpus:       []perspective.SourceArticle{{ArticleID: uuid.New()}, {ArticleID: uuid.New()}},
+		NextRevision: 3,
+		NeedsRefresh: true,
+	}
+	set := &perspective.PerspectiveSet{StoryClusterID: clusterID, Revision: 3, Perspectives: []perspective.Perspective{{Label: "Unions"}, {Label: "Government"}}}
+
+	s.env.OnActivity(activities.LoadPerspectiveCorpusActivity, mock.Anything, clusterID).Return(input, nil).Once()
+	s.env.OnActivity(activities.GenerateStoryPerspectivesActivity, mock.Anything, clusterID, *input).Return(set, nil).Once()
+	s.env.OnActivity(activities.SavePerspectiveSetActivity, mock.Anything, *set).Return(nil).Once()
+
+	s.env.ExecuteWorkflow(StoryPerspectiveWorkflow, clusterID)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Aceleași articole ca la ultima revizie -> niciun apel la model
+func (s *WorkflowTestSuite) TestStoryPerspectiveWorkflow_SkipsUnchangedCorpus() {
+	activities := &NewsProcessingActivities{}
+	clusterID := uuid.New()
+
+	s.env.OnActivity(activities.LoadPerspectiveCorpusActivity, mock.Anything, clusterID).Return(&PerspectiveCorpus{NextRevision: 2}, nil).Once()
+
+	s.env.ExecuteWorkflow(StoryPerspectiveWorkflow, clusterID)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Articol nou în timpul generării -> corpusul se reîncarcă înainte de încheiere
+func (s *WorkflowTestSuite) TestStoryPerspectiveWorkflow_ReloadsCorpusWhenSignalledDuringGeneration() {
+	activities := &NewsProcessingActivities{}
+	clusterID := uuid.New()
+
+	first := &PerspectiveCorpus{Corpus: []perspective.SourceArticle{{ArticleID: uuid.New()}, {ArticleID: uuid.New()}}, NextRevision: 1, NeedsRefresh: true}
+	second := &PerspectiveCorpus{Corpus: append(first.Corpus, perspective.SourceArticle{ArticleID: uuid.New()}), NextRevision: 2, NeedsRefresh: true}
+	s.env.OnActivity(activities.LoadPerspectiveCorpusActivity, mock.Anything, clusterID).Return(first, nil).Once()
+	s.env.OnActivity(activities.LoadPerspectiveCorpusActivity, mock.Anything, clusterID).Return(second, nil).Once()
+
+	var generated []int
+	s.env.OnActivity(activities.GenerateStoryPerspectivesActivity, mock.Anything, clusterID, mock.Anything).Return(
+		func(ctx context.Context, clusterID uuid.UUID, input PerspectiveCorpus) (*perspective.PerspectiveSet, error) {
+			generated = append(generated, input.NextRevision)
+			return &perspective.PerspectiveSet{StoryClusterID: clusterID, Revision: input.NextRevision}, nil
+		}).After(time.Minute).Twice()
+	s.env.OnActivity(activities.SavePerspectiveSetActivity, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	// [RO] Semnalul sosește cât timp prima generare încă rulează
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(PerspectiveCorpusChangedSignal, nil)
+	}, 30*time.Second)
+
+	s.env.ExecuteWorkflow(StoryPerspectiveWorkflow, clusterID)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal([]int{1, 2}, generated)
+}
+
+// [RO] Test: Migrarea pe pasaje avansează cursorul, inclusiv peste articolele eșuate
+func (s *WorkflowTestSuite) TestArticleChunkBackfillWorkflow_AdvancesCursorPastFailures() {
+	activities := &NewsProcessingActivities{}
+	next := ChunkBackfillCursor{PublishedAt: time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC), ArticleID: uuid.New()}
+
+	s.env.OnActivity(activities.BackfillArticleChunksActivity, mock.Anything, ChunkBackfillCursor{}).
+		Return(&ChunkBackfillBatch{Processed: 20, Failed: 2, Next: next}, nil).Once()
+	s.env.OnActivity(activities.BackfillArticleChunksActivity, mock.Anything, next).
+		Return(&ChunkBackfillBatch{Next: next}, nil).Once()
+
+	s.env.ExecuteWorkflow(ArticleChunkBackfillWorkflow, ChunkBackfillProgress{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+// [RO] Test: Arhivă mare -> ContinueAsNew cu progresul acumulat
+func (s *WorkflowTestSuite) TestArticleChunkBackfillWorkflow_ContinuesAsNewWithProgress() {
+	activities := &NewsProcessingActivities{}
+	cursor := ChunkBackfillCursor{PublishedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), ArticleID: uuid.New()}
+	s.env.OnActivity(activities.BackfillArticleChunksActivity, mock.Anything, mock.Anything).
+		Return(&ChunkBackfillBatch{Processed: 20, Next: cursor}, nil).Times(articleChunkBackfillBatchesPerRun)
+
+	s.env.ExecuteWorkflow(ArticleChunkBackfillWorkflow, ChunkBackfillProgress{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continued *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &continued)
+	var progress ChunkBackfillProgress
+	s.Require().NoError(converter.GetDefaultDataConverter().FromPayloads(continued.Input, &progress))
+	s.Equal(ChunkBackfillProgress{Cursor: cursor, Processed: 20 * articleChunkBackfillBatchesPerRun}, progress)
+}
+
+// [RO] Test: Migrarea grafului -> ContinueAsNew cu pasul curent
+// Primul pas se termină (lot gol), al doilea umple restul execuției și continuă cu totalul lui.
+func (s *WorkflowTestSuite) TestLinkGraphNodesMigrationWorkflow_ContinuesAsNewWithStep() {
+	activities := &NewsProcessingActivities{}
+	s.env.OnActivity(activities.BackfillStoryClustersActivity, mock.Anything).Return(0, nil).Once()
+	s.env.OnActivity(activities.LinkLegacyEventsActivity, mock.Anything).Return(10, nil).Times(graphLinkMigrationBatchesPerRun - 1)
+
+	s.env.ExecuteWorkflow(LinkGraphNodesMigrationWorkflow, GraphLinkMigrationProgress{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continued *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &continued)
+	var progress GraphLinkMigrationProgress
+	s.Require().NoError(converter.GetDefaultDataConverter().FromPayloads(continued.Input, &progress))
+	s.Equal(GraphLinkMigrationProgress{Step: 1, Processed: 10 * (graphLinkMigrationBatchesPerRun - 1)}, progress)
+}
+
 func TestWorkflowTestSuite(t *testing.T) {
 	suite.Run(t, new(WorkflowTestSuite))
 }
@@ -0,0 +1,265 @@
+package wikidata
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// [RO] Clasele Wikidata (P31 "instance of") pe care le păstrăm, mapate la tipurile noastre.
+// Dump-ul este deja filtrat de operator, dar păstrăm verificarea ca un fișier greșit să nu umple baza.
+var instanceOfTypes = map[string]string{
+	// Persoane
+	"Q5": entity.TypePerson, // human
+
+	// Organizații
+	"Q43229":   entity.TypeOrganization, // organization
+	"Q4830453": entity.TypeOrganization, // business
+	"Q783794":  entity.TypeOrganization, // company
+	"Q7278":    entity.TypeOrganization, // political party
+	"Q484652":  entity.TypeOrganization, // international organization
+	"Q327333":  entity.TypeOrganization, // government agency
+	"Q163740":  entity.TypeOrganization, // nonprofit organization
+	"Q1193236": entity.TypeOrganization, // news agency
+	"Q11032":   entity.TypeOrganization, // newspaper
+
+	// Locuri
+	"Q6256":     entity.TypePlace, // country
+	"Q3624078":  entity.TypePlace, // sovereign state
+	"Q515":      entity.TypePlace, // city
+	"Q5119":     entity.TypePlace, // capital
+	"Q1549591":  entity.TypePlace, // big city
+	"Q486972":   entity.TypePlace, // human settlement
+	"Q10864048": entity.TypePlace, // first-level administrative division
+	"Q35657":    entity.TypePlace, // U.S. state
+}
+
+// [RO] Forma minimă a unei entități din dump-ul JSON Wikidata (doar câmpurile folosite)
+type rawEntity struct {
+	ID           string                     `json:"id"`
+	Type         string                     `json:"type"`
+	Labels       map[string]languageValue   `json:"labels"`
+	Descriptions map[string]languageValue   `json:"descriptions"`
+	Aliases      map[string][]languageValue `json:"aliases"`
+	Claims       map[string][]rawClaim      `json:"claims"`
+	Sitelinks    map[string]json.RawMessage `json:"sitelinks"`
+}
+
+type languageValue struct {
+	Value string `json:"value"`
+}
+
+type rawClaim struct {
+	Mainsnak struct {
+		Datavalue struct {
+			Value json.RawMessage `json:"value"`
+		} `json:"datavalue"`
+	} `json:"mainsnak"`
+	Rank string `json:"rank"`
+}
+
+// [RO] Cititor de Dump Wikidata (offline)
+//
+// Dump-ul oficial este un array JSON uriaș cu o entitate pe linie:
+//
+//	[
+//	{"id":"Q42",...},
+//	{"id":"Q64",...}
+//	]
+//
+// Citim linie cu linie (fără să încărcăm fișierul în RAM). Fișierele .gz și .bz2 sunt decomprimate din mers.
+type DumpReader struct {
+	languages []string
+	reader    *bufio.Reader
+	closer    io.Closer
+}
+
+// [RO] Deschide un fișier dump local.
+// `languages` este ordinea de preferință pentru etichete/descrieri (ex: "en", "ro").
+func OpenDump(path string, languages []string) (*DumpReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var source io.Reader = file
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		source = gz
+	case strings.HasSuffix(path, ".bz2"):
+		source = bzip2.NewReader(file)
+	}
+
+	return NewDumpReader(source, file, languages), nil
+}
+
+// [RO] Constructor peste un flux deja deschis (folosit și în teste)
+func NewDumpReader(source io.Reader, closer io.Closer, languages []string) *DumpReader {
+	if len(languages) == 0 {
+		languages = []string{"en"}
+	}
+	return &DumpReader{
+		languages: languages,
+		reader:    bufio.NewReaderSize(source, 1<<20),
+		closer:    closer,
+	}
+}
+
+// [RO] Următoarea intrare utilă.
+// Returnează io.EOF la final. Liniile care nu sunt persoane/organizații/locuri sunt sărite.
+func (dump *DumpReader) Next() (*entity.KnowledgeBaseEntry, error) {
+	for {
+		line, err := dump.reader.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+
+		entry, parseErr := ParseEntityLine(line, dump.languages)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		if entry != nil {
+			return entry, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// [RO] Închide fișierul
+func (dump *DumpReader) Close() error {
+	if dump.closer == nil {
+		return nil
+	}
+	return dump.closer.Close()
+}
+
+// [RO] Parsează o linie din dump.
+// Returnează (nil, nil) pentru liniile de structură ("[", "]") și pentru entitățile care nu ne interesează.
+func ParseEntityLine(line []byte, languages []string) (*entity.KnowledgeBaseEntry, error) {
+	line = bytes.TrimSpace(line)
+	line = bytes.TrimSuffix(line, []byte(","))
+	if len(line) == 0 || bytes.Equal(line, []byte("[")) || bytes.Equal(line, []byte("]")) {
+		return nil, nil
+	}
+
+	var raw rawEntity
+	if err := json.Unmarshal(line, &raw); err != nil {
+		return nil, fmt.Errorf("[RO] Linie invalidă în dump-ul Wikidata: %w", err)
+	}
+	if raw.Type != "" && raw.Type != "item" {
+		return nil, nil
+	}
+
+	entityType := classify(raw.Claims["P31"])
+	if entityType == "" {
+		return nil, nil
+	}
+
+	label := pickLanguage(raw.Labels, languages)
+	if label == "" {
+		return nil, nil
+	}
+
+	entry := &entity.KnowledgeBaseEntry{
+		QID:         raw.ID,
+		Label:       label,
+		Description: pickLanguage(raw.Descriptions, languages),
+		Type:        entityType,
+		Sitelinks:   len(raw.Sitelinks),
+	}
+
+	seen := map[string]bool{label: true}
+	for _, lang := range languages {
+		for _, alias := range raw.Aliases[lang] {
+			if alias.Value != "" && !seen[alias.Value] {
+				seen[alias.Value] = true
+				entry.Aliases = append(entry.Aliases, alias.Value)
+			}
+		}
+		// Eticheta din celelalte limbi este și ea un alias ("Bucharest" / "București").
+		if other, ok := raw.Labels[lang]; ok && !seen[other.Value] {
+			seen[other.Value] = true
+			entry.Aliases = append(entry.Aliases, other.Value)
+		}
+	}
+
+	if entityType == entity.TypePlace {
+		entry.Latitude, entry.Longitude, entry.HasCoordinates = coordinates(raw.Claims["P625"])
+	}
+
+	return entry, nil
+}
+
+// [RO] Primul tip recunoscut din P31 (ignorăm afirmațiile depreciate)
+func classify(claims []rawClaim) string {
+	for _, claim := range claims {
+		if claim.Rank == "deprecated" {
+			continue
+		}
+		var target struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(claim.Mainsnak.Datavalue.Value, &target); err != nil {
+			continue
+		}
+		if entityType, ok := instanceOfTypes[target.ID]; ok {
+			return entityType
+		}
+	}
+	return ""
+}
+
+// [RO] Coordonatele (P625). Preferăm afirmația "preferred", altfel prima validă.
+func coordinates(claims []rawClaim) (float64, float64, bool) {
+	var latitude, longitude float64
+	found := false
+
+	for _, claim := range claims {
+		if claim.Rank == "deprecated" {
+			continue
+		}
+		var value struct {
+			Latitude  float64 `json:"latitude"`
+			Longitude float64 `json:"longitude"`
+			Globe     string  `json:"globe"`
+		}
+		if err := json.Unmarshal(claim.Mainsnak.Datavalue.Value, &value); err != nil {
+			continue
+		}
+		// Doar coordonate terestre (Wikidata are și locuri pe Lună/Marte).
+		if value.Globe != "" && !strings.HasSuffix(value.Globe, "/Q2") {
+			continue
+		}
+		if !found || claim.Rank == "preferred" {
+			latitude, longitude, found = value.Latitude, value.Longitude, true
+			if claim.Rank == "preferred" {
+				break
+			}
+		}
+	}
+	return latitude, longitude, found
+}
+
+func pickLanguage(values map[string]languageValue, languages []string) string {
+	for _, lang := range languages {
+		if value, ok := values[lang]; ok && value.Value != "" {
+			return value.Value
+		}
+	}
+	return ""
+}
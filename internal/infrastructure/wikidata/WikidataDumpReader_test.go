@@ -0,0 +1,50 @@
+package wikidata
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// [RO] Un dump minuscul în formatul oficial: un loc, o persoană și o operă (ignorată).
+const sampleDump = `[
+{"type":"item","id":"Q19660","labels":{"en":{"value":"Bucharest"},"ro":{"value":"București"}},"descriptions":{"en":{"value":"capital of Romania"}},"aliases":{"en":[{"value":"Bucuresti"}]},"claims":{"P31":[{"rank":"normal","mainsnak":{"datavalue":{"value":{"id":"Q5119"}}}}],"P625":[{"rank":"normal","mainsnak":{"datavalue":{"value":{"latitude":44.4325,"longitude":26.1039,"globe":"http://www.wikidata.org/entity/Q2"}}}}]},"sitelinks":{"enwiki":{},"rowiki":{}}},
+{"type":"item","id":"Q6279","labels":{"en":{"value":"Joe Biden"}},"descriptions":{"en":{"value":"46th president of the United States"}},"aliases":{"en":[{"value":"Joseph Robinette Biden Jr."}]},"claims":{"P31":[{"rank":"normal","mainsnak":{"datavalue":{"value":{"id":"Q5"}}}}]},"sitelinks":{"enwiki":{}}},
+{"type":"item","id":"Q25338","labels":{"en":{"value":"The Little Prince"}},"claims":{"P31":[{"rank":"normal","mainsnak":{"datavalue":{"value":{"id":"Q7725634"}}}}]}}
+]
+`
+
+func TestDumpReader_StreamsPeopleAndPlaces(t *testing.T) {
+	reader := NewDumpReader(strings.NewReader(sampleDump), nil, []string{"en", "ro"})
+
+	bucharest, err := reader.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "Q19660", bucharest.QID)
+	assert.Equal(t, entity.TypePlace, bucharest.Type)
+	assert.Equal(t, "capital of Romania", bucharest.Description)
+	assert.ElementsMatch(t, []string{"Bucuresti", "București"}, bucharest.Aliases)
+	assert.True(t, bucharest.HasCoordinates)
+	assert.InDelta(t, 44.4325, bucharest.Latitude, 0.0001)
+	assert.Equal(t, 2, bucharest.Sitelinks)
+
+	biden, err := reader.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, entity.TypePerson, biden.Type)
+	assert.False(t, biden.HasCoordinates)
+
+	// [RO] Opera literară nu este persoană/organizație/loc -> sărită, urmează EOF.
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestParseEntityLine_IgnoresNonTerrestrialCoordinates(t *testing.T) {
+	line := `{"type":"item","id":"Q1","labels":{"en":{"value":"Tranquility Base"}},"claims":{"P31":[{"mainsnak":{"datavalue":{"value":{"id":"Q486972"}}}}],"P625":[{"mainsnak":{"datavalue":{"value":{"latitude":0.67,"longitude":23.47,"globe":"http://www.wikidata.org/entity/Q405"}}}}]}},`
+
+	entry, err := ParseEntityLine([]byte(line), []string{"en"})
+
+	assert.NoError(t, err)
+	assert.False(t, entry.HasCoordinates)
+}
@@ -0,0 +1,95 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/yourorg/truthweave/internal/domain/analytics"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+)
+
+// [RO] Programarea Agregatelor (cron Temporal)
+const (
+	RollupWorkflowID      = "analytics-rollups"
+	RollupBackfillID      = "analytics-rollups-backfill"
+	RollupCronSchedule    = "*/15 * * * *"
+	MaxRollupBackfillDays = 730
+	rollupWorkflowName    = "AnalyticsRollupWorkflow"
+	workflowTaskQueue     = "truthweave-task-queue"
+)
+
+// [RO] Serviciul de Serii de Timp Analitice
+//
+// Emoția globală, scorul de adevăr și numărul de articole, pe oră sau pe zi, global sau
+// pe țară / sector / narațiune. Citește doar agregatele pre-calculate (analytics_rollups).
+type AnalyticsTimeSeriesService struct {
+	rollups          analytics.AnalyticsRollupPersistenceInterface
+	workflowLauncher ports.WorkflowOrchestratorLauncher
+	now              func() time.Time
+}
+
+// [RO] Constructor Serviciu Analitic
+func NewAnalyticsTimeSeriesService(rollups analytics.AnalyticsRollupPersistenceInterface, launcher ports.WorkflowOrchestratorLauncher) *AnalyticsTimeSeriesService {
+	return &AnalyticsTimeSeriesService{rollups: rollups, workflowLauncher: launcher, now: time.Now}
+}
+
+// [RO] Seria de Timp
+// Intervalele fără articole sunt completate cu zero, ca graficul să aibă o axă continuă.
+func (service *AnalyticsTimeSeriesService) RetrieveTimeSeries(executionContext context.Context, query analytics.TimeSeriesQuery) (*analytics.TimeSeries, error) {
+	if err := query.Normalize(service.now()); err != nil {
+		return nil, err
+	}
+
+	stored, err := service.rollups.RetrieveTimeSeries(executionContext, query)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &analytics.TimeSeries{
+		Granularity: query.Granularity,
+		Dimension:   query.Dimension,
+		From:        query.From,
+		To:          query.To,
+		Buckets:     analytics.FillTimeSeriesGaps(query, stored),
+	}
+	if query.Dimension != analytics.DimensionGlobal {
+		series.Value = query.Value
+	}
+	return series, nil
+}
+
+// [RO] Programează Recalcularea Periodică (Admin)
+// ID-ul fix face apelul idempotent: dacă cron-ul rulează deja, primim execuția existentă.
+func (service *AnalyticsTimeSeriesService) ScheduleRollups(executionContext context.Context) (string, error) {
+	options := client.StartWorkflowOptions{
+		ID:           RollupWorkflowID,
+		TaskQueue:    workflowTaskQueue,
+		CronSchedule: RollupCronSchedule,
+	}
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, rollupWorkflowName, time.Duration(0))
+	if err != nil {
+		return "", fmt.Errorf("[RO] Eroare: Agregatele nu au putut fi programate: %w", err)
+	}
+	return run.GetID(), nil
+}
+
+// [RO] Reconstruiește Istoricul (Admin)
+// O rulare unică peste ultimele `days` zile (ex: după prima instalare sau după o corecție de date).
+func (service *AnalyticsTimeSeriesService) StartRollupBackfill(executionContext context.Context, days int) (string, error) {
+	if days <= 0 || days > MaxRollupBackfillDays {
+		return "", fmt.Errorf("[RO] Eroare: Numărul de zile trebuie să fie între 1 și %d.", MaxRollupBackfillDays)
+	}
+	options := client.StartWorkflowOptions{
+		ID:        RollupBackfillID,
+		TaskQueue: workflowTaskQueue,
+	}
+	lookback := time.Duration(days) * 24 * time.Hour
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, rollupWorkflowName, lookback)
+	if err != nil {
+		return "", fmt.Errorf("[RO] Eroare: Reconstrucția agregatelor nu a putut fi pornită: %w", err)
+	}
+	return run.GetID(), nil
+}
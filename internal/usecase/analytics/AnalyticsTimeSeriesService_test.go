@@ -0,0 +1,101 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/analytics"
+	"github.com/yourorg/truthweave/internal/domain/trend"
+)
+
+// [RO] Depozit fals: returnează intervalele scriptate și reține cererea primită.
+type fakeRollupRepository struct {
+	buckets  []analytics.TimeSeriesBucket
+	received analytics.TimeSeriesQuery
+}
+
+func (repo *fakeRollupRepository) RefreshRollups(ctx context.Context, from time.Time, to time.Time) error {
+	return nil
+}
+
+func (repo *fakeRollupRepository) RefreshNarrativeRollups(ctx context.Context, narratives map[string][]string, from time.Time, to time.Time) error {
+	return nil
+}
+
+func (repo *fakeRollupRepository) RetrieveTimeSeries(ctx context.Context, query analytics.TimeSeriesQuery) ([]analytics.TimeSeriesBucket, error) {
+	repo.received = query
+	return repo.buckets, nil
+}
+
+func TestRetrieveTimeSeries_NormalizesAndFillsGaps(t *testing.T) {
+	repo := &fakeRollupRepository{buckets: []analytics.TimeSeriesBucket{{
+		BucketStart:       time.Date(2026, 3, 21, 9, 0, 0, 0, time.UTC),
+		ArticleCount:      2,
+		AverageTruthScore: 0.8,
+		EmotionCounts:     map[string]int{"Fear": 2},
+	}}}
+	service := NewAnalyticsTimeSeriesService(repo, nil)
+	service.now = func() time.Time { return time.Date(2026, 3, 21, 10, 30, 0, 0, time.UTC) }
+
+	series, err := service.RetrieveTimeSeries(context.Background(), analytics.TimeSeriesQuery{
+		Granularity: analytics.GranularityHour,
+		Dimension:   analytics.DimensionCountry,
+		Value:       "ro",
+		From:        time.Date(2026, 3, 21, 8, 15, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "RO", repo.received.Value)
+	assert.Equal(t, "RO", series.Value)
+	require.Len(t, series.Buckets, 3) // 08:00, 09:00, 10:00
+	assert.Equal(t, 0, series.Buckets[0].ArticleCount)
+	assert.Equal(t, "Fear", series.Buckets[1].DominantEmotion)
+	assert.Equal(t, 0, series.Buckets[2].ArticleCount)
+}
+
+func TestRetrieveTimeSeries_RejectsUnknownSector(t *testing.T) {
+	service := NewAnalyticsTimeSeriesService(&fakeRollupRepository{}, nil)
+	_, err := service.RetrieveTimeSeries(context.Background(), analytics.TimeSeriesQuery{Dimension: analytics.DimensionSector, Value: "astrology"})
+	assert.Error(t, err)
+}
+
+func TestStartRollupBackfill_ValidatesDays(t *testing.T) {
+	service := NewAnalyticsTimeSeriesService(&fakeRollupRepository{}, nil)
+	_, err := service.StartRollupBackfill(context.Background(), MaxRollupBackfillDays+1)
+	assert.Error(t, err)
+}
+
+// [RO] Depozit fals de semnale: reține fereastra cerută.
+type fakeTrendRepository struct {
+	since time.Time
+	limit int
+}
+
+func (repo *fakeTrendRepository) RetrieveVolumeSeries(ctx context.Context, kind string, windowEnd time.Time, historyHours int, minCount int) ([]trend.VolumeSeries, error) {
+	return nil, nil
+}
+
+func (repo *fakeTrendRepository) SaveTrendSignals(ctx context.Context, signals []trend.TrendSignal) error {
+	return nil
+}
+
+func (repo *fakeTrendRepository) RetrieveRecentTrendSignals(ctx context.Context, since time.Time, limit int) ([]trend.TrendSignal, error) {
+	repo.since, repo.limit = since, limit
+	return nil, nil
+}
+
+func TestRetrieveRecentSignals_ClampsWindowAndReturnsEmptyList(t *testing.T) {
+	repo := &fakeTrendRepository{}
+	service := NewTrendSignalService(repo, nil)
+	now := time.Date(2026, 3, 21, 10, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	signals, err := service.RetrieveRecentSignals(context.Background(), 10_000, 0)
+	require.NoError(t, err)
+	assert.NotNil(t, signals)
+	assert.Equal(t, now.Add(-DefaultTrendHours*time.Hour), repo.since)
+	assert.Equal(t, DefaultTrendLimit, repo.limit)
+}
@@ -0,0 +1,74 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/yourorg/truthweave/internal/domain/trend"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+)
+
+// [RO] Limite Listă Trenduri
+const (
+	DefaultTrendHours = 24
+	MaxTrendHours     = 7 * 24
+	DefaultTrendLimit = 50
+	MaxTrendLimit     = 200
+
+	TrendDetectionWorkflowID   = "trend-detection"
+	TrendDetectionCronSchedule = "*/15 * * * *"
+	trendDetectionWorkflowName = "TrendDetectionWorkflow"
+)
+
+// [RO] Serviciul Semnalelor de Trend
+//
+// Expune semnalele "breaking" detectate de TrendDetectionWorkflow (entități și povești
+// cu volum mult peste linia lor de bază) și programează detectarea periodică.
+type TrendSignalService struct {
+	signals          trend.TrendPersistenceInterface
+	workflowLauncher ports.WorkflowOrchestratorLauncher
+	now              func() time.Time
+}
+
+// [RO] Constructor Serviciu Trenduri
+func NewTrendSignalService(signals trend.TrendPersistenceInterface, launcher ports.WorkflowOrchestratorLauncher) *TrendSignalService {
+	return &TrendSignalService{signals: signals, workflowLauncher: launcher, now: time.Now}
+}
+
+// [RO] Semnalele din ultimele `hours` ore, cele mai noi primele
+func (service *TrendSignalService) RetrieveRecentSignals(executionContext context.Context, hours int, limit int) ([]trend.TrendSignal, error) {
+	if hours <= 0 || hours > MaxTrendHours {
+		hours = DefaultTrendHours
+	}
+	if limit <= 0 || limit > MaxTrendLimit {
+		limit = DefaultTrendLimit
+	}
+
+	since := service.now().Add(-time.Duration(hours) * time.Hour)
+	signals, err := service.signals.RetrieveRecentTrendSignals(executionContext, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	if signals == nil {
+		signals = []trend.TrendSignal{}
+	}
+	return signals, nil
+}
+
+// [RO] Programează Detectarea Periodică (Admin)
+// ID-ul fix face apelul idempotent: dacă cron-ul rulează deja, primim execuția existentă.
+func (service *TrendSignalService) ScheduleTrendDetection(executionContext context.Context) (string, error) {
+	options := client.StartWorkflowOptions{
+		ID:           TrendDetectionWorkflowID,
+		TaskQueue:    workflowTaskQueue,
+		CronSchedule: TrendDetectionCronSchedule,
+	}
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, trendDetectionWorkflowName)
+	if err != nil {
+		return "", fmt.Errorf("[RO] Eroare: Detectarea trendurilor nu a putut fi programată: %w", err)
+	}
+	return run.GetID(), nil
+}
@@ -0,0 +1,224 @@
+package article
+
+import (
+	"math"
+	"time"
+
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// [RO] Numele stratului din plăcile vectoriale (folosit în stilul Mapbox: "source-layer": "gaia").
+const gaiaVectorTileLayer = "gaia"
+
+// ---------------------------------------------------------------------------
+// [RO] GeoJSON (RFC 7946)
+// Coordonatele sunt [longitudine, latitudine], în această ordine.
+// ---------------------------------------------------------------------------
+
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Geometry   GeoJSONPoint           `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type GeoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// [RO] Colecție GeoJSON din Știrile de pe Hartă
+func newGaiaFeatureCollection(points []article.GaiaArticlePoint) *GeoJSONFeatureCollection {
+	collection := &GeoJSONFeatureCollection{Type: "FeatureCollection", Features: []GeoJSONFeature{}}
+	for _, point := range points {
+		collection.Features = append(collection.Features, GeoJSONFeature{
+			Type:     "Feature",
+			ID:       point.ID,
+			Geometry: GeoJSONPoint{Type: "Point", Coordinates: [2]float64{point.Longitude, point.Latitude}},
+			Properties: map[string]interface{}{
+				"title":        point.Title,
+				"url":          point.URL,
+				"truth_score":  point.TruthScore,
+				"emotion":      point.Emotion,
+				"published_at": point.PublishedAt.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+	return collection
+}
+
+// ---------------------------------------------------------------------------
+// [RO] Mapbox Vector Tile (specificația 2.1)
+// Codare protobuf manuală (protowire), fără cod generat: un singur strat cu puncte.
+// ---------------------------------------------------------------------------
+
+// [RO] Câmpurile protobuf din vector_tile.proto
+const (
+	mvtTileLayers = 3
+
+	mvtLayerName     = 1
+	mvtLayerFeatures = 2
+	mvtLayerKeys     = 3
+	mvtLayerValues   = 4
+	mvtLayerExtent   = 5
+	mvtLayerVersion  = 15
+
+	mvtFeatureID       = 1
+	mvtFeatureTags     = 2
+	mvtFeatureType     = 3
+	mvtFeatureGeometry = 4
+
+	mvtValueString = 1
+	mvtValueDouble = 3
+	mvtValueUint   = 5
+
+	mvtGeometryPoint = 1
+	mvtCommandMoveTo = 1
+	mvtSpecVersion   = 2
+)
+
+// [RO] Proprietate a unui punct din placă (string, float64 sau int)
+type mvtProperty struct {
+	key   string
+	value interface{}
+}
+
+// [RO] Tabelele de chei/valori ale stratului (fiecare cheie și valoare apare o singură dată)
+type mvtLayerBuilder struct {
+	keys       []string
+	keyIndex   map[string]uint64
+	values     [][]byte
+	valueIndex map[string]uint64
+	features   [][]byte
+	nextID     uint64
+}
+
+func newMVTLayerBuilder() *mvtLayerBuilder {
+	return &mvtLayerBuilder{keyIndex: map[string]uint64{}, valueIndex: map[string]uint64{}}
+}
+
+func (layer *mvtLayerBuilder) key(name string) uint64 {
+	if index, ok := layer.keyIndex[name]; ok {
+		return index
+	}
+	index := uint64(len(layer.keys))
+	layer.keys = append(layer.keys, name)
+	layer.keyIndex[name] = index
+	return index
+}
+
+func (layer *mvtLayerBuilder) value(encoded []byte) uint64 {
+	if index, ok := layer.valueIndex[string(encoded)]; ok {
+		return index
+	}
+	index := uint64(len(layer.values))
+	layer.values = append(layer.values, encoded)
+	layer.valueIndex[string(encoded)] = index
+	return index
+}
+
+func mvtStringValue(value string) []byte {
+	return protowire.AppendString(protowire.AppendTag(nil, mvtValueString, protowire.BytesType), value)
+}
+
+func mvtDoubleValue(value float64) []byte {
+	return protowire.AppendFixed64(protowire.AppendTag(nil, mvtValueDouble, protowire.Fixed64Type), math.Float64bits(value))
+}
+
+func mvtUintValue(value uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, mvtValueUint, protowire.VarintType), value)
+}
+
+// [RO] Adaugă un punct (coordonate deja proiectate în placă) cu proprietățile lui
+func (layer *mvtLayerBuilder) addPoint(x int, y int, properties []mvtProperty) {
+	var tags []byte
+	for _, property := range properties {
+		var encoded []byte
+		switch value := property.value.(type) {
+		case string:
+			encoded = mvtStringValue(value)
+		case float64:
+			encoded = mvtDoubleValue(value)
+		case int:
+			encoded = mvtUintValue(uint64(value))
+		default:
+			continue
+		}
+		tags = protowire.AppendVarint(tags, layer.key(property.key))
+		tags = protowire.AppendVarint(tags, layer.value(encoded))
+	}
+
+	// [RO] Geometrie: MoveTo(1) urmat de (dx, dy) codate zigzag, relativ la colțul plăcii.
+	var geometry []byte
+	geometry = protowire.AppendVarint(geometry, uint64(mvtCommandMoveTo&0x7|1<<3))
+	geometry = protowire.AppendVarint(geometry, protowire.EncodeZigZag(int64(x)))
+	geometry = protowire.AppendVarint(geometry, protowire.EncodeZigZag(int64(y)))
+
+	layer.nextID++
+	var feature []byte
+	feature = protowire.AppendTag(feature, mvtFeatureID, protowire.VarintType)
+	feature = protowire.AppendVarint(feature, layer.nextID)
+	feature = protowire.AppendTag(feature, mvtFeatureTags, protowire.BytesType)
+	feature = protowire.AppendBytes(feature, tags)
+	feature = protowire.AppendTag(feature, mvtFeatureType, protowire.VarintType)
+	feature = protowire.AppendVarint(feature, mvtGeometryPoint)
+	feature = protowire.AppendTag(feature, mvtFeatureGeometry, protowire.BytesType)
+	feature = protowire.AppendBytes(feature, geometry)
+	layer.features = append(layer.features, feature)
+}
+
+// [RO] Placa finală: un strat, cu tabelele de chei/valori la final
+func (layer *mvtLayerBuilder) encode(name string, extent int) []byte {
+	var encoded []byte
+	encoded = protowire.AppendTag(encoded, mvtLayerVersion, protowire.VarintType)
+	encoded = protowire.AppendVarint(encoded, mvtSpecVersion)
+	encoded = protowire.AppendTag(encoded, mvtLayerName, protowire.BytesType)
+	encoded = protowire.AppendString(encoded, name)
+	for _, feature := range layer.features {
+		encoded = protowire.AppendTag(encoded, mvtLayerFeatures, protowire.BytesType)
+		encoded = protowire.AppendBytes(encoded, feature)
+	}
+	for _, key := range layer.keys {
+		encoded = protowire.AppendTag(encoded, mvtLayerKeys, protowire.BytesType)
+		encoded = protowire.AppendString(encoded, key)
+	}
+	for _, value := range layer.values {
+		encoded = protowire.AppendTag(encoded, mvtLayerValues, protowire.BytesType)
+		encoded = protowire.AppendBytes(encoded, value)
+	}
+	encoded = protowire.AppendTag(encoded, mvtLayerExtent, protowire.VarintType)
+	encoded = protowire.AppendVarint(encoded, uint64(extent))
+
+	tile := protowire.AppendTag(nil, mvtTileLayers, protowire.BytesType)
+	return protowire.AppendBytes(tile, encoded)
+}
+
+// [RO] Placă Vectorială din Grupurile Hărții
+// Grupurile care (după proiecție) cad în afara plăcii sunt ignorate.
+func encodeGaiaVectorTile(tile article.GaiaTile, clusters []article.GaiaCluster) []byte {
+	layer := newMVTLayerBuilder()
+	for _, cluster := range clusters {
+		x, y := tile.Project(cluster.Latitude, cluster.Longitude, article.VectorTileExtent)
+		if x < 0 || x >= article.VectorTileExtent || y < 0 || y >= article.VectorTileExtent {
+			continue
+		}
+
+		properties := []mvtProperty{
+			{"cluster_id", cluster.ID},
+			{"count", cluster.Count},
+			{"avg_truth_score", cluster.AverageTruthScore},
+			{"dominant_emotion", cluster.DominantEmotion},
+		}
+		if cluster.ArticleID != "" {
+			properties = append(properties, mvtProperty{"article_id", cluster.ArticleID})
+		}
+		layer.addPoint(x, y, properties)
+	}
+	return layer.encode(gaiaVectorTileLayer, article.VectorTileExtent)
+}
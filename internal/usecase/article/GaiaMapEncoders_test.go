@@ -0,0 +1,114 @@
+package article
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// [RO] Cititor minimal de câmpuri protobuf: număr câmp -> valori (varint sau octeți).
+func decodeProtoFields(t *testing.T, encoded []byte) map[protowire.Number][]interface{} {
+	fields := map[protowire.Number][]interface{}{}
+	for len(encoded) > 0 {
+		number, wireType, length := protowire.ConsumeTag(encoded)
+		require.GreaterOrEqual(t, length, 0)
+		encoded = encoded[length:]
+
+		switch wireType {
+		case protowire.VarintType:
+			value, n := protowire.ConsumeVarint(encoded)
+			require.GreaterOrEqual(t, n, 0)
+			fields[number] = append(fields[number], value)
+			encoded = encoded[n:]
+		case protowire.Fixed64Type:
+			value, n := protowire.ConsumeFixed64(encoded)
+			require.GreaterOrEqual(t, n, 0)
+			fields[number] = append(fields[number], value)
+			encoded = encoded[n:]
+		case protowire.BytesType:
+			value, n := protowire.ConsumeBytes(encoded)
+			require.GreaterOrEqual(t, n, 0)
+			fields[number] = append(fields[number], value)
+			encoded = encoded[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", wireType)
+		}
+	}
+	return fields
+}
+
+func decodePacked(t *testing.T, packed []byte) []uint64 {
+	var values []uint64
+	for len(packed) > 0 {
+		value, n := protowire.ConsumeVarint(packed)
+		require.GreaterOrEqual(t, n, 0)
+		values = append(values, value)
+		packed = packed[n:]
+	}
+	return values
+}
+
+func TestEncodeGaiaVectorTile_ProducesValidPointLayer(t *testing.T) {
+	tile := article.GaiaTile{Z: 1, X: 1, Y: 0}
+	clusters := []article.GaiaCluster{
+		{ID: "1/0/0", Latitude: 44.43, Longitude: 26.10, Count: 12, AverageTruthScore: 0.7, DominantEmotion: "F"},
+		{ID: "1/0/1", Latitude: 35.68, Longitude: 139.69, Count: 1, AverageTruthScore: 0.7, DominantEmotion: "F", ArticleID: "a-1"},
+		{ID: "1/0/-1", Latitude: 40.71, Longitude: -74.0, Count: 5}, // [RO] În afara plăcii -> ignorat
+	}
+
+	tileFields := decodeProtoFields(t, encodeGaiaVectorTile(tile, clusters))
+	require.Len(t, tileFields[mvtTileLayers], 1)
+
+	layer := decodeProtoFields(t, tileFields[mvtTileLayers][0].([]byte))
+	assert.Equal(t, uint64(mvtSpecVersion), layer[mvtLayerVersion][0])
+	assert.Equal(t, gaiaVectorTileLayer, string(layer[mvtLayerName][0].([]byte)))
+	assert.Equal(t, uint64(article.VectorTileExtent), layer[mvtLayerExtent][0])
+	require.Len(t, layer[mvtLayerFeatures], 2)
+
+	var keys []string
+	for _, key := range layer[mvtLayerKeys] {
+		keys = append(keys, string(key.([]byte)))
+	}
+	assert.Equal(t, []string{"cluster_id", "count", "avg_truth_score", "dominant_emotion", "article_id"}, keys)
+
+	// [RO] Valorile identice ("F", 0.7) sunt stocate o singură dată: 2 id-uri + 2 count + 0.7 + "F" + article_id.
+	assert.Len(t, layer[mvtLayerValues], 7)
+
+	first := decodeProtoFields(t, layer[mvtLayerFeatures][0].([]byte))
+	assert.Equal(t, uint64(mvtGeometryPoint), first[mvtFeatureType][0])
+	assert.Len(t, decodePacked(t, first[mvtFeatureTags][0].([]byte)), 8)
+
+	geometry := decodePacked(t, first[mvtFeatureGeometry][0].([]byte))
+	require.Len(t, geometry, 3)
+	assert.Equal(t, uint64(9), geometry[0], "[RO] MoveTo cu un singur punct")
+	expectedX, expectedY := tile.Project(44.43, 26.10, article.VectorTileExtent)
+	assert.Equal(t, int64(expectedX), protowire.DecodeZigZag(geometry[1]))
+	assert.Equal(t, int64(expectedY), protowire.DecodeZigZag(geometry[2]))
+}
+
+func TestNewGaiaFeatureCollection_UsesLongitudeLatitudeOrder(t *testing.T) {
+	collection := newGaiaFeatureCollection([]article.GaiaArticlePoint{{
+		ID: "a-1", Title: "Summit", Latitude: 44.43, Longitude: 26.10, TruthScore: 0.8,
+		PublishedAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}})
+
+	encoded, err := json.Marshal(collection)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "FeatureCollection",
+		"features": [{
+			"type": "Feature",
+			"id": "a-1",
+			"geometry": {"type": "Point", "coordinates": [26.10, 44.43]},
+			"properties": {"title": "Summit", "url": "", "truth_score": 0.8, "emotion": "", "published_at": "2026-05-01T12:00:00Z"}
+		}]
+	}`, string(encoded))
+
+	empty, _ := json.Marshal(newGaiaFeatureCollection(nil))
+	assert.JSONEq(t, `{"type": "FeatureCollection", "features": []}`, string(empty))
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/yourorg/truthweave/internal/domain/ad"
 	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
 	"github.com/yourorg/truthweave/internal/usecase/ports"
+	"go.temporal.io/sdk/client"
 )
 
 // [RO] Serviciul de Orchestrare a Știrilor
@@ -79,6 +81,45 @@ func (service *NewsArticleOrchestrationService) StartNewsAnalysisPipeline(execut
 	return &NewsProcessingResponse{JobID: "job-" + uuid.New().String(), ProcessID: "run-init"}, nil
 }
 
+// [RO] Rebalansare Manuală a Grafului (Admin)
+// Reevaluează imediat cauzele unui eveniment față de istoricul ultimelor 7 zile.
+func (service *NewsArticleOrchestrationService) TriggerGraphRebalance(executionContext context.Context, eventID string) error {
+	if eventID == "" {
+		return fmt.Errorf("[RO] Eroare: ID-ul evenimentului nu poate fi gol.")
+	}
+	return service.workflowLauncher.ScheduleGraphRebalance(executionContext, eventID, 0)
+}
+
+// [RO] Migrare Graf: Legare Articole <-> Evenimente (Admin)
+// Pornește job-ul unic care grupează articolele vechi și leagă evenimentele fără sursă.
+// ID-ul fix al workflow-ului împiedică rularea a două migrări simultan.
+func (service *NewsArticleOrchestrationService) StartGraphLinkMigration(executionContext context.Context) (*NewsProcessingResponse, error) {
+	options := client.StartWorkflowOptions{
+		ID:        "graph-link-migration",
+		TaskQueue: "truthweave-task-queue",
+	}
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, "LinkGraphNodesMigrationWorkflow", struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("[RO] Eroare: Migrarea nu a putut fi pornită: %w", err)
+	}
+	return &NewsProcessingResponse{JobID: run.GetID(), ProcessID: run.GetRunID()}, nil
+}
+
+// [RO] Migrare Index: Indexarea pe Pasaje a Arhivei (Admin)
+// Articolele salvate înainte de indexul pe pasaje (sau cele a căror indexare a eșuat).
+func (service *NewsArticleOrchestrationService) StartArticleChunkBackfill(executionContext context.Context) (*NewsProcessingResponse, error) {
+	options := client.StartWorkflowOptions{
+		ID:        "article-chunk-backfill",
+		TaskQueue: "truthweave-task-queue",
+	}
+	// Progres gol: migrarea pornește de la cel mai nou articol.
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, "ArticleChunkBackfillWorkflow", struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("[RO] Eroare: Indexarea nu a putut fi pornită: %w", err)
+	}
+	return &NewsProcessingResponse{JobID: run.GetID(), ProcessID: run.GetRunID()}, nil
+}
+
 // [RO] Recuperează Dosarul Complet al Știrii
 // Caută o știre după ID și returnează toate detaliile disponibile.
 func (service *NewsArticleOrchestrationService) RetrieveCompleteNewsArticle(executionContext context.Context, idString string) (*article.NewsArticleEntity, error) {
@@ -98,30 +139,19 @@ type FeedDisplayItem struct {
 
 // [RO] Generează Fluxul de Știri Personalizat
 //
-// Construiește lista de noutăți pentru utilizator.
+// Construiește lista de noutăți pentru utilizator (opțional doar dintr-o țară).
 // Folosește algoritmul "Zipper" pentru a insera reclame printre articole într-un mod echilibrat
 // (ex: 1 reclamă la fiecare 5 articole).
-func (service *NewsArticleOrchestrationService) GeneratePersonalizedNewsFeed(executionContext context.Context) ([]FeedDisplayItem, error) {
-	// 1. Obținem Știrile Recente (prin interfața extinsă sau cast)
-	// Definim o interfață locală pentru capabilitatea de Feed a repository-ului.
-	type FeedDataProvider interface {
-		// Presupunem că am adăugat GetFeed în repo, sau folosim o metodă existentă.
-		// Repo-ul Postgres are `GetFeed` (trebuie redenumită `RetrieveLatestNews` poate?).
-		// Pentru compatibilitate cu codul existent în repo `PostgresNewsArticleRepository` (care are GetFeed),
-		// vom folosi type assertion. Dar repo-ul nou nu are `GetFeed` în interfața `NewsArticlePersistenceInterface`.
-		// Trebuia să o adaug în interfață? Da.
-		// Voi face un cast la un tip care are metoda, sau adaug în interfață data viitoare.
-		// Pentru acum, folosim ce e în interfață sau extindem.
-		// Soluție: Extindem conceptul. Dacă nu putem lua feed, returnăm gol.
-		// Dar stai, am uitat să pun `GetFeed` în `NewsArticlePersistenceInterface`!
-		// Voi presupune că există pentru a demonstra logica, sau o voi adăuga.
-		// E mai sigur să folosesc `RetrieveNewsArticleByID` repetat? Nu.
-		// Voi lăsa logica de feed goală momentan dacă nu am metoda în interfață, sau voi face cast la structura concretă (Bad Practice).
-		// Cel mai bine: Adaug metoda în Interface ulterior. Aici voi simula.
-	}
-
-	// Mocking data for strict compilation if interface misses method.
-	articles := []*article.NewsArticleEntity{} // Gol momentan
+func (service *NewsArticleOrchestrationService) GeneratePersonalizedNewsFeed(executionContext context.Context, query article.NewsFeedQuery) ([]FeedDisplayItem, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+
+	// 1. Obținem Știrile Recente
+	articles, err := service.newsRepository.RetrieveLatestNewsArticles(executionContext, query)
+	if err != nil {
+		return nil, err
+	}
 
 	// 2. Obținem Reclamele Active
 	ads, err := service.adRepository.RetrieveActiveAdvertisementCampaigns(executionContext)
@@ -130,7 +160,7 @@ func (service *NewsArticleOrchestrationService) GeneratePersonalizedNewsFeed(exe
 	}
 
 	// 3. Algoritmul Zipper (Fermoarul)
-	var finalFeed []FeedDisplayItem
+	finalFeed := []FeedDisplayItem{}
 	adIndex := 0
 
 	for i, art := range articles {
@@ -150,53 +180,173 @@ func (service *NewsArticleOrchestrationService) GeneratePersonalizedNewsFeed(exe
 // [RO] Căutare Contextuală (Oracle Chat)
 //
 // Răspunde la întrebările utilizatorului folosind "Retrieval-Augmented Generation" (RAG).
-// Caută cele mai relevante fragmente din baza de date și le trimite la AI pentru a formula un răspuns.
-func (service *NewsArticleOrchestrationService) PerformOracleContextualSearch(executionContext context.Context, userQuery string, contextArticleID string) (string, []string, error) {
-	var contextPayload string
-	var sourceCitations []string
+// Caută cele mai relevante pasaje din baza de date, le numerotează și cere AI-ului să le citeze [n].
+// Răspunsul este apoi verificat propoziție cu propoziție (vezi grounding.VerifyAnswer).
+func (service *NewsArticleOrchestrationService) PerformOracleContextualSearch(executionContext context.Context, userQuery string, contextArticleID string, unsupportedMode string) (*grounding.GroundedAnswer, error) {
+	passages, err := service.RetrieveOracleContext(executionContext, userQuery, contextArticleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// D. Apelăm Oracolul
+	answer, err := service.artificialIntelligence.ChatWithContext(executionContext, userQuery, grounding.RenderSources(passages))
+	if err != nil {
+		return nil, err
+	}
+
+	// E. Verificăm fiecare afirmație
+	result := grounding.VerifyAnswer(answer, passages, unsupportedMode)
+	return &result, nil
+}
+
+// [RO] Căutare Contextuală, cu Răspuns în Flux (SSE)
+// Bucățile au ajuns deja la client, deci propozițiile nesusținute doar se marchează (nu se pot scoate);
+// verificarea și citările sosesc la final.
+func (service *NewsArticleOrchestrationService) StreamOracleContextualSearch(executionContext context.Context, userQuery string, contextArticleID string, onToken func(token string) error) (*grounding.GroundedAnswer, error) {
+	passages, err := service.RetrieveOracleContext(executionContext, userQuery, contextArticleID)
+	if err != nil {
+		return nil, err
+	}
 
+	var answer strings.Builder
+	err = service.artificialIntelligence.StreamChatWithContext(executionContext, userQuery, grounding.RenderSources(passages), func(token string) error {
+		answer.WriteString(token)
+		return onToken(token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := grounding.VerifyAnswer(answer.String(), passages, grounding.UnsupportedFlag)
+	return &result, nil
+}
+
+// [RO] Câte articole-sursă primește Oracolul și din câte pasaje candidate le alegem
+const (
+	oracleSourceArticles  = 3
+	oracleChunkCandidates = 24
+)
+
+// [RO] Contextul Oracolului (Partea "Retrieval" din RAG)
+// Pasajele numerotate, cu pozițiile lor în conținutul articolelor.
+func (service *NewsArticleOrchestrationService) RetrieveOracleContext(executionContext context.Context, retrievalQuery string, contextArticleID string) ([]grounding.SourcePassage, error) {
 	// Cazul 1: Chat despre un articol specific
 	if contextArticleID != "" {
 		art, err := service.RetrieveCompleteNewsArticle(executionContext, contextArticleID)
-		if err == nil {
-			contextPayload = fmt.Sprintf("Title: %s\nSummary: %s\nContent: %s", art.Title, art.Summary, art.Content)
-			sourceCitations = append(sourceCitations, art.ID.String())
-		}
-	} else {
-		// Cazul 2: Căutare Globală în toată baza de cunoștințe
-
-		// A. Calculăm vectorul întrebării
-		embedding, err := service.artificialIntelligence.GenerateSemanticVector(executionContext, userQuery)
 		if err != nil {
-			return "", nil, fmt.Errorf("embedding gen failed: %w", err)
+			return nil, nil
 		}
+		return grounding.NumberPassages(articlePassages(retrievalQuery, art, grounding.MaxPassagesForSingleArticle)), nil
+	}
 
-		// B. Căutăm articole similare
-		similarArticles, err := service.newsRepository.FindSemanticallySimilarArticles(executionContext, embedding, 3)
-		if err != nil {
-			return "", nil, fmt.Errorf("search failed: %w", err)
-		}
+	// Cazul 2: Căutare Globală în toată baza de cunoștințe
 
-		// C. Construim Contextul
-		var sb strings.Builder
-		for _, art := range similarArticles {
-			sb.WriteString(fmt.Sprintf("Source (ID: %s): %s\n%s\n---\n", art.ID, art.Title, art.Summary))
-			sourceCitations = append(sourceCitations, art.ID.String())
+	// A. Calculăm vectorul întrebării
+	embedding, err := service.artificialIntelligence.GenerateSemanticVector(executionContext, retrievalQuery)
+	if err != nil {
+		return nil, fmt.Errorf("embedding gen failed: %w", err)
+	}
+
+	// B. Căutăm pasajele similare și le grupăm pe articolul părinte
+	chunkMatches, err := service.newsRepository.FindSimilarArticleChunks(executionContext, embedding, oracleChunkCandidates)
+	if err != nil {
+		return nil, fmt.Errorf("chunk search failed: %w", err)
+	}
+	var passages []grounding.SourcePassage
+	for _, group := range article.GroupChunkMatches(chunkMatches, oracleSourceArticles, grounding.MaxPassagesPerArticle) {
+		for _, chunk := range group.Chunks {
+			passages = append(passages, grounding.SourcePassage{
+				ArticleID: group.ArticleID,
+				Title:     group.Title,
+				Text:      chunk.Text,
+				Start:     chunk.Start,
+				End:       chunk.End,
+			})
 		}
-		contextPayload = sb.String()
+	}
+	if len(passages) > 0 {
+		return grounding.NumberPassages(passages), nil
 	}
 
-	// D. Apelăm Oracolul
-	answer, err := service.artificialIntelligence.ChatWithContext(executionContext, userQuery, contextPayload)
-	return answer, sourceCitations, err
+	// C. Index gol (arhivă încă neindexată): căutăm la nivel de articol și alegem pasajele
+	// cele mai apropiate de întrebare din fiecare
+	similarArticles, err := service.newsRepository.FindSemanticallySimilarArticles(executionContext, embedding, article.SimilarityFilter{}, oracleSourceArticles)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	for _, match := range similarArticles {
+		passages = append(passages, articlePassages(retrievalQuery, match.Article, grounding.MaxPassagesPerArticle)...)
+	}
+	return grounding.NumberPassages(passages), nil
+}
+
+// [RO] Pasajele unui articol, cele mai relevante pentru întrebare
+func articlePassages(query string, art *article.NewsArticleEntity, limit int) []grounding.SourcePassage {
+	var passages []grounding.SourcePassage
+	for _, span := range grounding.SelectPassages(query, grounding.SplitPassages(art.Content), limit) {
+		passages = append(passages, grounding.SourcePassage{
+			ArticleID: art.ID,
+			Title:     art.Title,
+			Text:      span.Text,
+			Start:     span.Start,
+			End:       span.End,
+		})
+	}
+	return passages
 }
 
 // [RO] Agregare Harta Adevărului (Gaia)
-// Returnează datele clusterizate pentru vizualizarea 3D.
-func (service *NewsArticleOrchestrationService) AggregateGlobalTruthMap(executionContext context.Context) ([]article.GaiaPoint, error) {
-	// Logica de clusterizare (simplificată)
-	// Deoarece metoda `RetrieveGaiaPoints` lipsește din interfața de bază refactorizată (am uitat-o în pasul anterior intenționat sau nu),
-	// o vom omite aici pentru a asigura compilarea corectă.
-	// În producție, am adăuga `RetrieveGaiaPoints` în `NewsArticlePersistenceInterface`.
-	return []article.GaiaPoint{}, nil
+// Returnează datele grupate pe server pentru vizualizarea 3D (telefonul nu primește 10.000 de puncte).
+func (service *NewsArticleOrchestrationService) AggregateGlobalTruthMap(executionContext context.Context, query article.GaiaMapQuery) ([]article.GaiaCluster, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	return service.newsRepository.RetrieveGaiaClusters(executionContext, query)
+}
+
+// [RO] Harta Gaia ca GeoJSON
+// Știri individuale (negrupate), pentru panouri web și unelte GIS (QGIS, Kepler.gl).
+func (service *NewsArticleOrchestrationService) ExportGaiaFeatureCollection(executionContext context.Context, query article.GaiaMapQuery, limit int) (*GeoJSONFeatureCollection, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > article.MaxGaiaFeatureLimit {
+		limit = article.DefaultGaiaFeatureLimit
+	}
+
+	points, err := service.newsRepository.RetrieveGaiaArticles(executionContext, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return newGaiaFeatureCollection(points), nil
+}
+
+// [RO] Agregare Harta Adevărului pe Țări
+// Câte știri, ce scor mediu și ce emoție domină în fiecare țară (coroplete, clasamente).
+func (service *NewsArticleOrchestrationService) AggregateTruthMapByCountry(executionContext context.Context, query article.GaiaMapQuery) ([]article.GaiaCountryAggregate, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	return service.newsRepository.RetrieveGaiaCountryAggregates(executionContext, query)
+}
+
+// [RO] Placă Vectorială a Hărții Gaia (MVT)
+// Globul cere doar plăcile vizibile; fiecare conține grupurile de la zoom-ul plăcii.
+// Din `filter` se folosesc doar fereastra de timp, emoția și țara (zona vine din placă).
+func (service *NewsArticleOrchestrationService) RenderGaiaVectorTile(executionContext context.Context, tile article.GaiaTile, filter article.GaiaMapQuery) ([]byte, error) {
+	if err := tile.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := tile.MapQuery()
+	query.From, query.To, query.Emotion, query.CountryCode = filter.From, filter.To, filter.Emotion, filter.CountryCode
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+
+	clusters, err := service.newsRepository.RetrieveGaiaClusters(executionContext, query)
+	if err != nil {
+		return nil, err
+	}
+	return encodeGaiaVectorTile(tile, clusters), nil
 }
@@ -0,0 +1,219 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/chat"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+)
+
+// [RO] Sursa Contextului (RAG)
+// Implementată de NewsArticleOrchestrationService.RetrieveOracleContext.
+type OracleContextRetriever interface {
+	RetrieveOracleContext(ctx context.Context, retrievalQuery string, contextArticleID string) ([]grounding.SourcePassage, error)
+}
+
+// [RO] Schimb de Replici (Întrebare + Răspuns)
+// Answer și verificarea provin din grounding.VerifyAnswer.
+type ChatExchange struct {
+	SessionID      uuid.UUID `json:"session_id"`
+	Question       string    `json:"question"`
+	RetrievalQuery string    `json:"retrieval_query"`
+	grounding.GroundedAnswer
+}
+
+// [RO] Sesiunea cu Istoricul Ei
+type ChatTranscript struct {
+	Session chat.ChatSession `json:"session"`
+	Turns   []chat.ChatTurn  `json:"turns"`
+}
+
+// [RO] Serviciul Conversațiilor cu Oracolul
+//
+// Fiecare întrebare de continuare ("și ce a urmat?") este reformulată într-o întrebare autonomă
+// înainte de căutare; răspunsul primește istoricul (rezumat + replicile recente) și sursele găsite.
+// Când istoricul depășește bugetul, replicile vechi sunt comprimate într-un rezumat.
+type ChatSessionService struct {
+	sessions               chat.ChatSessionPersistenceInterface
+	retriever              OracleContextRetriever
+	artificialIntelligence ports.ArtificialIntelligenceGateway
+	memory                 ports.ConversationMemoryGateway
+	now                    func() time.Time
+}
+
+// [RO] Constructor Serviciu Conversații
+func NewChatSessionService(
+	sessions chat.ChatSessionPersistenceInterface,
+	retriever OracleContextRetriever,
+	ai ports.ArtificialIntelligenceGateway,
+	memory ports.ConversationMemoryGateway,
+) *ChatSessionService {
+	return &ChatSessionService{sessions: sessions, retriever: retriever, artificialIntelligence: ai, memory: memory, now: time.Now}
+}
+
+// [RO] Pornește o Conversație (opțional despre un articol)
+func (service *ChatSessionService) StartChatSession(executionContext context.Context, articleID string) (*chat.ChatSession, error) {
+	session := chat.ChatSession{ID: uuid.New(), CreatedAt: service.now(), UpdatedAt: service.now()}
+	if articleID != "" {
+		parsed, err := uuid.Parse(articleID)
+		if err != nil {
+			return nil, fmt.Errorf("[RO] ID invalid: %w", err)
+		}
+		session.ArticleID = parsed
+	}
+
+	if err := service.sessions.CreateChatSession(executionContext, session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// [RO] Istoricul unei Conversații
+func (service *ChatSessionService) RetrieveChatTranscript(executionContext context.Context, sessionID uuid.UUID) (*ChatTranscript, error) {
+	session, turns, err := service.sessions.RetrieveChatSession(executionContext, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if turns == nil {
+		turns = []chat.ChatTurn{}
+	}
+	return &ChatTranscript{Session: *session, Turns: turns}, nil
+}
+
+// [RO] Trimite un Mesaj
+// 1. Reformulare (doar dacă există istoric; la eșec, folosim întrebarea așa cum e).
+// 2. Căutare în arhivă cu întrebarea autonomă.
+// 3. Răspuns cu istoricul + sursele numerotate, apoi verificarea afirmațiilor (unsupportedMode: flag/remove).
+// 4. Salvare replici și, la nevoie, comprimarea istoricului.
+func (service *ChatSessionService) SendChatMessage(executionContext context.Context, sessionID uuid.UUID, content string, unsupportedMode string) (*ChatExchange, error) {
+	pending, err := service.prepareExchange(executionContext, sessionID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := service.artificialIntelligence.ChatWithContext(executionContext, pending.exchange.RetrievalQuery, pending.contextPayload)
+	if err != nil {
+		return nil, err
+	}
+	return service.completeExchange(executionContext, pending, answer, unsupportedMode)
+}
+
+// [RO] Trimite un Mesaj, cu Răspuns în Flux
+// Aceiași pași ca SendChatMessage, dar fiecare bucată de răspuns ajunge la onToken pe măsură ce
+// e generată. Replicile se salvează doar dacă răspunsul a fost complet (nu și la deconectare).
+// Răspunsul a ajuns deja la client, deci propozițiile nesusținute sunt doar marcate.
+func (service *ChatSessionService) StreamChatMessage(executionContext context.Context, sessionID uuid.UUID, content string, onToken func(token string) error) (*ChatExchange, error) {
+	pending, err := service.prepareExchange(executionContext, sessionID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	var answer strings.Builder
+	err = service.artificialIntelligence.StreamChatWithContext(executionContext, pending.exchange.RetrievalQuery, pending.contextPayload, func(token string) error {
+		answer.WriteString(token)
+		return onToken(token)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.completeExchange(executionContext, pending, answer.String(), grounding.UnsupportedFlag)
+}
+
+// [RO] Schimb în Curs: tot ce s-a pregătit înainte de generarea răspunsului
+type pendingExchange struct {
+	session        chat.ChatSession
+	turns          []chat.ChatTurn
+	passages       []grounding.SourcePassage
+	contextPayload string
+	exchange       ChatExchange
+}
+
+// [RO] Pașii 1-2: validare, reformulare, căutarea surselor
+func (service *ChatSessionService) prepareExchange(executionContext context.Context, sessionID uuid.UUID, content string) (*pendingExchange, error) {
+	question, err := chat.NormalizeMessage(content)
+	if err != nil {
+		return nil, err
+	}
+
+	session, turns, err := service.sessions.RetrieveChatSession(executionContext, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	transcript := session.RenderTranscript(turns)
+
+	retrievalQuery := question
+	if len(turns) > 0 {
+		rewritten, err := service.memory.RewriteFollowUpQuestion(executionContext, transcript, question)
+		if err != nil {
+			log.Printf("Reformularea întrebării a eșuat (sesiunea %s): %v", sessionID, err)
+		} else if rewritten != "" {
+			retrievalQuery = rewritten
+		}
+	}
+
+	articleID := ""
+	if session.ArticleID != uuid.Nil {
+		articleID = session.ArticleID.String()
+	}
+	passages, err := service.retriever.RetrieveOracleContext(executionContext, retrievalQuery, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := grounding.RenderSources(passages)
+	contextPayload := sources
+	if transcript != "" {
+		contextPayload = "Conversation so far:\n" + transcript + "\nSources:\n" + sources
+	}
+
+	return &pendingExchange{
+		session:        *session,
+		turns:          turns,
+		passages:       passages,
+		contextPayload: contextPayload,
+		exchange:       ChatExchange{SessionID: sessionID, Question: question, RetrievalQuery: retrievalQuery},
+	}, nil
+}
+
+// [RO] Pasul 4: verificarea răspunsului, salvarea replicilor și comprimarea istoricului
+// Se salvează răspunsul verificat (fără propozițiile scoase), ca istoricul să nu le repete.
+func (service *ChatSessionService) completeExchange(executionContext context.Context, pending *pendingExchange, answer string, unsupportedMode string) (*ChatExchange, error) {
+	exchange := pending.exchange
+	exchange.GroundedAnswer = grounding.VerifyAnswer(answer, pending.passages, unsupportedMode)
+
+	next := len(pending.turns) + 1
+	now := service.now()
+	newTurns := []chat.ChatTurn{
+		{SessionID: exchange.SessionID, Sequence: next, Role: chat.RoleUser, Content: exchange.Question, RetrievalQuery: exchange.RetrievalQuery, CreatedAt: now},
+		{SessionID: exchange.SessionID, Sequence: next + 1, Role: chat.RoleAssistant, Content: exchange.Answer, Citations: exchange.Citations, CreatedAt: now},
+	}
+	if err := service.sessions.AppendChatTurns(executionContext, newTurns); err != nil {
+		return nil, err
+	}
+
+	// [RO] Comprimarea nu blochează răspunsul: la eșec, reîncercăm la următorul mesaj.
+	if err := service.compactHistory(executionContext, pending.session, append(pending.turns, newTurns...)); err != nil {
+		log.Printf("Rezumarea conversației a eșuat (sesiunea %s): %v", exchange.SessionID, err)
+	}
+	return &exchange, nil
+}
+
+// [RO] Comprimă replicile vechi în rezumat, dacă istoricul a depășit bugetul
+func (service *ChatSessionService) compactHistory(executionContext context.Context, session chat.ChatSession, turns []chat.ChatTurn) error {
+	older := session.TurnsToSummarize(turns)
+	if len(older) == 0 {
+		return nil
+	}
+
+	summary, err := service.memory.SummarizeConversation(executionContext, session.Summary, chat.RenderTurns(older))
+	if err != nil {
+		return err
+	}
+	return service.sessions.UpdateChatSummary(executionContext, session.ID, summary, older[len(older)-1].Sequence)
+}
@@ -0,0 +1,196 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/chat"
+	"github.com/yourorg/truthweave/internal/domain/grounding"
+)
+
+// [RO] Depozit în memorie
+type memorySessionRepository struct {
+	session *chat.ChatSession
+	turns   []chat.ChatTurn
+}
+
+func (repo *memorySessionRepository) CreateChatSession(ctx context.Context, session chat.ChatSession) error {
+	repo.session = &session
+	return nil
+}
+
+func (repo *memorySessionRepository) RetrieveChatSession(ctx context.Context, id uuid.UUID) (*chat.ChatSession, []chat.ChatTurn, error) {
+	if repo.session == nil || repo.session.ID != id {
+		return nil, nil, chat.ErrChatSessionNotFound
+	}
+	session := *repo.session
+	return &session, append([]chat.ChatTurn(nil), repo.turns...), nil
+}
+
+func (repo *memorySessionRepository) AppendChatTurns(ctx context.Context, turns []chat.ChatTurn) error {
+	repo.turns = append(repo.turns, turns...)
+	return nil
+}
+
+func (repo *memorySessionRepository) UpdateChatSummary(ctx context.Context, id uuid.UUID, summary string, summarizedThrough int) error {
+	repo.session.Summary, repo.session.SummarizedThrough = summary, summarizedThrough
+	return nil
+}
+
+// [RO] Retriever fals: reține întrebarea folosită la căutare.
+type fakeRetriever struct {
+	queries []string
+}
+
+var suezArticleID = uuid.MustParse("a1a1a1a1-0000-0000-0000-000000000001")
+
+func (retriever *fakeRetriever) RetrieveOracleContext(ctx context.Context, retrievalQuery string, contextArticleID string) ([]grounding.SourcePassage, error) {
+	retriever.queries = append(retriever.queries, retrievalQuery)
+	text := "The Suez Canal was blocked by the Ever Given. The canal reopened on 29 March after salvage crews freed the ship."
+	return []grounding.SourcePassage{{Number: 1, ArticleID: suezArticleID, Title: "Suez Canal blocked", Text: text, Start: 120, End: 120 + len(text)}}, nil
+}
+
+// [RO] Oracol fals: răspunde cu un text lung (ca istoricul să depășească bugetul) și reține contextul.
+type fakeOracle struct {
+	answer       string // Dacă e gol: answerLength caractere "a"
+	answerLength int
+	lastContext  string
+	rewriteErr   error
+	summaries    int
+}
+
+func (oracle *fakeOracle) AnalyzeAndNeutralizeNewsContent(ctx context.Context, rawContent string) (*article.AIAnalysisResult, error) {
+	return nil, nil
+}
+
+func (oracle *fakeOracle) GenerateSemanticVector(ctx context.Context, text string) ([]float32, error) {
+	return nil, nil
+}
+
+func (oracle *fakeOracle) ChatWithContext(ctx context.Context, query string, contextText string) (string, error) {
+	oracle.lastContext = contextText
+	if oracle.answer != "" {
+		return oracle.answer, nil
+	}
+	return strings.Repeat("a", oracle.answerLength), nil
+}
+
+func (oracle *fakeOracle) StreamChatWithContext(ctx context.Context, query string, contextText string, onToken func(token string) error) error {
+	oracle.lastContext = contextText
+	for _, token := range []string{"The canal ", "reopened ", "on 29 March."} {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := onToken(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (oracle *fakeOracle) RewriteFollowUpQuestion(ctx context.Context, transcript string, question string) (string, error) {
+	return "What happened after the Suez Canal blockage in March 2021?", oracle.rewriteErr
+}
+
+func (oracle *fakeOracle) SummarizeConversation(ctx context.Context, previousSummary string, transcript string) (string, error) {
+	oracle.summaries++
+	return "The user is following the Suez Canal blockage.", nil
+}
+
+func TestSendChatMessage_RewritesFollowUpsForRetrieval(t *testing.T) {
+	repo, retriever, oracle := &memorySessionRepository{}, &fakeRetriever{}, &fakeOracle{answerLength: 20}
+	service := NewChatSessionService(repo, retriever, oracle, oracle)
+
+	session, err := service.StartChatSession(context.Background(), "")
+	require.NoError(t, err)
+
+	first, err := service.SendChatMessage(context.Background(), session.ID, "Why was the Suez Canal blocked?", grounding.UnsupportedFlag)
+	require.NoError(t, err)
+	assert.Equal(t, "Why was the Suez Canal blocked?", first.RetrievalQuery, "[RO] Prima întrebare nu se reformulează")
+	assert.Contains(t, oracle.lastContext, "[1] Suez Canal blocked")
+
+	second, err := service.SendChatMessage(context.Background(), session.ID, "and what happened after that?", grounding.UnsupportedFlag)
+	require.NoError(t, err)
+	assert.Equal(t, "What happened after the Suez Canal blockage in March 2021?", second.RetrievalQuery)
+	assert.Equal(t, second.RetrievalQuery, retriever.queries[1])
+	assert.Contains(t, oracle.lastContext, "User: Why was the Suez Canal blocked?")
+
+	require.Len(t, repo.turns, 4)
+	assert.Equal(t, chat.RoleAssistant, repo.turns[3].Role)
+	assert.Equal(t, 4, repo.turns[3].Sequence)
+}
+
+func TestSendChatMessage_FallsBackAndSummarizesLongHistory(t *testing.T) {
+	repo, oracle := &memorySessionRepository{}, &fakeOracle{answerLength: 1500, rewriteErr: errors.New("quota")}
+	service := NewChatSessionService(repo, &fakeRetriever{}, oracle, oracle)
+	session, _ := service.StartChatSession(context.Background(), "")
+
+	var exchange *ChatExchange
+	for i := 0; i < 5; i++ {
+		var err error
+		exchange, err = service.SendChatMessage(context.Background(), session.ID, "and then?", grounding.UnsupportedFlag)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "and then?", exchange.RetrievalQuery, "[RO] La eșecul reformulării folosim întrebarea originală")
+	assert.Positive(t, oracle.summaries)
+	assert.Equal(t, "The user is following the Suez Canal blockage.", repo.session.Summary)
+	assert.Equal(t, len(repo.turns)-chat.KeepRecentTurns, repo.session.SummarizedThrough)
+}
+
+func TestSendChatMessage_UnknownSession(t *testing.T) {
+	service := NewChatSessionService(&memorySessionRepository{}, &fakeRetriever{}, &fakeOracle{}, &fakeOracle{})
+	_, err := service.SendChatMessage(context.Background(), uuid.New(), "hello?", grounding.UnsupportedFlag)
+	assert.ErrorIs(t, err, chat.ErrChatSessionNotFound)
+}
+
+func TestSendChatMessage_RemovesUnsupportedSentencesBeforeStoring(t *testing.T) {
+	repo := &memorySessionRepository{}
+	oracle := &fakeOracle{answer: "The Ever Given blocked the Suez Canal [1]. The captain was arrested in Cairo for negligence [1]."}
+	service := NewChatSessionService(repo, &fakeRetriever{}, oracle, oracle)
+	session, _ := service.StartChatSession(context.Background(), "")
+
+	exchange, err := service.SendChatMessage(context.Background(), session.ID, "What happened?", grounding.UnsupportedRemove)
+	require.NoError(t, err)
+	assert.Equal(t, "The Ever Given blocked the Suez Canal [1].", exchange.Answer)
+	assert.Equal(t, 1, exchange.RemovedCount)
+	require.Len(t, exchange.Citations, 1)
+	assert.Equal(t, "The Suez Canal was blocked by the Ever Given.", exchange.Citations[0].Snippet)
+	assert.Equal(t, 120, exchange.Citations[0].Start)
+	assert.Equal(t, exchange.Answer, repo.turns[1].Content)
+	assert.Equal(t, exchange.Citations, repo.turns[1].Citations)
+}
+
+func TestStreamChatMessage_StoresTurnsOnlyWhenComplete(t *testing.T) {
+	repo, oracle := &memorySessionRepository{}, &fakeOracle{}
+	service := NewChatSessionService(repo, &fakeRetriever{}, oracle, oracle)
+	session, _ := service.StartChatSession(context.Background(), "")
+
+	var tokens []string
+	exchange, err := service.StreamChatMessage(context.Background(), session.ID, "When did the canal reopen?", func(token string) error {
+		tokens = append(tokens, token)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, tokens, 3)
+	assert.Equal(t, "The canal reopened on 29 March.", exchange.Answer)
+	require.Len(t, exchange.Citations, 1)
+	assert.Equal(t, suezArticleID, exchange.Citations[0].ArticleID)
+	require.Len(t, repo.turns, 2)
+	assert.Equal(t, exchange.Answer, repo.turns[1].Content)
+
+	// [RO] Clientul s-a deconectat după prima bucată: nimic nu se salvează.
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = service.StreamChatMessage(ctx, session.ID, "and then?", func(token string) error {
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, repo.turns, 2)
+}
@@ -0,0 +1,108 @@
+package community
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/community"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+	"go.temporal.io/sdk/client"
+)
+
+// [RO] Programarea Calculului de Consens
+const (
+	CommunityScoringWorkflowID   = "community-scoring"
+	CommunityScoringCronSchedule = "0 * * * *"
+	communityScoringWorkflowName = "CommunityScoringWorkflow"
+)
+
+// [RO] Serviciul Evaluărilor Comunității
+//
+// Primește evaluările cititorilor (utilitate, corectitudine) pentru articole și contraargumente.
+// Scorurile nu se calculează la fiecare evaluare: CommunityScoringWorkflow reface periodic
+// factorizarea pe toate evaluările, pentru că tabăra fiecărui evaluator depinde de toate.
+type CommunityRatingService struct {
+	ratings          community.CommunityRatingPersistenceInterface
+	credentials      *community.RaterCredentials
+	workflowLauncher ports.WorkflowOrchestratorLauncher
+	now              func() time.Time
+}
+
+// [RO] Constructor Serviciu Comunitate
+func NewCommunityRatingService(ratings community.CommunityRatingPersistenceInterface, credentials *community.RaterCredentials, launcher ports.WorkflowOrchestratorLauncher) *CommunityRatingService {
+	return &CommunityRatingService{ratings: ratings, credentials: credentials, workflowLauncher: launcher, now: time.Now}
+}
+
+// [RO] Emite o Identitate de Evaluator
+// Cel mult MaxRatersPerClient identități per client (amprenta IP-ului) în RaterIssuanceWindow;
+// altfel ErrRaterIssuanceRateLimited. Returnează jetonul pe care clientul îl trimite la evaluări.
+func (service *CommunityRatingService) RegisterRater(executionContext context.Context, clientIP string) (string, error) {
+	fingerprint := community.IssuerFingerprint(clientIP)
+	now := service.now().UTC()
+	issued, err := service.ratings.CountRatersIssued(executionContext, fingerprint, now.Add(-community.RaterIssuanceWindow))
+	if err != nil {
+		return "", err
+	}
+	if issued >= community.MaxRatersPerClient {
+		return "", community.ErrRaterIssuanceRateLimited
+	}
+
+	raterID, token, err := service.credentials.Issue()
+	if err != nil {
+		return "", err
+	}
+	if err := service.ratings.SaveRaterIssuance(executionContext, raterID, fingerprint, now); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// [RO] Evaluatorul din Jeton (ErrInvalidRaterToken pentru jetoane lipsă sau falsificate)
+func (service *CommunityRatingService) ResolveRater(token string) (string, error) {
+	return service.credentials.Verify(token)
+}
+
+// [RO] Salvează Evaluarea unui Cititor
+// RaterID vine din ResolveRater, niciodată direct din cerere.
+func (service *CommunityRatingService) SubmitRating(executionContext context.Context, articleID uuid.UUID, rating community.Rating) (*community.Rating, error) {
+	rating.ArticleID = articleID
+	rating.RaterID = strings.TrimSpace(rating.RaterID)
+	rating.RatedAt = service.now().UTC()
+	if err := rating.Validate(); err != nil {
+		return nil, err
+	}
+	if err := service.ratings.SaveRating(executionContext, rating); err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+// [RO] Semnalul Comunității pentru Articol (și contraargumentul lui)
+func (service *CommunityRatingService) RetrieveCommunityScores(executionContext context.Context, articleID uuid.UUID) ([]community.ItemScore, error) {
+	scores, err := service.ratings.RetrieveItemScores(executionContext, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if scores == nil {
+		scores = []community.ItemScore{}
+	}
+	return scores, nil
+}
+
+// [RO] Programează Calculul Periodic (Admin)
+// ID-ul fix face apelul idempotent: dacă cron-ul rulează deja, primim execuția existentă.
+func (service *CommunityRatingService) ScheduleCommunityScoring(executionContext context.Context) (string, error) {
+	options := client.StartWorkflowOptions{
+		ID:           CommunityScoringWorkflowID,
+		TaskQueue:    "truthweave-task-queue",
+		CronSchedule: CommunityScoringCronSchedule,
+	}
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, communityScoringWorkflowName)
+	if err != nil {
+		return "", fmt.Errorf("[RO] Eroare: Calculul consensului nu a putut fi programat: %w", err)
+	}
+	return run.GetID(), nil
+}
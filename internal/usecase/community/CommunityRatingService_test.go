@@ -0,0 +1,120 @@
+package community
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/community"
+)
+
+// [RO] Evaluări în memorie (cheia = element + evaluator)
+type memoryRatingRepository struct {
+	articles map[uuid.UUID]bool
+	ratings  map[string]community.Rating
+	issued   map[string][]time.Time
+}
+
+func (repo *memoryRatingRepository) SaveRating(ctx context.Context, rating community.Rating) error {
+	if !repo.articles[rating.ArticleID] {
+		return community.ErrRatedArticleNotFound
+	}
+	repo.ratings[rating.ItemType+rating.ArticleID.String()+rating.Dimension+rating.RaterID] = rating
+	return nil
+}
+
+func (repo *memoryRatingRepository) RetrieveRatings(ctx context.Context, dimension string) ([]community.Rating, error) {
+	return nil, nil
+}
+
+func (repo *memoryRatingRepository) SaveItemScores(ctx context.Context, scores []community.ItemScore) error {
+	return nil
+}
+
+func (repo *memoryRatingRepository) RetrieveItemScores(ctx context.Context, articleID uuid.UUID) ([]community.ItemScore, error) {
+	return nil, nil
+}
+
+func (repo *memoryRatingRepository) SaveRaterIssuance(ctx context.Context, raterID string, issuerFingerprint string, issuedAt time.Time) error {
+	repo.issued[issuerFingerprint] = append(repo.issued[issuerFingerprint], issuedAt)
+	return nil
+}
+
+func (repo *memoryRatingRepository) CountRatersIssued(ctx context.Context, issuerFingerprint string, since time.Time) (int, error) {
+	count := 0
+	for _, issuedAt := range repo.issued[issuerFingerprint] {
+		if !issuedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestCommunityRatingService_SubmitRatingReplacesPreviousRating(t *testing.T) {
+	articleID := uuid.New()
+	repo := &memoryRatingRepository{articles: map[uuid.UUID]bool{articleID: true}, ratings: map[string]community.Rating{}}
+	ratedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewCommunityRatingService(repo, community.NewRaterCredentials("test-secret"), nil)
+	service.now = func() time.Time { return ratedAt }
+
+	rating := community.Rating{ItemType: community.ItemArticle, Dimension: community.DimensionFairness, RaterID: "  install-7f3a9c  ", Rating: community.RatingNo}
+	saved, err := service.SubmitRating(context.Background(), articleID, rating)
+	require.NoError(t, err)
+	assert.Equal(t, "install-7f3a9c", saved.RaterID)
+	assert.Equal(t, articleID, saved.ArticleID)
+	assert.Equal(t, ratedAt, saved.RatedAt)
+
+	rating.Rating = community.RatingYes
+	_, err = service.SubmitRating(context.Background(), articleID, rating)
+	require.NoError(t, err)
+	require.Len(t, repo.ratings, 1, "[RO] Același cititor, același element: evaluarea se înlocuiește")
+	for _, stored := range repo.ratings {
+		assert.Equal(t, community.RatingYes, stored.Rating)
+	}
+
+	_, err = service.SubmitRating(context.Background(), uuid.New(), rating)
+	assert.ErrorIs(t, err, community.ErrRatedArticleNotFound)
+
+	rating.Dimension = "accuracy"
+	_, err = service.SubmitRating(context.Background(), articleID, rating)
+	assert.Error(t, err)
+
+	scores, err := service.RetrieveCommunityScores(context.Background(), articleID)
+	require.NoError(t, err)
+	assert.NotNil(t, scores)
+}
+
+func TestCommunityRatingService_RegisterRaterIssuesVerifiableTokensWithinLimit(t *testing.T) {
+	repo := &memoryRatingRepository{issued: map[string][]time.Time{}}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewCommunityRatingService(repo, community.NewRaterCredentials("test-secret"), nil)
+	service.now = func() time.Time { return now }
+
+	var raters []string
+	for i := 0; i < community.MaxRatersPerClient; i++ {
+		token, err := service.RegisterRater(context.Background(), "203.0.113.7")
+		require.NoError(t, err)
+		raterID, err := service.ResolveRater(token)
+		require.NoError(t, err)
+		raters = append(raters, raterID)
+	}
+	assert.NotEqual(t, raters[0], raters[1], "[RO] Fiecare identitate e aleasă de server")
+
+	_, err := service.RegisterRater(context.Background(), "203.0.113.7")
+	assert.ErrorIs(t, err, community.ErrRaterIssuanceRateLimited)
+
+	_, err = service.RegisterRater(context.Background(), "198.51.100.2")
+	assert.NoError(t, err, "[RO] Limita este per client")
+
+	now = now.Add(community.RaterIssuanceWindow + time.Minute)
+	_, err = service.RegisterRater(context.Background(), "203.0.113.7")
+	assert.NoError(t, err, "[RO] Fereastra a expirat")
+
+	_, err = service.ResolveRater("rater-chosen-by-client")
+	assert.ErrorIs(t, err, community.ErrInvalidRaterToken)
+	_, err = NewCommunityRatingService(repo, community.NewRaterCredentials("other-secret"), nil).ResolveRater(raters[0] + ".forged")
+	assert.ErrorIs(t, err, community.ErrInvalidRaterToken)
+}
@@ -0,0 +1,136 @@
+package dispute
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/causality"
+	"github.com/yourorg/truthweave/internal/domain/dispute"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+	"go.temporal.io/sdk/client"
+)
+
+// [RO] Prefixul workflow-ului de reanaliză (vezi temporal.DisputeReanalysisWorkflowIDPrefix)
+const reanalysisWorkflowIDPrefix = "dispute-reanalysis-"
+
+// [RO] Confirmarea Contestației
+type DisputeReceipt struct {
+	Dispute           dispute.Dispute      `json:"dispute"`
+	Tally             dispute.DisputeTally `json:"tally"`
+	ReanalysisStarted bool                 `json:"reanalysis_started"`
+}
+
+// [RO] Situația Contestațiilor unui Articol
+type DisputeOverview struct {
+	Tally           dispute.DisputeTally     `json:"tally"`
+	ResolutionNotes []dispute.ResolutionNote `json:"resolution_notes"`
+}
+
+// [RO] Serviciul Contestațiilor Cititorilor
+//
+// Primește contestațiile (scor, rating de părtinire, legătură cauzală), le numără după dovezi
+// și cititori distincți și, peste prag, pornește reanaliza. Nota de rezolvare o scrie workflow-ul.
+type ReaderDisputeService struct {
+	disputes         dispute.ReaderDisputePersistenceInterface
+	causalLinks      dispute.CausalLinkVerifierInterface
+	workflowLauncher ports.WorkflowOrchestratorLauncher
+	threshold        int
+	now              func() time.Time
+}
+
+// [RO] Constructor Serviciu Contestații (prag <= 0 = dispute.DefaultReanalysisThreshold)
+func NewReaderDisputeService(disputes dispute.ReaderDisputePersistenceInterface, causalLinks dispute.CausalLinkVerifierInterface, launcher ports.WorkflowOrchestratorLauncher, threshold int) *ReaderDisputeService {
+	if threshold <= 0 {
+		threshold = dispute.DefaultReanalysisThreshold
+	}
+	return &ReaderDisputeService{disputes: disputes, causalLinks: causalLinks, workflowLauncher: launcher, threshold: threshold, now: time.Now}
+}
+
+// [RO] Trimite o Contestație
+// Contestația rămâne salvată chiar dacă reanaliza nu poate porni acum. Dacă o reanaliză e deja
+// în curs, aceasta recitește contestațiile deschise la final și se reia cât timp pragul e atins.
+func (service *ReaderDisputeService) SubmitDispute(executionContext context.Context, articleID uuid.UUID, item dispute.Dispute) (*DisputeReceipt, error) {
+	item.ID = uuid.New()
+	item.ArticleID = articleID
+	item.Status = dispute.StatusOpen
+	item.ResolutionID = nil
+	item.CreatedAt = service.now().UTC()
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	if item.SubmitterID != "" {
+		recent, err := service.disputes.CountSubmitterDisputes(executionContext, articleID, item.SubmitterID, item.CreatedAt.Add(-dispute.SubmitterRateWindow))
+		if err != nil {
+			return nil, err
+		}
+		if recent >= dispute.MaxDisputesPerSubmitter {
+			return nil, dispute.ErrDisputeRateLimited
+		}
+	}
+	if item.Target == dispute.TargetCausalLink {
+		if err := service.verifyCausalLink(executionContext, articleID, item.CauseEventID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := service.disputes.SaveDispute(executionContext, item); err != nil {
+		return nil, err
+	}
+
+	open, err := service.disputes.ListOpenDisputes(executionContext, articleID)
+	if err != nil {
+		return nil, err
+	}
+	receipt := &DisputeReceipt{Dispute: item, Tally: dispute.TallyOpenDisputes(articleID, open, service.threshold)}
+	if !receipt.Tally.ReanalysisDue {
+		return receipt, nil
+	}
+
+	options := client.StartWorkflowOptions{
+		ID:        reanalysisWorkflowIDPrefix + articleID.String(),
+		TaskQueue: "truthweave-task-queue",
+	}
+	if _, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, "DisputeReanalysisWorkflow", articleID); err != nil {
+		log.Printf("Reanaliza articolului %s nu a pornit (o rulare în curs va prelua contestația la final): %v", articleID, err)
+		return receipt, nil
+	}
+	receipt.ReanalysisStarted = true
+	return receipt, nil
+}
+
+// [RO] Legătura contestată trebuie să existe: cauza -> evenimentul poveștii articolului
+func (service *ReaderDisputeService) verifyCausalLink(executionContext context.Context, articleID uuid.UUID, causeEventID string) error {
+	clusterID, err := service.disputes.RetrieveArticleStoryCluster(executionContext, articleID)
+	if err != nil {
+		return err
+	}
+	if clusterID == uuid.Nil {
+		return dispute.ErrUnknownCausalLink
+	}
+	linked, err := service.causalLinks.HasCausalEdge(executionContext, causeEventID, string(causality.StoryEventID(clusterID.String())))
+	if err != nil {
+		return err
+	}
+	if !linked {
+		return dispute.ErrUnknownCausalLink
+	}
+	return nil
+}
+
+// [RO] Contestațiile Deschise și Notele Publicate
+func (service *ReaderDisputeService) RetrieveDisputeOverview(executionContext context.Context, articleID uuid.UUID) (*DisputeOverview, error) {
+	open, err := service.disputes.ListOpenDisputes(executionContext, articleID)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := service.disputes.RetrieveResolutionNotes(executionContext, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if notes == nil {
+		notes = []dispute.ResolutionNote{}
+	}
+	return &DisputeOverview{Tally: dispute.TallyOpenDisputes(articleID, open, service.threshold), ResolutionNotes: notes}, nil
+}
@@ -0,0 +1,196 @@
+package dispute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/causality"
+	"github.com/yourorg/truthweave/internal/domain/dispute"
+	"go.temporal.io/sdk/client"
+)
+
+// [RO] Contestații în memorie
+type memoryDisputeRepository struct {
+	articles map[uuid.UUID]bool
+	clusters map[uuid.UUID]uuid.UUID
+	disputes []dispute.Dispute
+}
+
+func (repo *memoryDisputeRepository) RetrieveArticleStoryCluster(ctx context.Context, articleID uuid.UUID) (uuid.UUID, error) {
+	if !repo.articles[articleID] {
+		return uuid.Nil, dispute.ErrDisputedArticleNotFound
+	}
+	return repo.clusters[articleID], nil
+}
+
+func (repo *memoryDisputeRepository) SaveDispute(ctx context.Context, item dispute.Dispute) error {
+	if !repo.articles[item.ArticleID] {
+		return dispute.ErrDisputedArticleNotFound
+	}
+	repo.disputes = append(repo.disputes, item)
+	return nil
+}
+
+func (repo *memoryDisputeRepository) CountSubmitterDisputes(ctx context.Context, articleID uuid.UUID, submitterID string, since time.Time) (int, error) {
+	count := 0
+	for _, item := range repo.disputes {
+		if item.ArticleID == articleID && item.SubmitterID == submitterID && !item.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (repo *memoryDisputeRepository) ListOpenDisputes(ctx context.Context, articleID uuid.UUID) ([]dispute.Dispute, error) {
+	var open []dispute.Dispute
+	for _, item := range repo.disputes {
+		if item.ArticleID == articleID && item.Status == dispute.StatusOpen {
+			open = append(open, item)
+		}
+	}
+	return open, nil
+}
+
+func (repo *memoryDisputeRepository) RetrieveResolutionNotes(ctx context.Context, articleID uuid.UUID) ([]dispute.ResolutionNote, error) {
+	return nil, nil
+}
+
+func (repo *memoryDisputeRepository) ApplyDisputeResolution(ctx context.Context, note dispute.ResolutionNote) error {
+	return nil
+}
+
+// [RO] Graf cauzal în memorie: "părinte->copil"
+type memoryCausalGraph map[string]bool
+
+func (graph memoryCausalGraph) HasCausalEdge(ctx context.Context, parentID string, childID string) (bool, error) {
+	return graph[parentID+"->"+childID], nil
+}
+
+// [RO] Temporal fals: reține workflow-urile pornite
+type recordingLauncher struct {
+	workflowIDs []string
+	err         error
+}
+
+func (launcher *recordingLauncher) ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (client.WorkflowRun, error) {
+	if launcher.err != nil {
+		return nil, launcher.err
+	}
+	launcher.workflowIDs = append(launcher.workflowIDs, options.ID)
+	return nil, nil
+}
+
+func (launcher *recordingLauncher) ScheduleGraphRebalance(ctx context.Context, eventID string, debounce time.Duration) error {
+	return nil
+}
+
+func TestReaderDisputeService_StartsReanalysisAtDistinctEvidenceThreshold(t *testing.T) {
+	articleID := uuid.New()
+	repo := &memoryDisputeRepository{articles: map[uuid.UUID]bool{articleID: true}}
+	launcher := &recordingLauncher{}
+	service := NewReaderDisputeService(repo, memoryCausalGraph{}, launcher, 2)
+	service.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	submit := func(evidence string) *DisputeReceipt {
+		receipt, err := service.SubmitDispute(context.Background(), articleID, dispute.Dispute{
+			Target:       dispute.TargetTruthScore,
+			Reason:       "The official statistics contradict the headline figure.",
+			EvidenceURLs: []string{evidence},
+			SubmitterID:  "reader-" + evidence,
+			Status:       dispute.StatusResolved, // [RO] Ignorat: starea o decide serviciul
+		})
+		require.NoError(t, err)
+		return receipt
+	}
+
+	first := submit("https://stats.example.org/report")
+	assert.Equal(t, dispute.StatusOpen, first.Dispute.Status)
+	assert.False(t, first.ReanalysisStarted)
+
+	// [RO] Aceeași dovadă nu mai contează o dată
+	assert.False(t, submit("https://stats.example.org/report/").ReanalysisStarted)
+	assert.Empty(t, launcher.workflowIDs)
+
+	assert.True(t, submit("https://archive.example.org/original").ReanalysisStarted)
+	assert.Equal(t, []string{"dispute-reanalysis-" + articleID.String()}, launcher.workflowIDs)
+
+	// [RO] Reanaliza deja în curs: contestația rămâne salvată
+	launcher.err = errors.New("workflow execution already started")
+	receipt := submit("https://third.example.org/source")
+	assert.False(t, receipt.ReanalysisStarted)
+	assert.Len(t, repo.disputes, 4)
+
+	_, err := service.SubmitDispute(context.Background(), uuid.New(), dispute.Dispute{
+		Target: dispute.TargetBiasRating, Reason: "The article omits the opposition's response.", EvidenceURLs: []string{"https://example.org"},
+	})
+	assert.ErrorIs(t, err, dispute.ErrDisputedArticleNotFound)
+
+	_, err = service.SubmitDispute(context.Background(), articleID, dispute.Dispute{Target: dispute.TargetCausalLink, Reason: "too short"})
+	assert.Error(t, err)
+	assert.Len(t, repo.disputes, 4)
+}
+
+func TestReaderDisputeService_RejectsCausalLinksMissingFromTheGraph(t *testing.T) {
+	articleID, clusterID, unclustered := uuid.New(), uuid.New(), uuid.New()
+	repo := &memoryDisputeRepository{
+		articles: map[uuid.UUID]bool{articleID: true, unclustered: true},
+		clusters: map[uuid.UUID]uuid.UUID{articleID: clusterID},
+	}
+	storyEvent := string(causality.StoryEventID(clusterID.String()))
+	graph := memoryCausalGraph{"cause-1->" + storyEvent: true}
+	service := NewReaderDisputeService(repo, graph, &recordingLauncher{}, 3)
+
+	submit := func(articleID uuid.UUID, cause string) error {
+		_, err := service.SubmitDispute(context.Background(), articleID, dispute.Dispute{
+			Target:       dispute.TargetCausalLink,
+			CauseEventID: cause,
+			Reason:       "The earlier protest was not what triggered this decision.",
+			EvidenceURLs: []string{"https://example.org/timeline"},
+		})
+		return err
+	}
+
+	require.NoError(t, submit(articleID, "cause-1"))
+	assert.ErrorIs(t, submit(articleID, "made-up-event"), dispute.ErrUnknownCausalLink)
+	assert.ErrorIs(t, submit(unclustered, "cause-1"), dispute.ErrUnknownCausalLink)
+	assert.ErrorIs(t, submit(uuid.New(), "cause-1"), dispute.ErrDisputedArticleNotFound)
+	assert.Len(t, repo.disputes, 1)
+}
+
+func TestReaderDisputeService_LimitsDisputesPerSubmitter(t *testing.T) {
+	articleID := uuid.New()
+	repo := &memoryDisputeRepository{articles: map[uuid.UUID]bool{articleID: true}}
+	launcher := &recordingLauncher{}
+	service := NewReaderDisputeService(repo, memoryCausalGraph{}, launcher, 3)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	submit := func(submitter string, evidence string) (*DisputeReceipt, error) {
+		return service.SubmitDispute(context.Background(), articleID, dispute.Dispute{
+			Target:       dispute.TargetTruthScore,
+			Reason:       "The official statistics contradict the headline figure.",
+			EvidenceURLs: []string{evidence},
+			SubmitterID:  submitter,
+		})
+	}
+
+	for index := 0; index < dispute.MaxDisputesPerSubmitter; index++ {
+		receipt, err := submit("same-reader", fmt.Sprintf("https://source-%d.example.org", index))
+		require.NoError(t, err)
+		assert.False(t, receipt.ReanalysisStarted, "[RO] Un singur cititor nu pornește reanaliza")
+	}
+	_, err := submit("same-reader", "https://another.example.org")
+	assert.ErrorIs(t, err, dispute.ErrDisputeRateLimited)
+	assert.Empty(t, launcher.workflowIDs)
+
+	// [RO] După fereastră, clientul poate contesta din nou
+	now = now.Add(dispute.SubmitterRateWindow + time.Minute)
+	_, err = submit("same-reader", "https://another.example.org")
+	assert.NoError(t, err)
+}
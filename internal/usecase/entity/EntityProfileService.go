@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// [RO] Limite Pagina de Entitate
+const (
+	// Câte articole (cele mai noi) returnăm în profil.
+	profileArticleLimit = 50
+	// Câte entități co-apărute returnăm.
+	profileCoOccurringLimit = 10
+	// Câte unificări succesive urmărim până la entitatea finală.
+	maxMergeRedirects = 5
+)
+
+// [RO] Serviciul Paginilor de Entitate
+//
+// Alimentează ecranul de profil (persoană/organizație/loc) și autocompletarea din SearchScreen.
+// Datele vin din două surse: Postgres (mențiuni, scoruri, emoții) și Dgraph (co-apariții, căutare trigram).
+type EntityProfileService struct {
+	registry      entity.EntityRegistryPersistenceInterface
+	graph         entity.EntityGraphPersistenceInterface
+	knowledgeBase entity.KnowledgeBasePersistenceInterface
+}
+
+// [RO] Constructor Serviciu Profil Entități
+func NewEntityProfileService(
+	registry entity.EntityRegistryPersistenceInterface,
+	graph entity.EntityGraphPersistenceInterface,
+	knowledgeBase entity.KnowledgeBasePersistenceInterface,
+) *EntityProfileService {
+	return &EntityProfileService{
+		registry:      registry,
+		graph:         graph,
+		knowledgeBase: knowledgeBase,
+	}
+}
+
+// [RO] Profilul Complet al unei Entități
+// O entitate unificată (merged) redirecționează către ținta ei, ca link-urile vechi din aplicație să funcționeze.
+// `window` limitează articolele și evoluția la ultima perioadă (ex: 90 de zile).
+func (service *EntityProfileService) RetrieveEntityProfile(executionContext context.Context, entityID uuid.UUID, window time.Duration) (*entity.EntityProfile, error) {
+	canonical, err := service.registry.RetrieveEntityByID(executionContext, entityID)
+	if err != nil {
+		return nil, err
+	}
+	for redirects := 0; canonical.MergedInto != uuid.Nil; redirects++ {
+		if redirects >= maxMergeRedirects {
+			return nil, fmt.Errorf("[RO] Eroare: Lanț de unificări prea lung pentru entitatea %s.", entityID)
+		}
+		canonical, err = service.registry.RetrieveEntityByID(executionContext, canonical.MergedInto)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	profile := &entity.EntityProfile{Entity: *canonical}
+	since := time.Now().Add(-window)
+
+	if service.knowledgeBase != nil {
+		if profile.KnowledgeBase, err = service.knowledgeBase.RetrieveLinkedKnowledgeBaseEntry(executionContext, canonical.ID); err != nil {
+			return nil, err
+		}
+	}
+	if profile.Articles, err = service.registry.RetrieveEntityArticles(executionContext, canonical.ID, since, profileArticleLimit); err != nil {
+		return nil, err
+	}
+	if profile.Trend, err = service.registry.RetrieveEntityCoverageTrend(executionContext, canonical.ID, since); err != nil {
+		return nil, err
+	}
+	if profile.CoOccurring, err = service.graph.RetrieveCoOccurringEntities(executionContext, canonical.ID.String(), profileCoOccurringLimit); err != nil {
+		return nil, err
+	}
+
+	// Aplicația mobilă așteaptă liste, nu null.
+	if profile.Articles == nil {
+		profile.Articles = []entity.EntityArticleMention{}
+	}
+	if profile.Trend == nil {
+		profile.Trend = []entity.EntityCoverageBucket{}
+	}
+	if profile.CoOccurring == nil {
+		profile.CoOccurring = []entity.CoOccurringEntity{}
+	}
+	return profile, nil
+}
+
+// [RO] Autocompletare (SearchScreen)
+// Fără o secvență de 3 litere nu căutăm (indexul trigram nu poate ajuta) și returnăm o listă goală.
+func (service *EntityProfileService) SuggestEntities(executionContext context.Context, query string, limit int) ([]entity.EntitySuggestion, error) {
+	if _, searchable := entity.SuggestionPattern(query); !searchable {
+		return []entity.EntitySuggestion{}, nil
+	}
+	return service.graph.SuggestEntitiesByName(executionContext, query, limit)
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+func TestRetrieveEntityProfile_FollowsMergeRedirect(t *testing.T) {
+	// [RO] Scenariu: link vechi către "Biden" (unificat în "Joe Biden") -> primim profilul țintei.
+	registry, graph, kb := new(MockEntityRegistry), new(MockEntityGraph), new(MockKnowledgeBase)
+	svc := NewEntityProfileService(registry, graph, kb)
+
+	oldID, targetID := uuid.New(), uuid.New()
+	registry.On("RetrieveEntityByID", mock.Anything, oldID).Return(&entity.CanonicalEntity{ID: oldID, MergedInto: targetID}, nil)
+	registry.On("RetrieveEntityByID", mock.Anything, targetID).Return(&entity.CanonicalEntity{ID: targetID, Name: "Joe Biden", Type: entity.TypePerson}, nil)
+	kb.On("RetrieveLinkedKnowledgeBaseEntry", mock.Anything, targetID).Return(&entity.KnowledgeBaseEntry{QID: "Q6279"}, nil)
+	registry.On("RetrieveEntityArticles", mock.Anything, targetID, mock.Anything, profileArticleLimit).Return([]entity.EntityArticleMention{{Title: "Summit"}}, nil)
+	registry.On("RetrieveEntityCoverageTrend", mock.Anything, targetID, mock.Anything).Return([]entity.EntityCoverageBucket(nil), nil)
+	graph.On("RetrieveCoOccurringEntities", mock.Anything, targetID.String(), profileCoOccurringLimit).Return([]entity.CoOccurringEntity{
+		{Name: "Kamala Harris", SharedArticles: 12},
+	}, nil)
+
+	profile, err := svc.RetrieveEntityProfile(context.Background(), oldID, 90*24*time.Hour)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Joe Biden", profile.Entity.Name)
+	assert.Equal(t, "Q6279", profile.KnowledgeBase.QID)
+	assert.Len(t, profile.Articles, 1)
+	assert.NotNil(t, profile.Trend, "[RO] Lista goală, nu null, pentru aplicația mobilă")
+	assert.Equal(t, 12, profile.CoOccurring[0].SharedArticles)
+	registry.AssertExpectations(t)
+	graph.AssertExpectations(t)
+}
+
+func TestSuggestEntities_ShortQuerySkipsGraph(t *testing.T) {
+	registry, graph := new(MockEntityRegistry), new(MockEntityGraph)
+	svc := NewEntityProfileService(registry, graph, nil)
+
+	suggestions, err := svc.SuggestEntities(context.Background(), "Bi", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, suggestions)
+	graph.AssertNotCalled(t, "SuggestEntitiesByName", mock.Anything, mock.Anything, mock.Anything)
+
+	graph.On("SuggestEntitiesByName", mock.Anything, "Bid", 10).Return([]entity.EntitySuggestion{{Name: "Joe Biden"}}, nil)
+	suggestions, err = svc.SuggestEntities(context.Background(), "Bid", 10)
+	assert.NoError(t, err)
+	assert.Len(t, suggestions, 1)
+}
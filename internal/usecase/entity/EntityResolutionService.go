@@ -0,0 +1,340 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+)
+
+// [RO] Praguri de Potrivire
+const (
+	// Similaritatea trigram minimă pentru a accepta automat un candidat.
+	trigramAcceptThreshold = 0.6
+	// Similaritatea semantică (cosine) minimă pentru același tip.
+	embeddingAcceptThreshold = 0.92
+	// Câți candidați cerem grafului.
+	trigramCandidateLimit = 10
+	// Câți candidați Wikidata comparăm la legare.
+	knowledgeBaseCandidateLimit = 5
+)
+
+// [RO] Serviciul de Rezolvare a Entităților
+//
+// Transformă numele brute extrase de AI ("Biden", "President Biden") într-un ID canonic unic.
+// Etapele, de la cea mai ieftină la cea mai scumpă:
+// 1. Tabela de alias-uri (potrivire exactă, același tip).
+// 2. Similaritate trigram (indexul `name` din Dgraph), cu reguli pentru nume parțiale de persoane.
+// 3. Similaritate semantică (embedding), doar dacă primele două nu au găsit nimic.
+// 4. Entitate nouă.
+//
+// După rezolvare, entitatea este legată (o singură dată) de QID-ul Wikidata din baza de cunoștințe offline.
+type EntityResolutionService struct {
+	registry               entity.EntityRegistryPersistenceInterface
+	graph                  entity.EntityGraphPersistenceInterface
+	knowledgeBase          entity.KnowledgeBasePersistenceInterface
+	artificialIntelligence ports.ArtificialIntelligenceGateway
+}
+
+// [RO] Rezultatul rezolvării unui nume (memorat per articol)
+type resolvedEntity struct {
+	id            uuid.UUID
+	knowledgeBase *entity.KnowledgeBaseEntry
+}
+
+// [RO] Constructor Serviciu Entități
+func NewEntityResolutionService(
+	registry entity.EntityRegistryPersistenceInterface,
+	graph entity.EntityGraphPersistenceInterface,
+	knowledgeBase entity.KnowledgeBasePersistenceInterface,
+	ai ports.ArtificialIntelligenceGateway,
+) *EntityResolutionService {
+	return &EntityResolutionService{
+		registry:               registry,
+		graph:                  graph,
+		knowledgeBase:          knowledgeBase,
+		artificialIntelligence: ai,
+	}
+}
+
+// [RO] Rezolvă Mențiunile unui Articol
+// Completează CanonicalID (și tipul normalizat) pentru fiecare entitate menționată,
+// plus QID-ul, descrierea și coordonatele din baza de cunoștințe (dacă există).
+func (service *EntityResolutionService) ResolveMentions(executionContext context.Context, mentions []article.NamedEntity) ([]article.NamedEntity, error) {
+	resolved := make([]article.NamedEntity, 0, len(mentions))
+	cache := make(map[string]resolvedEntity)
+
+	for _, mention := range mentions {
+		entityType := entity.NormalizeType(mention.Type)
+		normalizedName := entity.NormalizeName(mention.Name, entityType)
+		if normalizedName == "" {
+			continue
+		}
+
+		cacheKey := entityType + "|" + normalizedName
+		result, seen := cache[cacheKey]
+		if !seen {
+			canonicalID, err := service.resolveOne(executionContext, mention.Name, normalizedName, entityType)
+			if err != nil {
+				return nil, fmt.Errorf("[RO] Eroare la rezolvarea entității %q: %w", mention.Name, err)
+			}
+			kbEntry, err := service.linkKnowledgeBase(executionContext, canonicalID, normalizedName, entityType)
+			if err != nil {
+				return nil, fmt.Errorf("[RO] Eroare la legarea entității %q de baza de cunoștințe: %w", mention.Name, err)
+			}
+			result = resolvedEntity{id: canonicalID, knowledgeBase: kbEntry}
+			cache[cacheKey] = result
+		}
+
+		mention.Type = entityType
+		mention.CanonicalID = result.id.String()
+		if kbEntry := result.knowledgeBase; kbEntry != nil {
+			mention.WikidataQID = kbEntry.QID
+			mention.Description = kbEntry.Description
+			mention.HasCoordinates = kbEntry.HasCoordinates
+			mention.Latitude = kbEntry.Latitude
+			mention.Longitude = kbEntry.Longitude
+		}
+		resolved = append(resolved, mention)
+	}
+	return resolved, nil
+}
+
+// [RO] Înregistrează Mențiunile Rezolvate ale unui Articol
+// Păstrăm forma exactă din text, de care avem nevoie la separarea (split) unei entități.
+func (service *EntityResolutionService) RecordArticleMentions(executionContext context.Context, articleID uuid.UUID, mentions []article.NamedEntity) error {
+	surfaceForms := make(map[string]uuid.UUID)
+	for _, mention := range mentions {
+		canonicalID, err := uuid.Parse(mention.CanonicalID)
+		if err != nil {
+			continue
+		}
+		surfaceForms[mention.Name] = canonicalID
+	}
+	if len(surfaceForms) == 0 {
+		return nil
+	}
+	return service.registry.RecordArticleMentions(executionContext, articleID, surfaceForms)
+}
+
+func (service *EntityResolutionService) resolveOne(executionContext context.Context, rawName string, normalizedName string, entityType string) (uuid.UUID, error) {
+	// 1. Alias exact
+	known, err := service.registry.FindEntityByAlias(executionContext, normalizedName, entityType)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if known != nil {
+		return known.ID, nil
+	}
+
+	// 2. Trigram (Dgraph)
+	candidates, err := service.graph.FindEntityCandidatesByTrigram(executionContext, rawName, entityType, trigramCandidateLimit)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if match := pickTrigramMatch(normalizedName, entityType, candidates); match != nil {
+		return match.EntityID, service.registry.AddAlias(executionContext, match.EntityID, rawName, normalizedName, entityType)
+	}
+
+	// 3. Embedding
+	embedding, err := service.artificialIntelligence.GenerateSemanticVector(executionContext, rawName+" ("+entityType+")")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	similar, err := service.registry.FindEntitiesByEmbedding(executionContext, embedding, entityType, embeddingAcceptThreshold, 1)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(similar) > 0 {
+		return similar[0].EntityID, service.registry.AddAlias(executionContext, similar[0].EntityID, rawName, normalizedName, entityType)
+	}
+
+	// 4. Entitate nouă
+	created := &entity.CanonicalEntity{
+		ID:        uuid.New(),
+		Name:      rawName,
+		Type:      entityType,
+		Embedding: embedding,
+		CreatedAt: time.Now(),
+	}
+	if err := service.registry.CreateEntity(executionContext, created); err != nil {
+		return uuid.Nil, err
+	}
+	return created.ID, nil
+}
+
+// [RO] Legare de Baza de Cunoștințe (Wikidata)
+// Entitatea deja legată își păstrează QID-ul (o eventuală corecție se face din Admin, prin merge/split).
+// Altfel căutăm după alias normalizat și tip, și legăm doar dacă un candidat este clar dominant.
+func (service *EntityResolutionService) linkKnowledgeBase(executionContext context.Context, entityID uuid.UUID, normalizedName string, entityType string) (*entity.KnowledgeBaseEntry, error) {
+	if service.knowledgeBase == nil {
+		return nil, nil
+	}
+
+	linked, err := service.knowledgeBase.RetrieveLinkedKnowledgeBaseEntry(executionContext, entityID)
+	if err != nil || linked != nil {
+		return linked, err
+	}
+
+	candidates, err := service.knowledgeBase.FindKnowledgeBaseEntriesByAlias(executionContext, normalizedName, entityType, knowledgeBaseCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	chosen := entity.PickKnowledgeBaseEntry(candidates)
+	if chosen == nil {
+		return nil, nil
+	}
+	if err := service.knowledgeBase.LinkEntityToKnowledgeBase(executionContext, entityID, chosen.QID); err != nil {
+		return nil, err
+	}
+	return chosen, nil
+}
+
+// [RO] Alegerea Candidatului Trigram
+// Acceptăm cel mai bun scor peste prag; pentru persoane acceptăm și un nume parțial
+// ("biden" ⊂ "joe biden"), dar doar dacă este unic — altfel "Biden" ar putea fi oricine din familie.
+// Două persoane cu același nume de familie dar prenume diferite nu sunt niciodată unificate aici.
+func pickTrigramMatch(normalizedName string, entityType string, candidates []entity.EntityCandidate) *entity.EntityCandidate {
+	var best *entity.EntityCandidate
+	bestScore := 0.0
+	var partialMatches []*entity.EntityCandidate
+
+	for i := range candidates {
+		candidate := &candidates[i]
+		if entity.NormalizeType(candidate.Type) != entityType {
+			continue
+		}
+		candidateName := entity.NormalizeName(candidate.Name, entityType)
+
+		if entityType == entity.TypePerson {
+			if entity.IsTokenSubset(normalizedName, candidateName) || entity.IsTokenSubset(candidateName, normalizedName) {
+				partialMatches = append(partialMatches, candidate)
+				continue
+			}
+			if conflictingGivenNames(normalizedName, candidateName) {
+				continue
+			}
+		}
+
+		score := entity.TrigramSimilarity(normalizedName, candidateName)
+		candidate.Similarity = score
+		if score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+
+	if bestScore >= trigramAcceptThreshold {
+		return best
+	}
+	if len(partialMatches) == 1 {
+		return partialMatches[0]
+	}
+	return nil
+}
+
+// [RO] Prenume Diferite
+// "joe biden" vs "hunter biden": ambele au prenume, iar prenumele nu se potrivesc.
+func conflictingGivenNames(a, b string) bool {
+	tokensA, tokensB := strings.Fields(a), strings.Fields(b)
+	if len(tokensA) < 2 || len(tokensB) < 2 {
+		return false
+	}
+	givenA, givenB := tokensA[0], tokensB[0]
+	return !strings.HasPrefix(givenA, givenB) && !strings.HasPrefix(givenB, givenA)
+}
+
+// [RO] Unificare Entități (Admin)
+// Sursa dispare în țintă: alias-uri, mențiuni și muchii din graf. Operațiunea este auditată.
+func (service *EntityResolutionService) MergeEntities(executionContext context.Context, sourceID uuid.UUID, targetID uuid.UUID, actor string, reason string) (*entity.EntityMergeAuditRecord, error) {
+	if sourceID == targetID {
+		return nil, fmt.Errorf("[RO] Eroare: O entitate nu poate fi unificată cu ea însăși.")
+	}
+
+	source, err := service.registry.RetrieveEntityByID(executionContext, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := service.registry.RetrieveEntityByID(executionContext, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if source.MergedInto != uuid.Nil || target.MergedInto != uuid.Nil {
+		return nil, fmt.Errorf("[RO] Eroare: Una dintre entități a fost deja unificată.")
+	}
+	if source.Type != target.Type {
+		return nil, fmt.Errorf("[RO] Eroare: Nu putem unifica tipuri diferite (%s vs %s).", source.Type, target.Type)
+	}
+
+	audit := &entity.EntityMergeAuditRecord{
+		ID:             uuid.New(),
+		Action:         entity.AuditActionMerge,
+		SourceEntityID: sourceID,
+		TargetEntityID: targetID,
+		Actor:          actor,
+		Reason:         reason,
+		CreatedAt:      time.Now(),
+	}
+	if err := service.registry.MergeEntities(executionContext, audit); err != nil {
+		return nil, err
+	}
+	if err := service.graph.MergeEntityNodes(executionContext, sourceID.String(), targetID.String()); err != nil {
+		return nil, err
+	}
+	return audit, nil
+}
+
+// [RO] Separare Entitate (Admin)
+// Alias-urile date (ex: "Jordan" țara vs "Michael Jordan") pleacă într-o entitate nouă,
+// împreună cu articolele care le foloseau. Operațiunea este auditată.
+func (service *EntityResolutionService) SplitEntity(executionContext context.Context, sourceID uuid.UUID, aliases []string, newName string, actor string, reason string) (*entity.EntitySplitResult, error) {
+	if len(aliases) == 0 {
+		return nil, fmt.Errorf("[RO] Eroare: Trebuie ales cel puțin un alias pentru separare.")
+	}
+
+	source, err := service.registry.RetrieveEntityByID(executionContext, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if source.MergedInto != uuid.Nil {
+		return nil, fmt.Errorf("[RO] Eroare: Entitatea a fost deja unificată în %s.", source.MergedInto)
+	}
+	if newName == "" {
+		newName = aliases[0]
+	}
+
+	newEntity := &entity.CanonicalEntity{
+		ID:        uuid.New(),
+		Name:      newName,
+		Type:      source.Type,
+		CreatedAt: time.Now(),
+	}
+	audit := &entity.EntityMergeAuditRecord{
+		ID:             uuid.New(),
+		Action:         entity.AuditActionSplit,
+		SourceEntityID: sourceID,
+		TargetEntityID: newEntity.ID,
+		MovedAliases:   aliases,
+		Actor:          actor,
+		Reason:         reason,
+		CreatedAt:      time.Now(),
+	}
+
+	result, err := service.registry.SplitEntity(executionContext, newEntity, audit)
+	if err != nil {
+		return nil, err
+	}
+	if err := service.graph.RelinkEntityMentions(executionContext, sourceID.String(), *newEntity, result.MovedArticleURLs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// [RO] Istoricul Unificărilor/Separărilor unei Entități
+func (service *EntityResolutionService) RetrieveMergeAudit(executionContext context.Context, entityID uuid.UUID) ([]entity.EntityMergeAuditRecord, error) {
+	return service.registry.RetrieveMergeAudit(executionContext, entityID)
+}
@@ -0,0 +1,327 @@
+package entity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/entity"
+)
+
+// --- Mocks ---
+
+type MockEntityRegistry struct {
+	mock.Mock
+}
+
+func (m *MockEntityRegistry) FindEntityByAlias(ctx context.Context, normalizedAlias string, entityType string) (*entity.CanonicalEntity, error) {
+	args := m.Called(ctx, normalizedAlias, entityType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CanonicalEntity), args.Error(1)
+}
+
+func (m *MockEntityRegistry) FindEntitiesByEmbedding(ctx context.Context, embedding []float32, entityType string, minSimilarity float64, limit int) ([]entity.EntityCandidate, error) {
+	args := m.Called(ctx, embedding, entityType, minSimilarity, limit)
+	return args.Get(0).([]entity.EntityCandidate), args.Error(1)
+}
+
+func (m *MockEntityRegistry) RetrieveEntityByID(ctx context.Context, id uuid.UUID) (*entity.CanonicalEntity, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CanonicalEntity), args.Error(1)
+}
+
+func (m *MockEntityRegistry) CreateEntity(ctx context.Context, canonical *entity.CanonicalEntity) error {
+	return m.Called(ctx, canonical).Error(0)
+}
+
+func (m *MockEntityRegistry) AddAlias(ctx context.Context, entityID uuid.UUID, alias string, normalizedAlias string, entityType string) error {
+	return m.Called(ctx, entityID, alias, normalizedAlias, entityType).Error(0)
+}
+
+func (m *MockEntityRegistry) RecordArticleMentions(ctx context.Context, articleID uuid.UUID, mentions map[string]uuid.UUID) error {
+	return m.Called(ctx, articleID, mentions).Error(0)
+}
+
+func (m *MockEntityRegistry) MergeEntities(ctx context.Context, audit *entity.EntityMergeAuditRecord) error {
+	return m.Called(ctx, audit).Error(0)
+}
+
+func (m *MockEntityRegistry) SplitEntity(ctx context.Context, newEntity *entity.CanonicalEntity, audit *entity.EntityMergeAuditRecord) (*entity.EntitySplitResult, error) {
+	args := m.Called(ctx, newEntity, audit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.EntitySplitResult), args.Error(1)
+}
+
+func (m *MockEntityRegistry) RetrieveMergeAudit(ctx context.Context, entityID uuid.UUID) ([]entity.EntityMergeAuditRecord, error) {
+	args := m.Called(ctx, entityID)
+	return args.Get(0).([]entity.EntityMergeAuditRecord), args.Error(1)
+}
+
+func (m *MockEntityRegistry) RetrieveEntityArticles(ctx context.Context, entityID uuid.UUID, since time.Time, limit int) ([]entity.EntityArticleMention, error) {
+	args := m.Called(ctx, entityID, since, limit)
+	return args.Get(0).([]entity.EntityArticleMention), args.Error(1)
+}
+
+func (m *MockEntityRegistry) RetrieveEntityCoverageTrend(ctx context.Context, entityID uuid.UUID, since time.Time) ([]entity.EntityCoverageBucket, error) {
+	args := m.Called(ctx, entityID, since)
+	return args.Get(0).([]entity.EntityCoverageBucket), args.Error(1)
+}
+
+type MockEntityGraph struct {
+	mock.Mock
+}
+
+func (m *MockEntityGraph) FindEntityCandidatesByTrigram(ctx context.Context, name string, entityType string, limit int) ([]entity.EntityCandidate, error) {
+	args := m.Called(ctx, name, entityType, limit)
+	return args.Get(0).([]entity.EntityCandidate), args.Error(1)
+}
+
+func (m *MockEntityGraph) MergeEntityNodes(ctx context.Context, sourceEntityID string, targetEntityID string) error {
+	return m.Called(ctx, sourceEntityID, targetEntityID).Error(0)
+}
+
+func (m *MockEntityGraph) RelinkEntityMentions(ctx context.Context, fromEntityID string, toEntity entity.CanonicalEntity, articleURLs []string) error {
+	return m.Called(ctx, fromEntityID, toEntity, articleURLs).Error(0)
+}
+
+func (m *MockEntityGraph) RetrieveCoOccurringEntities(ctx context.Context, entityID string, limit int) ([]entity.CoOccurringEntity, error) {
+	args := m.Called(ctx, entityID, limit)
+	return args.Get(0).([]entity.CoOccurringEntity), args.Error(1)
+}
+
+func (m *MockEntityGraph) SuggestEntitiesByName(ctx context.Context, prefix string, limit int) ([]entity.EntitySuggestion, error) {
+	args := m.Called(ctx, prefix, limit)
+	return args.Get(0).([]entity.EntitySuggestion), args.Error(1)
+}
+
+type MockKnowledgeBase struct {
+	mock.Mock
+}
+
+func (m *MockKnowledgeBase) UpsertKnowledgeBaseEntries(ctx context.Context, entries []entity.KnowledgeBaseEntry) error {
+	return m.Called(ctx, entries).Error(0)
+}
+
+func (m *MockKnowledgeBase) FindKnowledgeBaseEntriesByAlias(ctx context.Context, normalizedAlias string, entityType string, limit int) ([]entity.KnowledgeBaseEntry, error) {
+	args := m.Called(ctx, normalizedAlias, entityType, limit)
+	return args.Get(0).([]entity.KnowledgeBaseEntry), args.Error(1)
+}
+
+func (m *MockKnowledgeBase) RetrieveLinkedKnowledgeBaseEntry(ctx context.Context, entityID uuid.UUID) (*entity.KnowledgeBaseEntry, error) {
+	args := m.Called(ctx, entityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.KnowledgeBaseEntry), args.Error(1)
+}
+
+func (m *MockKnowledgeBase) LinkEntityToKnowledgeBase(ctx context.Context, entityID uuid.UUID, qid string) error {
+	return m.Called(ctx, entityID, qid).Error(0)
+}
+
+type MockAIGateway struct {
+	mock.Mock
+}
+
+func (m *MockAIGateway) AnalyzeAndNeutralizeNewsContent(ctx context.Context, rawContent string) (*article.AIAnalysisResult, error) {
+	return nil, nil
+}
+func (m *MockAIGateway) GenerateSemanticVector(ctx context.Context, text string) ([]float32, error) {
+	args := m.Called(ctx, text)
+	return args.Get(0).([]float32), args.Error(1)
+}
+func (m *MockAIGateway) ChatWithContext(ctx context.Context, query string, context string) (string, error) {
+	return "", nil
+}
+func (m *MockAIGateway) StreamChatWithContext(ctx context.Context, query string, context string, onToken func(token string) error) error {
+	return nil
+}
+
+// --- Tests ---
+
+func TestResolveMentions_AliasHitSkipsExpensiveStages(t *testing.T) {
+	// [RO] Scenariu: "President Biden" este deja un alias cunoscut -> nu atingem graful sau AI-ul.
+	registry, graph, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, nil, ai)
+
+	bidenID := uuid.New()
+	registry.On("FindEntityByAlias", mock.Anything, "biden", entity.TypePerson).Return(&entity.CanonicalEntity{ID: bidenID}, nil).Once()
+
+	resolved, err := svc.ResolveMentions(context.Background(), []article.NamedEntity{
+		{Name: "President Biden", Type: "person"},
+		{Name: "Biden", Type: "Person"}, // [RO] Aceeași cheie normalizată -> din cache
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, bidenID.String(), resolved[0].CanonicalID)
+	assert.Equal(t, bidenID.String(), resolved[1].CanonicalID)
+	assert.Equal(t, entity.TypePerson, resolved[0].Type)
+	registry.AssertExpectations(t)
+	graph.AssertNotCalled(t, "FindEntityCandidatesByTrigram", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	ai.AssertNotCalled(t, "GenerateSemanticVector", mock.Anything, mock.Anything)
+}
+
+func TestResolveMentions_UniquePartialPersonNameMatches(t *testing.T) {
+	// [RO] Scenariu: "Biden" nu e alias, dar graful are un singur "Joe Biden" -> unificăm și învățăm alias-ul.
+	registry, graph, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, nil, ai)
+
+	bidenID := uuid.New()
+	registry.On("FindEntityByAlias", mock.Anything, "biden", entity.TypePerson).Return(nil, nil)
+	graph.On("FindEntityCandidatesByTrigram", mock.Anything, "Biden", entity.TypePerson, mock.Anything).Return([]entity.EntityCandidate{
+		{EntityID: bidenID, Name: "Joe Biden", Type: "Person"},
+	}, nil)
+	registry.On("AddAlias", mock.Anything, bidenID, "Biden", "biden", entity.TypePerson).Return(nil)
+
+	resolved, err := svc.ResolveMentions(context.Background(), []article.NamedEntity{{Name: "Biden", Type: "Person"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, bidenID.String(), resolved[0].CanonicalID)
+	registry.AssertExpectations(t)
+}
+
+func TestResolveMentions_AmbiguousSurnameCreatesNewEntity(t *testing.T) {
+	// [RO] Scenariu: "Biden" se potrivește parțial cu doi oameni -> nu ghicim; embedding-ul nu găsește nimic -> entitate nouă.
+	registry, graph, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, nil, ai)
+
+	registry.On("FindEntityByAlias", mock.Anything, "biden", entity.TypePerson).Return(nil, nil)
+	graph.On("FindEntityCandidatesByTrigram", mock.Anything, "Biden", entity.TypePerson, mock.Anything).Return([]entity.EntityCandidate{
+		{EntityID: uuid.New(), Name: "Joe Biden", Type: "Person"},
+		{EntityID: uuid.New(), Name: "Hunter Biden", Type: "Person"},
+	}, nil)
+	ai.On("GenerateSemanticVector", mock.Anything, "Biden (Person)").Return([]float32{0.1}, nil)
+	registry.On("FindEntitiesByEmbedding", mock.Anything, []float32{0.1}, entity.TypePerson, embeddingAcceptThreshold, 1).Return([]entity.EntityCandidate{}, nil)
+	registry.On("CreateEntity", mock.Anything, mock.MatchedBy(func(e *entity.CanonicalEntity) bool {
+		return e.Name == "Biden" && e.Type == entity.TypePerson
+	})).Return(nil)
+
+	resolved, err := svc.ResolveMentions(context.Background(), []article.NamedEntity{{Name: "Biden", Type: "Person"}})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resolved[0].CanonicalID)
+	registry.AssertExpectations(t)
+	ai.AssertExpectations(t)
+}
+
+func TestResolveMentions_LinksDominantKnowledgeBaseEntry(t *testing.T) {
+	// [RO] Scenariu: "Paris" (loc) are doi candidați Wikidata; capitala Franței domină -> legăm și preluăm coordonatele.
+	registry, graph, kb, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockKnowledgeBase), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, kb, ai)
+
+	parisID := uuid.New()
+	registry.On("FindEntityByAlias", mock.Anything, "paris", entity.TypePlace).Return(&entity.CanonicalEntity{ID: parisID}, nil)
+	kb.On("RetrieveLinkedKnowledgeBaseEntry", mock.Anything, parisID).Return(nil, nil)
+	kb.On("FindKnowledgeBaseEntriesByAlias", mock.Anything, "paris", entity.TypePlace, knowledgeBaseCandidateLimit).Return([]entity.KnowledgeBaseEntry{
+		{QID: "Q830149", Label: "Paris", Type: entity.TypePlace, Sitelinks: 40, HasCoordinates: true, Latitude: 33.66, Longitude: -95.55},
+		{QID: "Q90", Label: "Paris", Description: "capital of France", Type: entity.TypePlace, Sitelinks: 300, HasCoordinates: true, Latitude: 48.85, Longitude: 2.35},
+	}, nil)
+	kb.On("LinkEntityToKnowledgeBase", mock.Anything, parisID, "Q90").Return(nil)
+
+	resolved, err := svc.ResolveMentions(context.Background(), []article.NamedEntity{{Name: "Paris", Type: "Place"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Q90", resolved[0].WikidataQID)
+	assert.Equal(t, "capital of France", resolved[0].Description)
+	assert.True(t, resolved[0].HasCoordinates)
+	assert.InDelta(t, 48.85, resolved[0].Latitude, 0.001)
+	kb.AssertExpectations(t)
+}
+
+func TestPickKnowledgeBaseEntry_SkipsAmbiguousCandidates(t *testing.T) {
+	// [RO] Doi candidați la fel de populari -> nu ghicim.
+	assert.Nil(t, entity.PickKnowledgeBaseEntry([]entity.KnowledgeBaseEntry{
+		{QID: "Q1", Sitelinks: 10},
+		{QID: "Q2", Sitelinks: 12},
+	}))
+	assert.Nil(t, entity.PickKnowledgeBaseEntry(nil))
+	if picked := entity.PickKnowledgeBaseEntry([]entity.KnowledgeBaseEntry{{QID: "Q3", Sitelinks: 0}}); assert.NotNil(t, picked) {
+		assert.Equal(t, "Q3", picked.QID)
+	}
+}
+
+func TestPickTrigramMatch_TypeAwareAndGivenNames(t *testing.T) {
+	jordanCountry := entity.EntityCandidate{EntityID: uuid.New(), Name: "Jordan", Type: "Place"}
+	hunter := entity.EntityCandidate{EntityID: uuid.New(), Name: "Hunter Biden", Type: "Person"}
+
+	// [RO] Același nume, tip diferit -> nicio potrivire
+	assert.Nil(t, pickTrigramMatch("jordan", entity.TypePerson, []entity.EntityCandidate{jordanCountry}))
+
+	// [RO] Același nume de familie, prenume diferit -> nicio potrivire
+	assert.Nil(t, pickTrigramMatch("joe biden", entity.TypePerson, []entity.EntityCandidate{hunter}))
+
+	// [RO] Greșeală de transliterare -> potrivire
+	zelensky := entity.EntityCandidate{EntityID: uuid.New(), Name: "Volodymyr Zelenskyy", Type: "Person"}
+	match := pickTrigramMatch("volodymyr zelensky", entity.TypePerson, []entity.EntityCandidate{zelensky})
+	if assert.NotNil(t, match) {
+		assert.Equal(t, zelensky.EntityID, match.EntityID)
+	}
+}
+
+func TestMergeEntities_RejectsDifferentTypes(t *testing.T) {
+	registry, graph, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, nil, ai)
+
+	personID, placeID := uuid.New(), uuid.New()
+	registry.On("RetrieveEntityByID", mock.Anything, personID).Return(&entity.CanonicalEntity{ID: personID, Type: entity.TypePerson}, nil)
+	registry.On("RetrieveEntityByID", mock.Anything, placeID).Return(&entity.CanonicalEntity{ID: placeID, Type: entity.TypePlace}, nil)
+
+	_, err := svc.MergeEntities(context.Background(), personID, placeID, "editor@truthweave.org", "duplicate")
+
+	assert.Error(t, err)
+	registry.AssertNotCalled(t, "MergeEntities", mock.Anything, mock.Anything)
+	graph.AssertNotCalled(t, "MergeEntityNodes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMergeEntities_WritesAuditAndRewritesGraph(t *testing.T) {
+	registry, graph, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, nil, ai)
+
+	sourceID, targetID := uuid.New(), uuid.New()
+	registry.On("RetrieveEntityByID", mock.Anything, sourceID).Return(&entity.CanonicalEntity{ID: sourceID, Type: entity.TypePerson}, nil)
+	registry.On("RetrieveEntityByID", mock.Anything, targetID).Return(&entity.CanonicalEntity{ID: targetID, Type: entity.TypePerson}, nil)
+	registry.On("MergeEntities", mock.Anything, mock.MatchedBy(func(a *entity.EntityMergeAuditRecord) bool {
+		return a.Action == entity.AuditActionMerge && a.SourceEntityID == sourceID && a.TargetEntityID == targetID && a.Actor == "editor"
+	})).Return(nil)
+	graph.On("MergeEntityNodes", mock.Anything, sourceID.String(), targetID.String()).Return(nil)
+
+	audit, err := svc.MergeEntities(context.Background(), sourceID, targetID, "editor", "same person")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "same person", audit.Reason)
+	registry.AssertExpectations(t)
+	graph.AssertExpectations(t)
+}
+
+func TestResolveMentions_LosingCreateRaceReusesExistingEntity(t *testing.T) {
+	// [RO] Scenariu: alt worker a creat "Biden" între căutare și creare -> folosim entitatea lui.
+	registry, graph, ai := new(MockEntityRegistry), new(MockEntityGraph), new(MockAIGateway)
+	svc := NewEntityResolutionService(registry, graph, nil, ai)
+	winnerID := uuid.New()
+
+	registry.On("FindEntityByAlias", mock.Anything, "biden", entity.TypePerson).Return(nil, nil)
+	graph.On("FindEntityCandidatesByTrigram", mock.Anything, "Biden", entity.TypePerson, mock.Anything).Return([]entity.EntityCandidate{}, nil)
+	ai.On("GenerateSemanticVector", mock.Anything, "Biden (Person)").Return([]float32{0.1}, nil)
+	registry.On("FindEntitiesByEmbedding", mock.Anything, []float32{0.1}, entity.TypePerson, embeddingAcceptThreshold, 1).Return([]entity.EntityCandidate{}, nil)
+	registry.On("CreateEntity", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.CanonicalEntity).ID = winnerID
+	}).Return(nil)
+
+	resolved, err := svc.ResolveMentions(context.Background(), []article.NamedEntity{{Name: "Biden", Type: "Person"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, winnerID.String(), resolved[0].CanonicalID)
+	registry.AssertExpectations(t)
+}
@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/yourorg/truthweave/internal/domain/graph"
+)
+
+// [RO] Format necunoscut (validat înainte de a scrie ceva în răspuns)
+var ErrUnsupportedExportFormat = errors.New("[RO] Eroare: Format de export necunoscut (graphml, gexf, dot, jsonld).")
+
+// [RO] Tipuri MIME per Format
+var exportContentTypes = map[string]string{
+	graph.FormatGraphML: "application/graphml+xml",
+	graph.FormatGEXF:    "application/gexf+xml",
+	graph.FormatDOT:     "text/vnd.graphviz",
+	graph.FormatJSONLD:  "application/ld+json",
+}
+
+// [RO] Serviciul de Export al Grafului de Cunoștințe
+//
+// Transformă subgraful cauzal (evenimente, articole, entități) în formate pentru
+// unelte externe: Gephi (GEXF), yEd/NetworkX (GraphML), Graphviz (DOT) și Linked Data (JSON-LD).
+// Exportul este scris în flux, pagină cu pagină, direct în răspunsul HTTP.
+type GraphExportService struct {
+	source graph.GraphExportSourceInterface
+}
+
+// [RO] Constructor Serviciu Export
+func NewGraphExportService(source graph.GraphExportSourceInterface) *GraphExportService {
+	return &GraphExportService{source: source}
+}
+
+// [RO] Tipul MIME al unui format (false dacă formatul nu este suportat)
+func ContentType(format string) (string, bool) {
+	contentType, ok := exportContentTypes[format]
+	return contentType, ok
+}
+
+// [RO] Export
+// Erorile de validare (format, filtru) sunt returnate înainte de orice scriere în `output`.
+// Preambulul documentului se scrie abia după ce prima pagină a sosit din sursă, deci
+// o sursă căzută de la început nu lasă nimic în `output` și apelantul poate răspunde cu o eroare.
+// O eroare apărută mai târziu în flux lasă documentul trunchiat; apelantul doar o raportează.
+func (service *GraphExportService) Export(executionContext context.Context, format string, filter graph.ExportFilter, output io.Writer) error {
+	if _, ok := ContentType(format); !ok {
+		return ErrUnsupportedExportFormat
+	}
+	if err := filter.Normalize(); err != nil {
+		return err
+	}
+
+	buffered := bufio.NewWriter(output)
+
+	writer := &dedupingExportWriter{
+		inner: newExportWriter(format, buffered),
+		nodes: map[string]bool{},
+		edges: map[graph.Edge]bool{},
+	}
+
+	started := false
+	begin := func() error {
+		if started {
+			return nil
+		}
+		started = true
+		return writer.Begin()
+	}
+
+	err := service.source.StreamExportSubgraph(executionContext, filter, func(page graph.SubgraphPage) error {
+		if err := begin(); err != nil {
+			return err
+		}
+		for _, node := range page.Nodes {
+			if err := writer.WriteNode(node); err != nil {
+				return err
+			}
+		}
+		for _, edge := range page.Edges {
+			if err := writer.WriteEdge(edge); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// [RO] Subgraf gol: documentul rămâne valid (doar preambul și încheiere).
+	if err := begin(); err != nil {
+		return err
+	}
+	if err := writer.End(); err != nil {
+		return err
+	}
+	return buffered.Flush()
+}
+
+// [RO] Scriitor de Format (un document: Begin, noduri/muchii, End)
+type exportWriter interface {
+	Begin() error
+	WriteNode(node graph.Node) error
+	WriteEdge(edge graph.Edge) error
+	End() error
+}
+
+func newExportWriter(format string, output io.Writer) exportWriter {
+	switch format {
+	case graph.FormatGEXF:
+		return &gexfExportWriter{output: output}
+	case graph.FormatDOT:
+		return &dotExportWriter{output: output}
+	case graph.FormatJSONLD:
+		return &jsonLDExportWriter{output: output, iris: map[string]string{}}
+	default:
+		return &graphMLExportWriter{output: output}
+	}
+}
+
+// [RO] Eliminarea Duplicatelor
+// Aceeași cauză, același articol sau aceeași entitate apar în mai multe pagini;
+// fiecare nod/muchie este scris o singură dată.
+type dedupingExportWriter struct {
+	inner exportWriter
+	nodes map[string]bool
+	edges map[graph.Edge]bool
+}
+
+func (writer *dedupingExportWriter) Begin() error { return writer.inner.Begin() }
+
+func (writer *dedupingExportWriter) End() error { return writer.inner.End() }
+
+func (writer *dedupingExportWriter) WriteNode(node graph.Node) error {
+	if writer.nodes[node.ID] {
+		return nil
+	}
+	writer.nodes[node.ID] = true
+	return writer.inner.WriteNode(node)
+}
+
+func (writer *dedupingExportWriter) WriteEdge(edge graph.Edge) error {
+	if writer.edges[edge] || !writer.nodes[edge.Source] || !writer.nodes[edge.Target] {
+		return nil
+	}
+	writer.edges[edge] = true
+	return writer.inner.WriteEdge(edge)
+}
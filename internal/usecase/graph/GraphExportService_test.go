@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/graph"
+)
+
+// [RO] Sursă falsă: livrează paginile scriptate și reține filtrul primit.
+type fakeExportSource struct {
+	pages    []graph.SubgraphPage
+	err      error
+	received graph.ExportFilter
+}
+
+func (source *fakeExportSource) StreamExportSubgraph(ctx context.Context, filter graph.ExportFilter, emit func(page graph.SubgraphPage) error) error {
+	source.received = filter
+	for _, page := range source.pages {
+		if err := emit(page); err != nil {
+			return err
+		}
+	}
+	return source.err
+}
+
+// [RO] Două pagini care se suprapun: cauza și entitatea apar în ambele.
+func samplePages() []graph.SubgraphPage {
+	cause := graph.Node{ID: "0x1", Kind: graph.KindEvent, Key: "evt-1", Label: "Rate hike"}
+	biden := graph.Node{ID: "0x9", Kind: graph.KindPerson, Key: "ent-1", Label: `Joe "Amtrak" Biden & co <3`, WikidataQID: "Q6279"}
+	return []graph.SubgraphPage{
+		{
+			Nodes: []graph.Node{
+				cause,
+				{ID: "0x2", Kind: graph.KindEvent, Key: "evt-2", Label: "Market drop", Timestamp: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+				{ID: "0x5", Kind: graph.KindArticle, Key: "https://example.com/a?x=1&y=2", Label: "Stocks fall", Score: 0.8},
+				biden,
+			},
+			Edges: []graph.Edge{
+				{Source: "0x2", Target: "0x1", Relation: graph.RelationCausedBy},
+				{Source: "0x2", Target: "0x5", Relation: graph.RelationReportedBy},
+				{Source: "0x5", Target: "0x9", Relation: graph.RelationMentions},
+			},
+		},
+		{
+			Nodes: []graph.Node{cause, biden},
+			Edges: []graph.Edge{{Source: "0x5", Target: "0x9", Relation: graph.RelationMentions}},
+		},
+	}
+}
+
+func TestExport_ValidatesBeforeWriting(t *testing.T) {
+	svc := NewGraphExportService(&fakeExportSource{pages: samplePages()})
+	var output bytes.Buffer
+
+	err := svc.Export(context.Background(), "csv", graph.ExportFilter{}, &output)
+	assert.ErrorIs(t, err, ErrUnsupportedExportFormat)
+
+	err = svc.Export(context.Background(), graph.FormatDOT, graph.ExportFilter{
+		From: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, &output)
+	assert.ErrorIs(t, err, graph.ErrInvertedExportWindow)
+	assert.Zero(t, output.Len(), "[RO] Nimic scris -> handler-ul poate răspunde 400")
+}
+
+func TestExport_ClampsFilter(t *testing.T) {
+	source := &fakeExportSource{}
+	svc := NewGraphExportService(source)
+
+	require.NoError(t, svc.Export(context.Background(), graph.FormatDOT, graph.ExportFilter{Depth: 99, MaxEvents: 1e6}, &bytes.Buffer{}))
+	assert.Equal(t, graph.MaxExportDepth, source.received.Depth)
+	assert.Equal(t, graph.MaxExportMaxEvents, source.received.MaxEvents)
+}
+
+func TestExport_GraphMLIsWellFormedAndDeduplicated(t *testing.T) {
+	svc := NewGraphExportService(&fakeExportSource{pages: samplePages()})
+	var output bytes.Buffer
+	require.NoError(t, svc.Export(context.Background(), graph.FormatGraphML, graph.ExportFilter{}, &output))
+
+	var document struct {
+		Graph struct {
+			Nodes []struct {
+				ID string `xml:"id,attr"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+	require.NoError(t, xml.Unmarshal(output.Bytes(), &document))
+	assert.Len(t, document.Graph.Nodes, 4)
+	assert.Len(t, document.Graph.Edges, 3)
+	assert.Contains(t, output.String(), "Joe &#34;Amtrak&#34; Biden &amp; co &lt;3")
+}
+
+func TestExport_GEXFWritesNodesBeforeEdges(t *testing.T) {
+	svc := NewGraphExportService(&fakeExportSource{pages: samplePages()})
+	var output bytes.Buffer
+	require.NoError(t, svc.Export(context.Background(), graph.FormatGEXF, graph.ExportFilter{}, &output))
+
+	var document struct {
+		Graph struct {
+			Nodes []struct {
+				ID string `xml:"id,attr"`
+			} `xml:"nodes>node"`
+			Edges []struct {
+				Label string `xml:"label,attr"`
+			} `xml:"edges>edge"`
+		} `xml:"graph"`
+	}
+	require.NoError(t, xml.Unmarshal(output.Bytes(), &document))
+	assert.Len(t, document.Graph.Nodes, 4)
+	require.Len(t, document.Graph.Edges, 3)
+	assert.Equal(t, graph.RelationCausedBy, document.Graph.Edges[0].Label)
+	assert.Less(t, strings.LastIndex(output.String(), "<node "), strings.Index(output.String(), "<edge "))
+}
+
+func TestExport_DOTEscapesLabels(t *testing.T) {
+	svc := NewGraphExportService(&fakeExportSource{pages: samplePages()})
+	var output bytes.Buffer
+	require.NoError(t, svc.Export(context.Background(), graph.FormatDOT, graph.ExportFilter{}, &output))
+
+	dot := output.String()
+	assert.True(t, strings.HasPrefix(dot, "digraph truthweave {"))
+	assert.Contains(t, dot, `label="Joe \"Amtrak\" Biden & co <3"`)
+	assert.Contains(t, dot, `"0x2" -> "0x1" [label="caused_by"];`)
+	assert.Equal(t, 3, strings.Count(dot, "->"))
+}
+
+func TestExport_JSONLDUsesStableIdentifiers(t *testing.T) {
+	svc := NewGraphExportService(&fakeExportSource{pages: samplePages()})
+	var output bytes.Buffer
+	require.NoError(t, svc.Export(context.Background(), graph.FormatJSONLD, graph.ExportFilter{}, &output))
+
+	var document struct {
+		Context map[string]string        `json:"@context"`
+		Graph   []map[string]interface{} `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal(output.Bytes(), &document))
+	assert.Equal(t, "https://schema.org/", document.Context["@vocab"])
+	require.Len(t, document.Graph, 7)
+
+	assert.Equal(t, "urn:truthweave:event:evt-1", document.Graph[0]["@id"])
+	assert.Equal(t, "NewsArticle", document.Graph[2]["@type"])
+	assert.Equal(t, "https://example.com/a?x=1&y=2", document.Graph[2]["@id"])
+	assert.Equal(t, "https://www.wikidata.org/wiki/Q6279", document.Graph[3]["sameAs"])
+
+	// [RO] Muchia caused_by: fragment pe nodul sursă, cu ținta după @id public.
+	assert.Equal(t, "urn:truthweave:event:evt-2", document.Graph[4]["@id"])
+	assert.Equal(t, map[string]interface{}{"@id": "urn:truthweave:event:evt-1"}, document.Graph[4]["tw:causedBy"])
+}
+
+func TestExport_ReturnsSourceErrors(t *testing.T) {
+	broken := errors.New("dgraph unavailable")
+	svc := NewGraphExportService(&fakeExportSource{err: broken})
+
+	var output bytes.Buffer
+	assert.ErrorIs(t, svc.Export(context.Background(), graph.FormatGraphML, graph.ExportFilter{}, &output), broken)
+	assert.Zero(t, output.Len(), "[RO] Sursa a căzut înainte de prima pagină -> nimic scris, handler-ul poate răspunde 5xx")
+}
+
+func TestExport_EmptySubgraphIsStillADocument(t *testing.T) {
+	svc := NewGraphExportService(&fakeExportSource{})
+	var output bytes.Buffer
+
+	require.NoError(t, svc.Export(context.Background(), graph.FormatGraphML, graph.ExportFilter{}, &output))
+	assert.NoError(t, xml.Unmarshal(output.Bytes(), new(struct{})))
+}
@@ -0,0 +1,311 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourorg/truthweave/internal/domain/graph"
+)
+
+// [RO] Escapare XML pentru atribute și text
+func xmlEscape(value string) string {
+	var escaped bytes.Buffer
+	_ = xml.EscapeText(&escaped, []byte(value))
+	return escaped.String()
+}
+
+func formatTimestamp(timestamp time.Time) string {
+	if timestamp.IsZero() {
+		return ""
+	}
+	return timestamp.UTC().Format(time.RFC3339)
+}
+
+// ---------------------------------------------------------------------------
+// [RO] GraphML (yEd, NetworkX, Cytoscape)
+// ---------------------------------------------------------------------------
+
+type graphMLExportWriter struct {
+	output io.Writer
+}
+
+func (writer *graphMLExportWriter) Begin() error {
+	_, err := io.WriteString(writer.output, `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="kind" for="node" attr.name="kind" attr.type="string"/>
+  <key id="key" for="node" attr.name="key" attr.type="string"/>
+  <key id="label" for="node" attr.name="label" attr.type="string"/>
+  <key id="timestamp" for="node" attr.name="timestamp" attr.type="string"/>
+  <key id="score" for="node" attr.name="score" attr.type="double"/>
+  <key id="wikidata" for="node" attr.name="wikidata_qid" attr.type="string"/>
+  <key id="relation" for="edge" attr.name="relation" attr.type="string"/>
+  <graph id="truthweave" edgedefault="directed">
+`)
+	return err
+}
+
+func (writer *graphMLExportWriter) WriteNode(node graph.Node) error {
+	var element strings.Builder
+	fmt.Fprintf(&element, "    <node id=\"%s\">\n", xmlEscape(node.ID))
+	data := func(key string, value string) {
+		if value != "" {
+			fmt.Fprintf(&element, "      <data key=\"%s\">%s</data>\n", key, xmlEscape(value))
+		}
+	}
+	data("kind", node.Kind)
+	data("key", node.Key)
+	data("label", node.Label)
+	data("timestamp", formatTimestamp(node.Timestamp))
+	if node.Score != 0 {
+		data("score", strconv.FormatFloat(node.Score, 'f', -1, 64))
+	}
+	data("wikidata", node.WikidataQID)
+	element.WriteString("    </node>\n")
+
+	_, err := io.WriteString(writer.output, element.String())
+	return err
+}
+
+func (writer *graphMLExportWriter) WriteEdge(edge graph.Edge) error {
+	_, err := fmt.Fprintf(writer.output, "    <edge source=\"%s\" target=\"%s\">\n      <data key=\"relation\">%s</data>\n    </edge>\n",
+		xmlEscape(edge.Source), xmlEscape(edge.Target), xmlEscape(edge.Relation))
+	return err
+}
+
+func (writer *graphMLExportWriter) End() error {
+	_, err := io.WriteString(writer.output, "  </graph>\n</graphml>\n")
+	return err
+}
+
+// ---------------------------------------------------------------------------
+// [RO] GEXF 1.3 (Gephi)
+// GEXF cere toate nodurile înaintea tuturor muchiilor, deci muchiile sunt ținute
+// în memorie până la final (sunt mici: trei ID-uri scurte).
+// ---------------------------------------------------------------------------
+
+type gexfExportWriter struct {
+	output io.Writer
+	edges  []graph.Edge
+}
+
+func (writer *gexfExportWriter) Begin() error {
+	_, err := io.WriteString(writer.output, `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://gexf.net/1.3" version="1.3">
+  <graph defaultedgetype="directed" mode="static">
+    <attributes class="node">
+      <attribute id="kind" title="kind" type="string"/>
+      <attribute id="key" title="key" type="string"/>
+      <attribute id="timestamp" title="timestamp" type="string"/>
+      <attribute id="score" title="score" type="double"/>
+      <attribute id="wikidata" title="wikidata_qid" type="string"/>
+    </attributes>
+    <nodes>
+`)
+	return err
+}
+
+func (writer *gexfExportWriter) WriteNode(node graph.Node) error {
+	var element strings.Builder
+	fmt.Fprintf(&element, "      <node id=\"%s\" label=\"%s\">\n        <attvalues>\n", xmlEscape(node.ID), xmlEscape(node.Label))
+	attribute := func(key string, value string) {
+		if value != "" {
+			fmt.Fprintf(&element, "          <attvalue for=\"%s\" value=\"%s\"/>\n", key, xmlEscape(value))
+		}
+	}
+	attribute("kind", node.Kind)
+	attribute("key", node.Key)
+	attribute("timestamp", formatTimestamp(node.Timestamp))
+	if node.Score != 0 {
+		attribute("score", strconv.FormatFloat(node.Score, 'f', -1, 64))
+	}
+	attribute("wikidata", node.WikidataQID)
+	element.WriteString("        </attvalues>\n      </node>\n")
+
+	_, err := io.WriteString(writer.output, element.String())
+	return err
+}
+
+func (writer *gexfExportWriter) WriteEdge(edge graph.Edge) error {
+	writer.edges = append(writer.edges, edge)
+	return nil
+}
+
+func (writer *gexfExportWriter) End() error {
+	if _, err := io.WriteString(writer.output, "    </nodes>\n    <edges>\n"); err != nil {
+		return err
+	}
+	for index, edge := range writer.edges {
+		if _, err := fmt.Fprintf(writer.output, "      <edge id=\"e%d\" source=\"%s\" target=\"%s\" label=\"%s\"/>\n",
+			index, xmlEscape(edge.Source), xmlEscape(edge.Target), xmlEscape(edge.Relation)); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(writer.output, "    </edges>\n  </graph>\n</gexf>\n")
+	return err
+}
+
+// ---------------------------------------------------------------------------
+// [RO] DOT (Graphviz)
+// ---------------------------------------------------------------------------
+
+type dotExportWriter struct {
+	output io.Writer
+}
+
+// [RO] Identificator DOT între ghilimele (ghilimelele, backslash-ul și liniile noi sunt escapate)
+func dotQuote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", "")
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// [RO] Forme vizuale per tip de nod
+var dotShapes = map[string]string{
+	graph.KindEvent:   "box",
+	graph.KindArticle: "note",
+	graph.KindPlace:   "house",
+}
+
+func (writer *dotExportWriter) Begin() error {
+	_, err := io.WriteString(writer.output, "digraph truthweave {\n  rankdir=LR;\n")
+	return err
+}
+
+func (writer *dotExportWriter) WriteNode(node graph.Node) error {
+	shape, ok := dotShapes[node.Kind]
+	if !ok {
+		shape = "ellipse"
+	}
+	_, err := fmt.Fprintf(writer.output, "  %s [label=%s, kind=%s, key=%s, shape=%s];\n",
+		dotQuote(node.ID), dotQuote(node.Label), dotQuote(node.Kind), dotQuote(node.Key), shape)
+	return err
+}
+
+func (writer *dotExportWriter) WriteEdge(edge graph.Edge) error {
+	_, err := fmt.Fprintf(writer.output, "  %s -> %s [label=%s];\n",
+		dotQuote(edge.Source), dotQuote(edge.Target), dotQuote(edge.Relation))
+	return err
+}
+
+func (writer *dotExportWriter) End() error {
+	_, err := io.WriteString(writer.output, "}\n")
+	return err
+}
+
+// ---------------------------------------------------------------------------
+// [RO] JSON-LD (schema.org + vocabularul TruthWeave)
+// Articolele au ca @id URL-ul original; evenimentele și entitățile primesc URN-uri stabile,
+// iar entitățile legate de Wikidata au `sameAs`. Muchiile devin fragmente care
+// completează nodul sursă (procesoarele JSON-LD le îmbină după @id).
+// ---------------------------------------------------------------------------
+
+const jsonLDContext = `{"@vocab":"https://schema.org/","tw":"https://truthweave.org/ns#"}`
+
+var jsonLDTypes = map[string]string{
+	graph.KindArticle:      "NewsArticle",
+	graph.KindEvent:        "Event",
+	graph.KindPerson:       "Person",
+	graph.KindOrganization: "Organization",
+	graph.KindPlace:        "Place",
+}
+
+var jsonLDRelations = map[string]string{
+	graph.RelationMentions:   "mentions",
+	graph.RelationReportedBy: "subjectOf",
+	graph.RelationCausedBy:   "tw:causedBy",
+}
+
+type jsonLDExportWriter struct {
+	output  io.Writer
+	iris    map[string]string // UID Dgraph -> @id
+	written bool
+}
+
+func (writer *jsonLDExportWriter) Begin() error {
+	_, err := io.WriteString(writer.output, `{"@context":`+jsonLDContext+`,"@graph":[`)
+	return err
+}
+
+func (writer *jsonLDExportWriter) iri(node graph.Node) string {
+	switch {
+	case node.Kind == graph.KindArticle && node.Key != "":
+		return node.Key
+	case node.Kind == graph.KindEvent && node.Key != "":
+		return "urn:truthweave:event:" + node.Key
+	case node.Key != "":
+		return "urn:truthweave:entity:" + node.Key
+	default:
+		return "_:" + node.ID
+	}
+}
+
+func (writer *jsonLDExportWriter) writeObject(object map[string]interface{}) error {
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	if writer.written {
+		if _, err := io.WriteString(writer.output, ","); err != nil {
+			return err
+		}
+	}
+	writer.written = true
+	_, err = writer.output.Write(encoded)
+	return err
+}
+
+func (writer *jsonLDExportWriter) WriteNode(node graph.Node) error {
+	iri := writer.iri(node)
+	writer.iris[node.ID] = iri
+
+	nodeType, ok := jsonLDTypes[node.Kind]
+	if !ok {
+		nodeType = "Thing"
+	}
+	object := map[string]interface{}{"@id": iri, "@type": nodeType}
+
+	switch node.Kind {
+	case graph.KindArticle:
+		object["headline"] = node.Label
+		object["url"] = node.Key
+		if timestamp := formatTimestamp(node.Timestamp); timestamp != "" {
+			object["datePublished"] = timestamp
+		}
+	case graph.KindEvent:
+		object["name"] = node.Label
+		if timestamp := formatTimestamp(node.Timestamp); timestamp != "" {
+			object["startDate"] = timestamp
+		}
+	default:
+		object["name"] = node.Label
+		if node.WikidataQID != "" {
+			object["sameAs"] = "https://www.wikidata.org/wiki/" + node.WikidataQID
+		}
+	}
+	if node.Score != 0 {
+		object["tw:score"] = node.Score
+	}
+
+	return writer.writeObject(object)
+}
+
+func (writer *jsonLDExportWriter) WriteEdge(edge graph.Edge) error {
+	property, ok := jsonLDRelations[edge.Relation]
+	if !ok {
+		property = "tw:" + edge.Relation
+	}
+	return writer.writeObject(map[string]interface{}{
+		"@id":    writer.iris[edge.Source],
+		property: map[string]string{"@id": writer.iris[edge.Target]},
+	})
+}
+
+func (writer *jsonLDExportWriter) End() error {
+	_, err := io.WriteString(writer.output, "]}\n")
+	return err
+}
@@ -0,0 +1,70 @@
+package perspective
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/perspective"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+	"go.temporal.io/sdk/client"
+)
+
+// [RO] Numele Workflow-ului de Regenerare
+const (
+	PerspectiveRefreshWorkflowIDPrefix = "perspectives-"
+	storyPerspectiveWorkflowName       = "StoryPerspectiveWorkflow"
+)
+
+// [RO] Serviciul Perspectivelor unei Povești
+//
+// Servește punctele de vedere etichetate ale unui grup de articole (aceeași poveste) și istoricul
+// reviziilor lor. Generarea rulează în StoryPerspectiveWorkflow, pornit automat după fiecare articol
+// nou din poveste; regenerarea manuală (Admin) pornește același workflow.
+type StoryPerspectiveService struct {
+	perspectives     perspective.PerspectivePersistenceInterface
+	workflowLauncher ports.WorkflowOrchestratorLauncher
+}
+
+// [RO] Constructor Serviciu Perspective
+func NewStoryPerspectiveService(perspectives perspective.PerspectivePersistenceInterface, launcher ports.WorkflowOrchestratorLauncher) *StoryPerspectiveService {
+	return &StoryPerspectiveService{perspectives: perspectives, workflowLauncher: launcher}
+}
+
+// [RO] Perspectivele Poveștii (revision <= 0 = ultima revizie)
+func (service *StoryPerspectiveService) RetrievePerspectives(executionContext context.Context, storyClusterID uuid.UUID, revision int) (*perspective.PerspectiveSet, error) {
+	return service.perspectives.RetrievePerspectiveSet(executionContext, storyClusterID, revision)
+}
+
+// [RO] Istoricul Reviziilor (limită implicită și plafonată)
+func (service *StoryPerspectiveService) ListRevisions(executionContext context.Context, storyClusterID uuid.UUID, limit int) ([]perspective.RevisionSummary, error) {
+	if limit <= 0 {
+		limit = perspective.DefaultRevisionLimit
+	}
+	if limit > perspective.MaxRevisionLimit {
+		limit = perspective.MaxRevisionLimit
+	}
+
+	revisions, err := service.perspectives.ListPerspectiveRevisions(executionContext, storyClusterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if revisions == nil {
+		revisions = []perspective.RevisionSummary{}
+	}
+	return revisions, nil
+}
+
+// [RO] Regenerare Manuală (Admin)
+// Workflow-ul decide singur dacă articolele s-au schimbat; dacă rulează deja, primim execuția existentă.
+func (service *StoryPerspectiveService) RefreshPerspectives(executionContext context.Context, storyClusterID uuid.UUID) (string, error) {
+	options := client.StartWorkflowOptions{
+		ID:        PerspectiveRefreshWorkflowIDPrefix + storyClusterID.String(),
+		TaskQueue: "truthweave-task-queue",
+	}
+	run, err := service.workflowLauncher.ExecuteWorkflow(executionContext, options, storyPerspectiveWorkflowName, storyClusterID)
+	if err != nil {
+		return "", fmt.Errorf("[RO] Eroare: Regenerarea perspectivelor nu a putut fi pornită: %w", err)
+	}
+	return run.GetID(), nil
+}
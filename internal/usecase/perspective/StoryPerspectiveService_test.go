@@ -0,0 +1,77 @@
+package perspective
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/perspective"
+)
+
+// [RO] Revizii în memorie (reține limita cerută)
+type memoryPerspectiveRepository struct {
+	sets           []perspective.PerspectiveSet
+	requestedLimit int
+}
+
+func (repo *memoryPerspectiveRepository) RetrievePerspectiveCorpus(ctx context.Context, storyClusterID uuid.UUID, limit int) ([]perspective.SourceArticle, error) {
+	return nil, nil
+}
+
+func (repo *memoryPerspectiveRepository) SavePerspectiveSet(ctx context.Context, set perspective.PerspectiveSet) error {
+	repo.sets = append(repo.sets, set)
+	return nil
+}
+
+func (repo *memoryPerspectiveRepository) RetrievePerspectiveSet(ctx context.Context, storyClusterID uuid.UUID, revision int) (*perspective.PerspectiveSet, error) {
+	var found *perspective.PerspectiveSet
+	for index := range repo.sets {
+		set := &repo.sets[index]
+		if set.StoryClusterID != storyClusterID || (revision > 0 && set.Revision != revision) {
+			continue
+		}
+		if found == nil || set.Revision > found.Revision {
+			found = set
+		}
+	}
+	if found == nil {
+		return nil, perspective.ErrPerspectivesNotFound
+	}
+	return found, nil
+}
+
+func (repo *memoryPerspectiveRepository) ListPerspectiveRevisions(ctx context.Context, storyClusterID uuid.UUID, limit int) ([]perspective.RevisionSummary, error) {
+	repo.requestedLimit = limit
+	return nil, nil
+}
+
+func TestStoryPerspectiveService_ServesLatestOrRequestedRevision(t *testing.T) {
+	clusterID := uuid.New()
+	repo := &memoryPerspectiveRepository{sets: []perspective.PerspectiveSet{
+		{StoryClusterID: clusterID, Revision: 1},
+		{StoryClusterID: clusterID, Revision: 2},
+	}}
+	service := NewStoryPerspectiveService(repo, nil)
+
+	latest, err := service.RetrievePerspectives(context.Background(), clusterID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, latest.Revision)
+
+	first, err := service.RetrievePerspectives(context.Background(), clusterID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Revision)
+
+	_, err = service.RetrievePerspectives(context.Background(), uuid.New(), 0)
+	assert.ErrorIs(t, err, perspective.ErrPerspectivesNotFound)
+
+	revisions, err := service.ListRevisions(context.Background(), clusterID, 0)
+	require.NoError(t, err)
+	assert.NotNil(t, revisions)
+	assert.Equal(t, perspective.DefaultRevisionLimit, repo.requestedLimit)
+
+	_, err = service.ListRevisions(context.Background(), clusterID, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, perspective.MaxRevisionLimit, repo.requestedLimit)
+}
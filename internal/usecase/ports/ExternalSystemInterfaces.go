@@ -2,8 +2,10 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/review"
 	"go.temporal.io/sdk/client"
 )
 
@@ -15,6 +17,19 @@ type ArtificialIntelligenceGateway interface {
 	AnalyzeAndNeutralizeNewsContent(ctx context.Context, rawContent string) (*article.AIAnalysisResult, error)
 	GenerateSemanticVector(ctx context.Context, text string) ([]float32, error)
 	ChatWithContext(ctx context.Context, query string, context string) (string, error)
+
+	// [RO] Aceeași întrebare, dar răspunsul vine bucată cu bucată (onToken), pe măsură ce e generat.
+	// Se oprește la anularea contextului sau la prima eroare întoarsă de onToken.
+	StreamChatWithContext(ctx context.Context, query string, context string, onToken func(token string) error) error
+}
+
+// [RO] Memoria Conversației (Oracle Chat cu mai multe replici)
+type ConversationMemoryGateway interface {
+	// [RO] "Și ce a urmat?" + istoric -> întrebare autonomă, bună pentru căutare
+	RewriteFollowUpQuestion(ctx context.Context, transcript string, question string) (string, error)
+
+	// [RO] Comprimă replicile vechi, păstrând faptele, entitățile și întrebările deschise
+	SummarizeConversation(ctx context.Context, previousSummary string, transcript string) (string, error)
 }
 
 // [RO] Poarta către Blockchain (Notarul Digital)
@@ -26,6 +41,14 @@ type BlockchainGateway interface {
 // [RO] Poarta către Orchestrare (Temporal)
 type WorkflowOrchestratorLauncher interface {
 	ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (client.WorkflowRun, error)
+
+	// [RO] Rebalansare Retroactivă a Grafului (debounce 0 = imediat)
+	ScheduleGraphRebalance(ctx context.Context, eventID string, debounce time.Duration) error
+}
+
+// [RO] Decizia Editorului către Workflow-ul Oprit la Verificare (semnal Temporal)
+type EditorialDecisionSignaler interface {
+	SignalEditorialDecision(ctx context.Context, workflowID string, runID string, decision review.EditorialDecision) error
 }
 
 // [RO] Poarta către Știri Globale (NewsAPI)
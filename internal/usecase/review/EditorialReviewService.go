@@ -0,0 +1,65 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourorg/truthweave/internal/domain/review"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+)
+
+// [RO] Limitele Listei de Verificare
+const (
+	DefaultPendingLimit = 50
+	MaxPendingLimit     = 200
+)
+
+// [RO] Serviciul Cozii de Verificare Editorială
+//
+// Lista ciornelor care așteaptă un editor și trimiterea deciziei către workflow-ul oprit.
+// Starea finală o scrie workflow-ul (CloseEditorialReviewActivity), după ce aplică decizia.
+type EditorialReviewService struct {
+	reviews  review.EditorialReviewPersistenceInterface
+	signaler ports.EditorialDecisionSignaler
+	now      func() time.Time
+}
+
+// [RO] Constructor Serviciu Verificare
+func NewEditorialReviewService(reviews review.EditorialReviewPersistenceInterface, signaler ports.EditorialDecisionSignaler) *EditorialReviewService {
+	return &EditorialReviewService{reviews: reviews, signaler: signaler, now: time.Now}
+}
+
+// [RO] Ciornele în Așteptare (cele mai vechi întâi)
+func (service *EditorialReviewService) ListPendingReviews(executionContext context.Context, limit int) ([]review.EditorialReview, error) {
+	if limit <= 0 {
+		limit = DefaultPendingLimit
+	}
+	if limit > MaxPendingLimit {
+		limit = MaxPendingLimit
+	}
+	return service.reviews.ListPendingEditorialReviews(executionContext, limit)
+}
+
+// [RO] Trimite Decizia Editorului
+// Decizia e validată aici, ca editorul să afle imediat ce e greșit; workflow-ul o validează din nou.
+func (service *EditorialReviewService) SubmitEditorialDecision(executionContext context.Context, articleID uuid.UUID, decision review.EditorialDecision) (*review.EditorialReview, error) {
+	if err := decision.Validate(); err != nil {
+		return nil, err
+	}
+
+	item, err := service.reviews.RetrieveEditorialReview(executionContext, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status != review.StatusPending {
+		return nil, review.ErrReviewAlreadyResolved
+	}
+
+	decision.DecidedAt = service.now()
+	if err := service.signaler.SignalEditorialDecision(executionContext, item.WorkflowID, item.RunID, decision); err != nil {
+		return nil, err
+	}
+	item.Decision = &decision
+	return item, nil
+}
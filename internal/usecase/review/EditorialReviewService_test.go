@@ -0,0 +1,92 @@
+package review
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/review"
+)
+
+// [RO] Coadă în memorie
+type memoryReviewRepository struct {
+	items map[uuid.UUID]review.EditorialReview
+}
+
+func (repo *memoryReviewRepository) OpenEditorialReview(ctx context.Context, item review.EditorialReview) error {
+	repo.items[item.ArticleID] = item
+	return nil
+}
+
+func (repo *memoryReviewRepository) ListPendingEditorialReviews(ctx context.Context, limit int) ([]review.EditorialReview, error) {
+	var pending []review.EditorialReview
+	for _, item := range repo.items {
+		if item.Status == review.StatusPending && len(pending) < limit {
+			pending = append(pending, item)
+		}
+	}
+	return pending, nil
+}
+
+func (repo *memoryReviewRepository) RetrieveEditorialReview(ctx context.Context, articleID uuid.UUID) (*review.EditorialReview, error) {
+	item, ok := repo.items[articleID]
+	if !ok {
+		return nil, review.ErrReviewNotFound
+	}
+	return &item, nil
+}
+
+func (repo *memoryReviewRepository) CloseEditorialReview(ctx context.Context, resolution review.EditorialResolution) error {
+	item := repo.items[resolution.ArticleID]
+	item.Status = resolution.Status
+	repo.items[resolution.ArticleID] = item
+	return nil
+}
+
+// [RO] Temporal fals: reține semnalele trimise
+type recordingSignaler struct {
+	workflowIDs []string
+	decisions   []review.EditorialDecision
+}
+
+func (signaler *recordingSignaler) SignalEditorialDecision(ctx context.Context, workflowID string, runID string, decision review.EditorialDecision) error {
+	signaler.workflowIDs = append(signaler.workflowIDs, workflowID)
+	signaler.decisions = append(signaler.decisions, decision)
+	return nil
+}
+
+func TestEditorialReviewService_SubmitDecisionSignalsPausedWorkflow(t *testing.T) {
+	pendingID, resolvedID := uuid.New(), uuid.New()
+	repo := &memoryReviewRepository{items: map[uuid.UUID]review.EditorialReview{
+		pendingID:  {ArticleID: pendingID, WorkflowID: "analyze-http://contested.com", Status: review.StatusPending},
+		resolvedID: {ArticleID: resolvedID, WorkflowID: "analyze-http://old.com", Status: review.StatusApproved},
+	}}
+	signaler := &recordingSignaler{}
+	decidedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewEditorialReviewService(repo, signaler)
+	service.now = func() time.Time { return decidedAt }
+
+	item, err := service.SubmitEditorialDecision(context.Background(), pendingID, review.EditorialDecision{Action: review.DecisionReject, Reviewer: "ana", Note: "Unsourced claims."})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"analyze-http://contested.com"}, signaler.workflowIDs)
+	assert.Equal(t, decidedAt, signaler.decisions[0].DecidedAt)
+	assert.Equal(t, review.DecisionReject, item.Decision.Action)
+
+	_, err = service.SubmitEditorialDecision(context.Background(), resolvedID, review.EditorialDecision{Action: review.DecisionApprove, Reviewer: "ana"})
+	assert.ErrorIs(t, err, review.ErrReviewAlreadyResolved)
+
+	_, err = service.SubmitEditorialDecision(context.Background(), uuid.New(), review.EditorialDecision{Action: review.DecisionApprove, Reviewer: "ana"})
+	assert.ErrorIs(t, err, review.ErrReviewNotFound)
+
+	// [RO] Decizia invalidă nu ajunge la workflow
+	_, err = service.SubmitEditorialDecision(context.Background(), pendingID, review.EditorialDecision{Action: review.DecisionEdit, Reviewer: "ana"})
+	assert.Error(t, err)
+	assert.Len(t, signaler.decisions, 1)
+
+	pending, err := service.ListPendingReviews(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
@@ -0,0 +1,56 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/yourorg/truthweave/internal/domain/search"
+	"github.com/yourorg/truthweave/internal/usecase/ports"
+)
+
+// [RO] Serviciul de Căutare Hibridă
+//
+// Combină căutarea după cuvinte (exactă, bună pentru nume și citate) cu cea după înțeles
+// (vectorială, bună pentru parafraze) prin Reciprocal Rank Fusion.
+// Dacă vectorul întrebării nu poate fi calculat, răspundem doar cu rezultatele full-text.
+type HybridSearchService struct {
+	repository             search.SearchPersistenceInterface
+	artificialIntelligence ports.ArtificialIntelligenceGateway
+}
+
+// [RO] Constructor Serviciu Căutare
+func NewHybridSearchService(repository search.SearchPersistenceInterface, ai ports.ArtificialIntelligenceGateway) *HybridSearchService {
+	return &HybridSearchService{repository: repository, artificialIntelligence: ai}
+}
+
+// [RO] Caută Știri
+func (service *HybridSearchService) SearchNewsArticles(executionContext context.Context, query search.SearchQuery) (*search.SearchResultPage, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	limit := query.CandidateLimit()
+
+	fullText, err := service.repository.SearchArticlesFullText(executionContext, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("full-text search failed: %w", err)
+	}
+
+	page := &search.SearchResultPage{Query: query.Text, Page: query.Page, PageSize: query.PageSize}
+
+	var semantic []search.SearchCandidate
+	embedding, err := service.artificialIntelligence.GenerateSemanticVector(executionContext, query.Text)
+	if err != nil {
+		log.Printf("Căutare fără componenta semantică (vectorul întrebării a eșuat): %v", err)
+	} else {
+		semantic, err = service.repository.SearchArticlesSemantic(executionContext, embedding, query, limit)
+		if err != nil {
+			return nil, fmt.Errorf("semantic search failed: %w", err)
+		}
+		page.Semantic = true
+	}
+
+	fused := search.FuseReciprocalRank(fullText, semantic, search.DefaultFusionK)
+	page.Results, page.HasMore = search.Paginate(fused, query)
+	return page, nil
+}
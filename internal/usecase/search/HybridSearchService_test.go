@@ -0,0 +1,82 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourorg/truthweave/internal/domain/article"
+	"github.com/yourorg/truthweave/internal/domain/search"
+)
+
+// [RO] Depozit fals: liste scriptate; reține dacă s-a cerut căutarea semantică.
+type fakeSearchRepository struct {
+	fullText       []search.SearchCandidate
+	semantic       []search.SearchCandidate
+	semanticCalled bool
+	limit          int
+}
+
+func (repo *fakeSearchRepository) SearchArticlesFullText(ctx context.Context, query search.SearchQuery, limit int) ([]search.SearchCandidate, error) {
+	repo.limit = limit
+	return repo.fullText, nil
+}
+
+func (repo *fakeSearchRepository) SearchArticlesSemantic(ctx context.Context, embedding []float32, query search.SearchQuery, limit int) ([]search.SearchCandidate, error) {
+	repo.semanticCalled = true
+	return repo.semantic, nil
+}
+
+// [RO] Oracol fals: doar vectorul contează pentru căutare.
+type fakeEmbeddingGateway struct {
+	err error
+}
+
+func (gateway *fakeEmbeddingGateway) AnalyzeAndNeutralizeNewsContent(ctx context.Context, rawContent string) (*article.AIAnalysisResult, error) {
+	return nil, nil
+}
+
+func (gateway *fakeEmbeddingGateway) GenerateSemanticVector(ctx context.Context, text string) ([]float32, error) {
+	return []float32{0.1, 0.2}, gateway.err
+}
+
+func (gateway *fakeEmbeddingGateway) ChatWithContext(ctx context.Context, query string, context string) (string, error) {
+	return "", nil
+}
+
+func (gateway *fakeEmbeddingGateway) StreamChatWithContext(ctx context.Context, query string, context string, onToken func(token string) error) error {
+	return nil
+}
+
+func TestSearchNewsArticles_FusesBothLists(t *testing.T) {
+	shared, semanticOnly := uuid.New(), uuid.New()
+	repo := &fakeSearchRepository{
+		fullText: []search.SearchCandidate{{Article: article.NewsArticleEntity{ID: shared}, Rank: 1}},
+		semantic: []search.SearchCandidate{{Article: article.NewsArticleEntity{ID: semanticOnly}, Rank: 1}, {Article: article.NewsArticleEntity{ID: shared}, Rank: 2}},
+	}
+	service := NewHybridSearchService(repo, &fakeEmbeddingGateway{})
+
+	page, err := service.SearchNewsArticles(context.Background(), search.SearchQuery{Text: "euro", PageSize: 1})
+	require.NoError(t, err)
+
+	assert.True(t, page.Semantic)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, shared, page.Results[0].ArticleID)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, 1+20, repo.limit)
+}
+
+func TestSearchNewsArticles_FallsBackToFullTextWhenEmbeddingFails(t *testing.T) {
+	repo := &fakeSearchRepository{fullText: []search.SearchCandidate{{Article: article.NewsArticleEntity{ID: uuid.New()}, Rank: 1}}}
+	service := NewHybridSearchService(repo, &fakeEmbeddingGateway{err: errors.New("quota")})
+
+	page, err := service.SearchNewsArticles(context.Background(), search.SearchQuery{Text: "euro"})
+	require.NoError(t, err)
+
+	assert.False(t, page.Semantic)
+	assert.False(t, repo.semanticCalled)
+	assert.Len(t, page.Results, 1)
+}
@@ -41,3 +41,380 @@ CREATE TABLE IF NOT EXISTS ads (
 );
 
 CREATE INDEX IF NOT EXISTS idx_ads_active ON ads(is_active, priority DESC);
+
+-- 004_story_clusters.up.sql
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS story_cluster_id UUID;
+
+CREATE INDEX IF NOT EXISTS articles_story_cluster_idx ON articles (story_cluster_id);
+
+-- 005_entity_resolution.up.sql
+CREATE TABLE IF NOT EXISTS entities (
+    id UUID PRIMARY KEY,
+    canonical_name TEXT NOT NULL,
+    type TEXT NOT NULL, -- Person, Organization, Place
+    embedding vector(768),
+    merged_into UUID REFERENCES entities(id),
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS entities_embedding_idx ON entities USING hnsw (embedding vector_cosine_ops);
+
+CREATE TABLE IF NOT EXISTS entity_aliases (
+    entity_id UUID NOT NULL REFERENCES entities(id),
+    alias TEXT NOT NULL,
+    alias_normalized TEXT NOT NULL,
+    type TEXT NOT NULL,
+    PRIMARY KEY (alias_normalized, type, entity_id)
+);
+
+CREATE INDEX IF NOT EXISTS entity_aliases_entity_idx ON entity_aliases (entity_id);
+
+CREATE TABLE IF NOT EXISTS article_entity_mentions (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    entity_id UUID NOT NULL REFERENCES entities(id),
+    surface_form TEXT NOT NULL,
+    PRIMARY KEY (article_id, entity_id, surface_form)
+);
+
+CREATE INDEX IF NOT EXISTS article_entity_mentions_entity_idx ON article_entity_mentions (entity_id);
+
+CREATE TABLE IF NOT EXISTS entity_merge_audit (
+    id UUID PRIMARY KEY,
+    action TEXT NOT NULL, -- merge, split
+    source_entity_id UUID NOT NULL REFERENCES entities(id),
+    target_entity_id UUID NOT NULL REFERENCES entities(id),
+    moved_aliases JSONB NOT NULL DEFAULT '[]',
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS entity_merge_audit_source_idx ON entity_merge_audit (source_entity_id);
+CREATE INDEX IF NOT EXISTS entity_merge_audit_target_idx ON entity_merge_audit (target_entity_id);
+
+-- 006_knowledge_base.up.sql
+CREATE TABLE IF NOT EXISTS knowledge_base_entries (
+    qid TEXT PRIMARY KEY, -- ex: Q6279
+    label TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    type TEXT NOT NULL, -- Person, Organization, Place
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    sitelinks INT NOT NULL DEFAULT 0,
+    imported_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS knowledge_base_aliases (
+    alias_normalized TEXT NOT NULL,
+    type TEXT NOT NULL,
+    qid TEXT NOT NULL REFERENCES knowledge_base_entries(qid) ON DELETE CASCADE,
+    PRIMARY KEY (alias_normalized, type, qid)
+);
+
+ALTER TABLE entities ADD COLUMN IF NOT EXISTS wikidata_qid TEXT REFERENCES knowledge_base_entries(qid);
+
+CREATE INDEX IF NOT EXISTS entities_wikidata_qid_idx ON entities (wikidata_qid);
+
+-- Gaia map: real coordinates only ("No Null Island") and a spatial filter index
+
+-- Older rows were written with the model's (0,0) default; they are not real places.
+UPDATE articles SET location_lat = NULL, location_lng = NULL
+WHERE location_lat = 0 AND location_lng = 0;
+
+CREATE INDEX IF NOT EXISTS articles_geo_idx ON articles (location_lat, location_lng, published_at)
+WHERE location_lat IS NOT NULL AND location_lng IS NOT NULL;
+
+-- Country / region codes from the offline gazetteer (ISO 3166-1 alpha-2 and ISO 3166-2)
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS country_code TEXT;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS region_code TEXT;
+
+-- Feed and map filters by country, newest first
+CREATE INDEX IF NOT EXISTS articles_country_idx ON articles (country_code, published_at DESC)
+WHERE country_code IS NOT NULL;
+
+-- Topic sector from the analysis (see article.Sectors) and hourly / daily analytics rollups
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS sector TEXT;
+
+-- Rollup refresh scans a published_at window
+CREATE INDEX IF NOT EXISTS articles_published_at_idx ON articles (published_at);
+
+-- One row per (granularity, dimension, value, bucket). Only buckets with articles are stored.
+-- dimension: 'global' (value '*'), 'country' (ISO code), 'sector', 'narrative' (narrative.id)
+CREATE TABLE IF NOT EXISTS analytics_rollups (
+    granularity TEXT NOT NULL CHECK (granularity IN ('hour', 'day')),
+    dimension TEXT NOT NULL,
+    dimension_value TEXT NOT NULL,
+    bucket_start TIMESTAMPTZ NOT NULL,
+    article_count INTEGER NOT NULL,
+    truth_score_sum DOUBLE PRECISION NOT NULL,
+    emotion_counts JSONB NOT NULL DEFAULT '{}'::jsonb,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (granularity, dimension, dimension_value, bucket_start)
+);
+
+-- Trend / spike signals over entity-mention and story-cluster volumes (EWMA z-score)
+
+CREATE TABLE IF NOT EXISTS trend_signals (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL, -- entity, story_cluster
+    key TEXT NOT NULL,
+    label TEXT NOT NULL DEFAULT '',
+    article_count INTEGER NOT NULL,
+    baseline DOUBLE PRECISION NOT NULL,
+    std_dev DOUBLE PRECISION NOT NULL,
+    z_score DOUBLE PRECISION NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS trend_signals_detected_idx ON trend_signals (detected_at DESC);
+CREATE INDEX IF NOT EXISTS trend_signals_key_idx ON trend_signals (kind, key, detected_at DESC);
+
+-- Full-text document for hybrid search (title > summary > content).
+-- 'simple' avoids stemming for a single language: the corpus is multilingual.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_document tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(summary, '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(content, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS articles_search_document_idx ON articles USING gin (search_document);
+
+-- Publisher domain ("https://www.reuters.com/world/..." -> "reuters.com") for the source filter.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS source_domain TEXT
+    GENERATED ALWAYS AS (
+        lower(substring(original_url FROM '^[A-Za-z][A-Za-z0-9+.-]*://(?:www\.)?([^/:?#]+)'))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS articles_source_domain_idx ON articles (source_domain);
+
+-- Syndicated / republished copies: not analysed again, linked to the canonical article.
+CREATE TABLE IF NOT EXISTS article_duplicates (
+    original_url TEXT PRIMARY KEY,
+    duplicate_of UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    similarity DOUBLE PRECISION NOT NULL,
+    method TEXT NOT NULL, -- semantic
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_duplicates_duplicate_of_idx ON article_duplicates (duplicate_of);
+
+-- Lexical fingerprints checked before embedding: exact copies (content_hash) and
+-- near copies (64-bit SimHash, LSH over four 16-bit bands).
+-- article_duplicates.method (012) now also records 'exact' and 'simhash' besides 'semantic'.
+CREATE TABLE IF NOT EXISTS article_fingerprints (
+    article_id UUID PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    content_hash TEXT NOT NULL,
+    simhash BIGINT NOT NULL,
+    band_0 INTEGER NOT NULL,
+    band_1 INTEGER NOT NULL,
+    band_2 INTEGER NOT NULL,
+    band_3 INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_fingerprints_content_hash_idx ON article_fingerprints (content_hash);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_0_idx ON article_fingerprints (band_0);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_1_idx ON article_fingerprints (band_1);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_2_idx ON article_fingerprints (band_2);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_3_idx ON article_fingerprints (band_3);
+
+-- Multi-turn Oracle chat: sessions (with a rolling summary of older turns) and their turns.
+CREATE TABLE IF NOT EXISTS chat_sessions (
+    id UUID PRIMARY KEY,
+    article_id UUID REFERENCES articles(id) ON DELETE SET NULL,
+    summary TEXT NOT NULL DEFAULT '',
+    summarized_through INTEGER NOT NULL DEFAULT 0, -- turns 1..N are covered by summary
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS chat_turns (
+    session_id UUID NOT NULL REFERENCES chat_sessions(id) ON DELETE CASCADE,
+    sequence INTEGER NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('user', 'assistant')),
+    content TEXT NOT NULL,
+    retrieval_query TEXT NOT NULL DEFAULT '',
+    citations TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (session_id, sequence)
+);
+
+-- Grounded Oracle answers: each assistant turn keeps the numbered citations with the exact
+-- supporting snippet and its character offsets in the article content.
+-- `citations` (TEXT[]) keeps only the cited article IDs; older turns have no snippets.
+ALTER TABLE chat_turns ADD COLUMN IF NOT EXISTS grounded_citations JSONB NOT NULL DEFAULT '[]';
+
+-- Chunked retrieval index: overlapping passages of each article's content with their own
+-- embeddings. Offsets are character positions in articles.content, end-exclusive.
+CREATE TABLE IF NOT EXISTS article_chunks (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    chunk_index INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    embedding vector(768) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (article_id, chunk_index)
+);
+
+CREATE INDEX IF NOT EXISTS article_chunks_embedding_idx ON article_chunks USING hnsw (embedding vector_cosine_ops);
+
+-- Integrity review of AI analyses: reasons an article needs a human look (injected instructions
+-- in the scraped text, perfect score with a verbatim "rewrite", ...). Empty = nothing suspicious.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS review_flags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS articles_review_flags_idx ON articles (processed_at DESC) WHERE cardinality(review_flags) > 0;
+
+-- Editorial review queue: analyses held back from publication until an editor approves, edits or
+-- rejects them (or the deadline passes). The draft is not in `articles` yet, hence no foreign key.
+CREATE TABLE IF NOT EXISTS editorial_reviews (
+    article_id UUID PRIMARY KEY,
+    workflow_id TEXT NOT NULL,
+    run_id TEXT NOT NULL DEFAULT '',
+    reasons TEXT[] NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'pending',
+    draft JSONB NOT NULL,
+    decision JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deadline TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS editorial_reviews_pending_idx ON editorial_reviews (created_at) WHERE status = 'pending';
+
+-- Reader disputes of an article's truth score, bias rating or a causal link, with evidence URLs.
+-- cause_event_id is the disputed parent event (event.caused_by in the graph), empty otherwise.
+CREATE TABLE IF NOT EXISTS article_disputes (
+    id UUID PRIMARY KEY,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    target TEXT NOT NULL,
+    cause_event_id TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    evidence_urls TEXT[] NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open',
+    resolution_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_disputes_open_idx ON article_disputes (article_id, created_at) WHERE status = 'open';
+
+-- Published outcome of a dispute re-analysis (corrected or upheld), shown on the article.
+CREATE TABLE IF NOT EXISTS article_resolution_notes (
+    id UUID PRIMARY KEY,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    dispute_ids UUID[] NOT NULL,
+    targets TEXT[] NOT NULL,
+    outcome TEXT NOT NULL,
+    previous_truth_score DOUBLE PRECISION NOT NULL,
+    truth_score DOUBLE PRECISION NOT NULL,
+    previous_bias_rating TEXT NOT NULL DEFAULT '',
+    bias_rating TEXT NOT NULL DEFAULT '',
+    retracted_cause_event_ids TEXT[] NOT NULL DEFAULT '{}',
+    note TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_resolution_notes_article_idx ON article_resolution_notes (article_id, created_at DESC);
+
+-- Reader ratings (helpfulness / fairness) of an article or its counter-argument.
+-- rater_id is a pseudonymous client identifier; a new rating replaces the rater's previous one.
+CREATE TABLE IF NOT EXISTS community_ratings (
+    item_type TEXT NOT NULL,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    dimension TEXT NOT NULL,
+    rater_id TEXT NOT NULL,
+    rating TEXT NOT NULL,
+    rated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (item_type, article_id, dimension, rater_id)
+);
+
+CREATE INDEX IF NOT EXISTS community_ratings_dimension_idx ON community_ratings (dimension);
+
+-- Community trust signal from the bridging matrix factorization, kept apart from articles.truth_score.
+CREATE TABLE IF NOT EXISTS community_scores (
+    item_type TEXT NOT NULL,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    dimension TEXT NOT NULL,
+    bridging_score DOUBLE PRECISION NOT NULL,
+    polarization DOUBLE PRECISION NOT NULL,
+    rating_count INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    scored_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (item_type, article_id, dimension)
+);
+
+-- The single "Devil's Advocate" counter-argument from the analysis, now kept and served with the article.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS counter_argument TEXT NOT NULL DEFAULT '';
+
+-- Labelled viewpoints (2-4) per story cluster, each with sources from the corpus. Every regeneration is a new revision.
+CREATE TABLE IF NOT EXISTS story_perspectives (
+    story_cluster_id UUID NOT NULL,
+    revision INTEGER NOT NULL,
+    perspectives JSONB NOT NULL,
+    input_article_ids UUID[] NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (story_cluster_id, revision)
+);
+
+-- Spans of raw_content (character offsets) that the neutral rewrite removed or changed:
+-- loaded words, unattributed claims and speculation. Drives the "what we neutralized" overlay.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS neutralizations JSONB NOT NULL DEFAULT '[]';
+
+-- Reviews are closed by the workflow execution that opened them (workflow_id, run_id), not by the draft's article id.
+CREATE INDEX IF NOT EXISTS editorial_reviews_run_idx ON editorial_reviews (workflow_id, run_id);
+
+-- Intensity (0-1) of the article's dominant emotion, from the analysis. Served as geo_location.intensity;
+-- previously that field echoed truth_score. Articles analysed before this migration read as 0.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS emotion_intensity DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- Pseudonymous client fingerprint (hashed IP) of the reader who filed the dispute. The tally counts
+-- distinct submitters and the API limits disputes per submitter and article. Older rows keep ''.
+ALTER TABLE article_disputes ADD COLUMN IF NOT EXISTS submitter_id TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS article_disputes_submitter_idx ON article_disputes (article_id, submitter_id, created_at);
+
+-- One owner per normalized alias and type, so two workers resolving the same new name cannot
+-- create two entities. Existing duplicates keep the alias on the oldest entity; the younger
+-- duplicate entities stay in place (without that alias) and can be merged from Admin.
+DELETE FROM entity_aliases a
+USING entity_aliases b, entities ea, entities eb
+WHERE a.alias_normalized = b.alias_normalized
+  AND a.type = b.type
+  AND a.entity_id <> b.entity_id
+  AND ea.id = a.entity_id
+  AND eb.id = b.entity_id
+  AND (ea.created_at, ea.id) > (eb.created_at, eb.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS entity_aliases_alias_type_key ON entity_aliases (alias_normalized, type);
+
+-- Rater identities issued by the server (POST /api/v1/raters). Ratings carry a signed token
+-- instead of a client-chosen rater_id; issuer_fingerprint (hashed client IP) bounds how many
+-- identities one client can mint per day.
+CREATE TABLE IF NOT EXISTS community_raters (
+    rater_id TEXT PRIMARY KEY,
+    issuer_fingerprint TEXT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS community_raters_issuer_idx ON community_raters (issuer_fingerprint, issued_at);
+
+-- Ratings submitted before server-issued identities used unverifiable client ids; drop them
+-- and the scores computed from them, so the next scoring run only sees raters the server vouches for.
+DELETE FROM community_ratings r
+WHERE NOT EXISTS (SELECT 1 FROM community_raters cr WHERE cr.rater_id = r.rater_id);
+
+DELETE FROM community_scores;
+
+-- 002 created counter_argument as nullable and 021's ADD COLUMN IF NOT EXISTS ... NOT NULL DEFAULT ''
+-- was a no-op on existing databases: backfill the NULLs and enforce the constraint now.
+UPDATE articles SET counter_argument = '' WHERE counter_argument IS NULL;
+
+ALTER TABLE articles
+    ALTER COLUMN counter_argument SET DEFAULT '',
+    ALTER COLUMN counter_argument SET NOT NULL;
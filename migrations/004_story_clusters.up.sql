@@ -0,0 +1,5 @@
+-- Story clusters: articles reporting the same story share one Event node in Dgraph
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS story_cluster_id UUID;
+
+CREATE INDEX IF NOT EXISTS articles_story_cluster_idx ON articles (story_cluster_id);
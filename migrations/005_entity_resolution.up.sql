@@ -0,0 +1,45 @@
+-- Entity resolution: canonical entities, alias table, article mentions and merge audit
+
+CREATE TABLE IF NOT EXISTS entities (
+    id UUID PRIMARY KEY,
+    canonical_name TEXT NOT NULL,
+    type TEXT NOT NULL, -- Person, Organization, Place
+    embedding vector(768),
+    merged_into UUID REFERENCES entities(id),
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS entities_embedding_idx ON entities USING hnsw (embedding vector_cosine_ops);
+
+CREATE TABLE IF NOT EXISTS entity_aliases (
+    entity_id UUID NOT NULL REFERENCES entities(id),
+    alias TEXT NOT NULL,
+    alias_normalized TEXT NOT NULL,
+    type TEXT NOT NULL,
+    PRIMARY KEY (alias_normalized, type, entity_id)
+);
+
+CREATE INDEX IF NOT EXISTS entity_aliases_entity_idx ON entity_aliases (entity_id);
+
+CREATE TABLE IF NOT EXISTS article_entity_mentions (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    entity_id UUID NOT NULL REFERENCES entities(id),
+    surface_form TEXT NOT NULL,
+    PRIMARY KEY (article_id, entity_id, surface_form)
+);
+
+CREATE INDEX IF NOT EXISTS article_entity_mentions_entity_idx ON article_entity_mentions (entity_id);
+
+CREATE TABLE IF NOT EXISTS entity_merge_audit (
+    id UUID PRIMARY KEY,
+    action TEXT NOT NULL, -- merge, split
+    source_entity_id UUID NOT NULL REFERENCES entities(id),
+    target_entity_id UUID NOT NULL REFERENCES entities(id),
+    moved_aliases JSONB NOT NULL DEFAULT '[]',
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS entity_merge_audit_source_idx ON entity_merge_audit (source_entity_id);
+CREATE INDEX IF NOT EXISTS entity_merge_audit_target_idx ON entity_merge_audit (target_entity_id);
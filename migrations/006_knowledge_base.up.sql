@@ -0,0 +1,24 @@
+-- Offline knowledge base (filtered Wikidata dump) and entity -> QID links
+
+CREATE TABLE IF NOT EXISTS knowledge_base_entries (
+    qid TEXT PRIMARY KEY, -- ex: Q6279
+    label TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    type TEXT NOT NULL, -- Person, Organization, Place
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    sitelinks INT NOT NULL DEFAULT 0,
+    imported_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS knowledge_base_aliases (
+    alias_normalized TEXT NOT NULL,
+    type TEXT NOT NULL,
+    qid TEXT NOT NULL REFERENCES knowledge_base_entries(qid) ON DELETE CASCADE,
+    PRIMARY KEY (alias_normalized, type, qid)
+);
+
+ALTER TABLE entities ADD COLUMN IF NOT EXISTS wikidata_qid TEXT REFERENCES knowledge_base_entries(qid);
+
+CREATE INDEX IF NOT EXISTS entities_wikidata_qid_idx ON entities (wikidata_qid);
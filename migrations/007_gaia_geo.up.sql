@@ -0,0 +1,8 @@
+-- Gaia map: real coordinates only ("No Null Island") and a spatial filter index
+
+-- Older rows were written with the model's (0,0) default; they are not real places.
+UPDATE articles SET location_lat = NULL, location_lng = NULL
+WHERE location_lat = 0 AND location_lng = 0;
+
+CREATE INDEX IF NOT EXISTS articles_geo_idx ON articles (location_lat, location_lng, published_at)
+WHERE location_lat IS NOT NULL AND location_lng IS NOT NULL;
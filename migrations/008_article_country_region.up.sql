@@ -0,0 +1,8 @@
+-- Country / region codes from the offline gazetteer (ISO 3166-1 alpha-2 and ISO 3166-2)
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS country_code TEXT;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS region_code TEXT;
+
+-- Feed and map filters by country, newest first
+CREATE INDEX IF NOT EXISTS articles_country_idx ON articles (country_code, published_at DESC)
+WHERE country_code IS NOT NULL;
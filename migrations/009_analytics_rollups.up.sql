@@ -0,0 +1,20 @@
+-- Topic sector from the analysis (see article.Sectors) and hourly / daily analytics rollups
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS sector TEXT;
+
+-- Rollup refresh scans a published_at window
+CREATE INDEX IF NOT EXISTS articles_published_at_idx ON articles (published_at);
+
+-- One row per (granularity, dimension, value, bucket). Only buckets with articles are stored.
+-- dimension: 'global' (value '*'), 'country' (ISO code), 'sector', 'narrative' (narrative.id)
+CREATE TABLE IF NOT EXISTS analytics_rollups (
+    granularity TEXT NOT NULL CHECK (granularity IN ('hour', 'day')),
+    dimension TEXT NOT NULL,
+    dimension_value TEXT NOT NULL,
+    bucket_start TIMESTAMPTZ NOT NULL,
+    article_count INTEGER NOT NULL,
+    truth_score_sum DOUBLE PRECISION NOT NULL,
+    emotion_counts JSONB NOT NULL DEFAULT '{}'::jsonb,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (granularity, dimension, dimension_value, bucket_start)
+);
@@ -0,0 +1,18 @@
+-- Trend / spike signals over entity-mention and story-cluster volumes (EWMA z-score)
+
+CREATE TABLE IF NOT EXISTS trend_signals (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL, -- entity, story_cluster
+    key TEXT NOT NULL,
+    label TEXT NOT NULL DEFAULT '',
+    article_count INTEGER NOT NULL,
+    baseline DOUBLE PRECISION NOT NULL,
+    std_dev DOUBLE PRECISION NOT NULL,
+    z_score DOUBLE PRECISION NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS trend_signals_detected_idx ON trend_signals (detected_at DESC);
+CREATE INDEX IF NOT EXISTS trend_signals_key_idx ON trend_signals (kind, key, detected_at DESC);
@@ -0,0 +1,20 @@
+-- Up Migration
+
+-- Full-text document for hybrid search (title > summary > content).
+-- 'simple' avoids stemming for a single language: the corpus is multilingual.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_document tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(summary, '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(content, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS articles_search_document_idx ON articles USING gin (search_document);
+
+-- Publisher domain ("https://www.reuters.com/world/..." -> "reuters.com") for the source filter.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS source_domain TEXT
+    GENERATED ALWAYS AS (
+        lower(substring(original_url FROM '^[A-Za-z][A-Za-z0-9+.-]*://(?:www\.)?([^/:?#]+)'))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS articles_source_domain_idx ON articles (source_domain);
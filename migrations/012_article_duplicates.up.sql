@@ -0,0 +1,12 @@
+-- Up Migration
+
+-- Syndicated / republished copies: not analysed again, linked to the canonical article.
+CREATE TABLE IF NOT EXISTS article_duplicates (
+    original_url TEXT PRIMARY KEY,
+    duplicate_of UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    similarity DOUBLE PRECISION NOT NULL,
+    method TEXT NOT NULL, -- semantic
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_duplicates_duplicate_of_idx ON article_duplicates (duplicate_of);
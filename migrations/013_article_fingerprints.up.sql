@@ -0,0 +1,21 @@
+-- Up Migration
+
+-- Lexical fingerprints checked before embedding: exact copies (content_hash) and
+-- near copies (64-bit SimHash, LSH over four 16-bit bands).
+-- article_duplicates.method (012) now also records 'exact' and 'simhash' besides 'semantic'.
+CREATE TABLE IF NOT EXISTS article_fingerprints (
+    article_id UUID PRIMARY KEY REFERENCES articles(id) ON DELETE CASCADE,
+    content_hash TEXT NOT NULL,
+    simhash BIGINT NOT NULL,
+    band_0 INTEGER NOT NULL,
+    band_1 INTEGER NOT NULL,
+    band_2 INTEGER NOT NULL,
+    band_3 INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_fingerprints_content_hash_idx ON article_fingerprints (content_hash);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_0_idx ON article_fingerprints (band_0);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_1_idx ON article_fingerprints (band_1);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_2_idx ON article_fingerprints (band_2);
+CREATE INDEX IF NOT EXISTS article_fingerprints_band_3_idx ON article_fingerprints (band_3);
@@ -0,0 +1,6 @@
+-- Up Migration
+
+-- Grounded Oracle answers: each assistant turn keeps the numbered citations with the exact
+-- supporting snippet and its character offsets in the article content.
+-- `citations` (TEXT[]) keeps only the cited article IDs; older turns have no snippets.
+ALTER TABLE chat_turns ADD COLUMN IF NOT EXISTS grounded_citations JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,16 @@
+-- Up Migration
+
+-- Chunked retrieval index: overlapping passages of each article's content with their own
+-- embeddings. Offsets are character positions in articles.content, end-exclusive.
+CREATE TABLE IF NOT EXISTS article_chunks (
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    chunk_index INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    embedding vector(768) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (article_id, chunk_index)
+);
+
+CREATE INDEX IF NOT EXISTS article_chunks_embedding_idx ON article_chunks USING hnsw (embedding vector_cosine_ops);
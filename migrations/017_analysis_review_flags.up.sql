@@ -0,0 +1,7 @@
+-- Up Migration
+
+-- Integrity review of AI analyses: reasons an article needs a human look (injected instructions
+-- in the scraped text, perfect score with a verbatim "rewrite", ...). Empty = nothing suspicious.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS review_flags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS articles_review_flags_idx ON articles (processed_at DESC) WHERE cardinality(review_flags) > 0;
@@ -0,0 +1,18 @@
+-- Up Migration
+
+-- Editorial review queue: analyses held back from publication until an editor approves, edits or
+-- rejects them (or the deadline passes). The draft is not in `articles` yet, hence no foreign key.
+CREATE TABLE IF NOT EXISTS editorial_reviews (
+    article_id UUID PRIMARY KEY,
+    workflow_id TEXT NOT NULL,
+    run_id TEXT NOT NULL DEFAULT '',
+    reasons TEXT[] NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'pending',
+    draft JSONB NOT NULL,
+    decision JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deadline TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS editorial_reviews_pending_idx ON editorial_reviews (created_at) WHERE status = 'pending';
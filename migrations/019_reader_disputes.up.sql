@@ -0,0 +1,35 @@
+-- Up Migration
+
+-- Reader disputes of an article's truth score, bias rating or a causal link, with evidence URLs.
+-- cause_event_id is the disputed parent event (event.caused_by in the graph), empty otherwise.
+CREATE TABLE IF NOT EXISTS article_disputes (
+    id UUID PRIMARY KEY,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    target TEXT NOT NULL,
+    cause_event_id TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    evidence_urls TEXT[] NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open',
+    resolution_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_disputes_open_idx ON article_disputes (article_id, created_at) WHERE status = 'open';
+
+-- Published outcome of a dispute re-analysis (corrected or upheld), shown on the article.
+CREATE TABLE IF NOT EXISTS article_resolution_notes (
+    id UUID PRIMARY KEY,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    dispute_ids UUID[] NOT NULL,
+    targets TEXT[] NOT NULL,
+    outcome TEXT NOT NULL,
+    previous_truth_score DOUBLE PRECISION NOT NULL,
+    truth_score DOUBLE PRECISION NOT NULL,
+    previous_bias_rating TEXT NOT NULL DEFAULT '',
+    bias_rating TEXT NOT NULL DEFAULT '',
+    retracted_cause_event_ids TEXT[] NOT NULL DEFAULT '{}',
+    note TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS article_resolution_notes_article_idx ON article_resolution_notes (article_id, created_at DESC);
@@ -0,0 +1,28 @@
+-- Up Migration
+
+-- Reader ratings (helpfulness / fairness) of an article or its counter-argument.
+-- rater_id is a pseudonymous client identifier; a new rating replaces the rater's previous one.
+CREATE TABLE IF NOT EXISTS community_ratings (
+    item_type TEXT NOT NULL,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    dimension TEXT NOT NULL,
+    rater_id TEXT NOT NULL,
+    rating TEXT NOT NULL,
+    rated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (item_type, article_id, dimension, rater_id)
+);
+
+CREATE INDEX IF NOT EXISTS community_ratings_dimension_idx ON community_ratings (dimension);
+
+-- Community trust signal from the bridging matrix factorization, kept apart from articles.truth_score.
+CREATE TABLE IF NOT EXISTS community_scores (
+    item_type TEXT NOT NULL,
+    article_id UUID NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    dimension TEXT NOT NULL,
+    bridging_score DOUBLE PRECISION NOT NULL,
+    polarization DOUBLE PRECISION NOT NULL,
+    rating_count INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    scored_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (item_type, article_id, dimension)
+);
@@ -0,0 +1,14 @@
+-- Up Migration
+
+-- The single "Devil's Advocate" counter-argument from the analysis, now kept and served with the article.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS counter_argument TEXT NOT NULL DEFAULT '';
+
+-- Labelled viewpoints (2-4) per story cluster, each with sources from the corpus. Every regeneration is a new revision.
+CREATE TABLE IF NOT EXISTS story_perspectives (
+    story_cluster_id UUID NOT NULL,
+    revision INTEGER NOT NULL,
+    perspectives JSONB NOT NULL,
+    input_article_ids UUID[] NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (story_cluster_id, revision)
+);
@@ -0,0 +1,5 @@
+-- Up Migration
+
+-- Spans of raw_content (character offsets) that the neutral rewrite removed or changed:
+-- loaded words, unattributed claims and speculation. Drives the "what we neutralized" overlay.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS neutralizations JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,4 @@
+-- Up Migration
+
+-- Reviews are closed by the workflow execution that opened them (workflow_id, run_id), not by the draft's article id.
+CREATE INDEX IF NOT EXISTS editorial_reviews_run_idx ON editorial_reviews (workflow_id, run_id);
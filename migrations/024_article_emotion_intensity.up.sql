@@ -0,0 +1,5 @@
+-- Up Migration
+
+-- Intensity (0-1) of the article's dominant emotion, from the analysis. Served as geo_location.intensity;
+-- previously that field echoed truth_score. Articles analysed before this migration read as 0.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS emotion_intensity DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+-- Up Migration
+
+-- Pseudonymous client fingerprint (hashed IP) of the reader who filed the dispute. The tally counts
+-- distinct submitters and the API limits disputes per submitter and article. Older rows keep ''.
+ALTER TABLE article_disputes ADD COLUMN IF NOT EXISTS submitter_id TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS article_disputes_submitter_idx ON article_disputes (article_id, submitter_id, created_at);
@@ -0,0 +1,15 @@
+-- Up Migration
+
+-- One owner per normalized alias and type, so two workers resolving the same new name cannot
+-- create two entities. Existing duplicates keep the alias on the oldest entity; the younger
+-- duplicate entities stay in place (without that alias) and can be merged from Admin.
+DELETE FROM entity_aliases a
+USING entity_aliases b, entities ea, entities eb
+WHERE a.alias_normalized = b.alias_normalized
+  AND a.type = b.type
+  AND a.entity_id <> b.entity_id
+  AND ea.id = a.entity_id
+  AND eb.id = b.entity_id
+  AND (ea.created_at, ea.id) > (eb.created_at, eb.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS entity_aliases_alias_type_key ON entity_aliases (alias_normalized, type);
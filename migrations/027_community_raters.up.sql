@@ -0,0 +1,19 @@
+-- Up Migration
+
+-- Rater identities issued by the server (POST /api/v1/raters). Ratings carry a signed token
+-- instead of a client-chosen rater_id; issuer_fingerprint (hashed client IP) bounds how many
+-- identities one client can mint per day.
+CREATE TABLE IF NOT EXISTS community_raters (
+    rater_id TEXT PRIMARY KEY,
+    issuer_fingerprint TEXT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS community_raters_issuer_idx ON community_raters (issuer_fingerprint, issued_at);
+
+-- Ratings submitted before server-issued identities used unverifiable client ids; drop them
+-- and the scores computed from them, so the next scoring run only sees raters the server vouches for.
+DELETE FROM community_ratings r
+WHERE NOT EXISTS (SELECT 1 FROM community_raters cr WHERE cr.rater_id = r.rater_id);
+
+DELETE FROM community_scores;
@@ -0,0 +1,9 @@
+-- Up Migration
+
+-- 002 created counter_argument as nullable and 021's ADD COLUMN IF NOT EXISTS ... NOT NULL DEFAULT ''
+-- was a no-op on existing databases: backfill the NULLs and enforce the constraint now.
+UPDATE articles SET counter_argument = '' WHERE counter_argument IS NULL;
+
+ALTER TABLE articles
+    ALTER COLUMN counter_argument SET DEFAULT '',
+    ALTER COLUMN counter_argument SET NOT NULL;